@@ -82,6 +82,7 @@ val services = mapOf(
     "emr" to ServiceSpec("EMR", vpcLock = true),
     "emrcontainers" to ServiceSpec("EMR Containers"),
     "emrserverless" to ServiceSpec("EMR Serverless"),
+    "entityresolution" to ServiceSpec("Entity Resolution"),
     "events" to ServiceSpec("EventBridge"),
     "evidently" to ServiceSpec("CloudWatch Evidently"),
     "finspace" to ServiceSpec("FinSpace"),
@@ -138,6 +139,7 @@ val services = mapOf(
     "opensearchserverless" to ServiceSpec("OpenSearch Serverless"),
     "opsworks" to ServiceSpec("OpsWorks", vpcLock = true),
     "organizations" to ServiceSpec("Organizations"),
+    "osis" to ServiceSpec("OpenSearch Ingestion"),
     "outposts" to ServiceSpec("Outposts"),
     "pinpoint" to ServiceSpec("Pinpoint"),
     "pipes" to ServiceSpec("EventBridge Pipes"),
@@ -150,6 +152,7 @@ val services = mapOf(
     "redshift" to ServiceSpec("Redshift", vpcLock = true),
     "redshiftdata" to ServiceSpec("Redshift Data"),
     "redshiftserverless" to ServiceSpec("Redshift Serverless"),
+    "resiliencehub" to ServiceSpec("Resilience Hub"),
     "resourceexplorer2" to ServiceSpec("Resource Explorer"),
     "resourcegroups" to ServiceSpec("Resource Groups"),
     "resourcegroupstaggingapi" to ServiceSpec("Resource Groups Tagging"),
@@ -190,6 +193,7 @@ val services = mapOf(
     "swf" to ServiceSpec("SWF (Simple Workflow)"),
     "synthetics" to ServiceSpec("CloudWatch Synthetics", parallelismOverride = 10),
     "timestreamwrite" to ServiceSpec("Timestream Write"),
+    "tnb" to ServiceSpec("Telco Network Builder"),
     "transcribe" to ServiceSpec("Transcribe"),
     "transfer" to ServiceSpec("Transfer Family", vpcLock = true),
     "vpclattice" to ServiceSpec("VPC Lattice"),
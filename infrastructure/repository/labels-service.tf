@@ -113,6 +113,7 @@ variable "service_labels" {
     "emr",
     "emrcontainers",
     "emrserverless",
+    "entityresolution",
     "events",
     "evidently",
     "finspace",
@@ -222,6 +223,7 @@ variable "service_labels" {
     "opsworks",
     "opsworkscm",
     "organizations",
+    "osis",
     "outposts",
     "panorama",
     "personalize",
@@ -303,6 +305,7 @@ variable "service_labels" {
     "textract",
     "timestreamquery",
     "timestreamwrite",
+    "tnb",
     "transcribe",
     "transcribestreaming",
     "transfer",
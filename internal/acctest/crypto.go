@@ -23,6 +23,7 @@ const (
 	PEMBlockTypeECPrivateKey       = `EC PRIVATE KEY`
 	PEMBlockTypeRSAPrivateKey      = `RSA PRIVATE KEY`
 	PEMBlockTypePublicKey          = `PUBLIC KEY`
+	PEMBlockTypeX509CRL            = `X509 CRL`
 )
 
 var (
@@ -409,6 +410,62 @@ func TLSRSAX509CertificateRequestPEM(t *testing.T, keyBits int, commonName strin
 	return string(pem.EncodeToMemory(csrBlock)), string(pem.EncodeToMemory(keyBlock))
 }
 
+// TLSRSAX509CertificateRevocationListPEM generates a x509 certificate revocation list PEM string,
+// signed by the given CA, that revokes the given certificate.
+func TLSRSAX509CertificateRevocationListPEM(t *testing.T, caKeyPem, caCertificatePem, certificatePem string) string {
+	caCertificateBlock, _ := pem.Decode([]byte(caCertificatePem))
+
+	caCertificate, err := x509.ParseCertificate(caCertificateBlock.Bytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The test CA certificates are not issued with the cRLSign key usage.
+	caCertificate.KeyUsage |= x509.KeyUsageCRLSign
+
+	caKeyBlock, _ := pem.Decode([]byte(caKeyPem))
+
+	caKey, err := x509.ParsePKCS1PrivateKey(caKeyBlock.Bytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certificateBlock, _ := pem.Decode([]byte(certificatePem))
+
+	certificate, err := x509.ParseCertificate(certificateBlock.Bytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	crl := &x509.RevocationList{
+		NextUpdate: time.Now().Add(24 * time.Hour), //nolint:gomnd
+		Number:     big.NewInt(1),
+		RevokedCertificateEntries: []x509.RevocationListEntry{
+			{
+				RevocationTime: time.Now(),
+				SerialNumber:   certificate.SerialNumber,
+			},
+		},
+		ThisUpdate: time.Now(),
+	}
+
+	crlBytes, err := x509.CreateRevocationList(rand.Reader, crl, caCertificate, caKey)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	crlBlock := &pem.Block{
+		Bytes: crlBytes,
+		Type:  PEMBlockTypeX509CRL,
+	}
+
+	return string(pem.EncodeToMemory(crlBlock))
+}
+
 func TLSPEMEscapeNewlines(pem string) string {
 	return strings.ReplaceAll(pem, "\n", "\\n")
 }
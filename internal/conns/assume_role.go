@@ -0,0 +1,89 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// chainAssumeRoles assumes each role in turn using the credentials obtained from the previous role.
+// The first role in a chain is assumed by aws-sdk-go-base, so roles starts at the second hop.
+func chainAssumeRoles(ctx context.Context, cfg aws_sdkv2.Config, roles []*awsbase.AssumeRole, stsRegion, stsEndpoint string) (aws_sdkv2.Config, error) {
+	for i, ar := range roles {
+		if ar == nil || ar.RoleARN == "" {
+			return cfg, fmt.Errorf("assume_role (%d): role ARN not set", i+2)
+		}
+
+		tflog.Info(ctx, "Assuming chained IAM Role", map[string]any{
+			"tf_aws.assume_role.role_arn":        ar.RoleARN,
+			"tf_aws.assume_role.session_name":    ar.SessionName,
+			"tf_aws.assume_role.external_id":     ar.ExternalID,
+			"tf_aws.assume_role.source_identity": ar.SourceIdentity,
+		})
+
+		client := sts.NewFromConfig(cfg, func(o *sts.Options) {
+			if stsRegion != "" {
+				o.Region = stsRegion
+			}
+
+			if stsEndpoint != "" {
+				o.EndpointResolver = sts.EndpointResolverFromURL(stsEndpoint)
+			}
+		})
+
+		provider := stscreds.NewAssumeRoleProvider(client, ar.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			assumeRoleOptions(o, ar)
+		})
+
+		if _, err := provider.Retrieve(ctx); err != nil {
+			return cfg, fmt.Errorf("assuming IAM Role (%s): %w", ar.RoleARN, err)
+		}
+
+		cfg.Credentials = aws_sdkv2.NewCredentialsCache(provider)
+	}
+
+	return cfg, nil
+}
+
+func assumeRoleOptions(o *stscreds.AssumeRoleOptions, ar *awsbase.AssumeRole) {
+	o.Duration = ar.Duration
+
+	if ar.SessionName != "" {
+		o.RoleSessionName = ar.SessionName
+	}
+
+	if ar.ExternalID != "" {
+		o.ExternalID = aws_sdkv2.String(ar.ExternalID)
+	}
+
+	if ar.Policy != "" {
+		o.Policy = aws_sdkv2.String(ar.Policy)
+	}
+
+	for _, v := range ar.PolicyARNs {
+		o.PolicyARNs = append(o.PolicyARNs, ststypes.PolicyDescriptorType{
+			Arn: aws_sdkv2.String(v),
+		})
+	}
+
+	for k, v := range ar.Tags {
+		o.Tags = append(o.Tags, ststypes.Tag{
+			Key:   aws_sdkv2.String(k),
+			Value: aws_sdkv2.String(v),
+		})
+	}
+
+	if len(ar.TransitiveTagKeys) > 0 {
+		o.TransitiveTagKeys = ar.TransitiveTagKeys
+	}
+
+	if ar.SourceIdentity != "" {
+		o.SourceIdentity = aws_sdkv2.String(ar.SourceIdentity)
+	}
+}
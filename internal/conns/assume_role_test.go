@@ -0,0 +1,70 @@
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+func TestAssumeRoleOptions(t *testing.T) {
+	t.Parallel()
+
+	ar := &awsbase.AssumeRole{
+		Duration:          time.Hour,
+		ExternalID:        "external-id",
+		PolicyARNs:        []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+		SessionName:       "session-name",
+		SourceIdentity:    "source-identity",
+		Tags:              map[string]string{"key1": "value1"},
+		TransitiveTagKeys: []string{"key1"},
+	}
+
+	var o stscreds.AssumeRoleOptions
+	assumeRoleOptions(&o, ar)
+
+	if got, want := o.Duration, time.Hour; got != want {
+		t.Errorf("got duration %s, expected %s", got, want)
+	}
+
+	if got, want := aws_sdkv2.ToString(o.ExternalID), "external-id"; got != want {
+		t.Errorf("got external ID %q, expected %q", got, want)
+	}
+
+	if o.Policy != nil {
+		t.Errorf("got policy %q, expected none", aws_sdkv2.ToString(o.Policy))
+	}
+
+	if got, want := len(o.PolicyARNs), 1; got != want {
+		t.Errorf("got %d policy ARNs, expected %d", got, want)
+	}
+
+	if got, want := o.RoleSessionName, "session-name"; got != want {
+		t.Errorf("got session name %q, expected %q", got, want)
+	}
+
+	if got, want := aws_sdkv2.ToString(o.SourceIdentity), "source-identity"; got != want {
+		t.Errorf("got source identity %q, expected %q", got, want)
+	}
+
+	if got, want := len(o.Tags), 1; got != want {
+		t.Errorf("got %d tags, expected %d", got, want)
+	}
+
+	if got, want := len(o.TransitiveTagKeys), 1; got != want {
+		t.Errorf("got %d transitive tag keys, expected %d", got, want)
+	}
+}
+
+func TestChainAssumeRolesMissingRoleARN(t *testing.T) {
+	t.Parallel()
+
+	_, err := chainAssumeRoles(context.Background(), aws_sdkv2.Config{}, []*awsbase.AssumeRole{{}}, "", "")
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
@@ -32,6 +32,19 @@ func (client *AWSClient) S3ConnURICleaningDisabled(context.Context) *s3.S3 {
 	return s3.New(client.Session.Copy(&config))
 }
 
+// S3ExpressControlConn returns an S3 client for the regional S3 Express One Zone control plane
+// endpoint, which serves directory bucket CreateBucket, DeleteBucket and ListDirectoryBuckets requests.
+func (client *AWSClient) S3ExpressControlConn(context.Context) *s3.S3 {
+	config := client.S3Conn().Config
+	config.Endpoint = aws.String(fmt.Sprintf("https://%s", client.RegionalHostname("s3express-control")))
+	config.S3ForcePathStyle = aws.Bool(true)
+
+	conn := s3.New(client.Session.Copy(&config))
+	conn.Client.SigningName = "s3express"
+
+	return conn
+}
+
 // SetHTTPClient sets the http.Client used for AWS API calls.
 // To have effect it must be called before the AWS SDK v1 Session is created.
 func (client *AWSClient) SetHTTPClient(httpClient *http.Client) {
@@ -16,6 +16,7 @@ import (
 	directoryservice_sdkv2 "github.com/aws/aws-sdk-go-v2/service/directoryservice"
 	"github.com/aws/aws-sdk-go-v2/service/docdbelastic"
 	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	elasticloadbalancingv2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/finspace"
 	"github.com/aws/aws-sdk-go-v2/service/fis"
 	"github.com/aws/aws-sdk-go-v2/service/glacier"
@@ -43,11 +44,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
 	"github.com/aws/aws-sdk-go-v2/service/swf"
 	"github.com/aws/aws-sdk-go-v2/service/transcribe"
+	transfer_sdkv2 "github.com/aws/aws-sdk-go-v2/service/transfer"
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
 	"github.com/aws/aws-sdk-go-v2/service/xray"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/acmpca"
-	"github.com/aws/aws-sdk-go/service/alexaforbusiness"
 	"github.com/aws/aws-sdk-go/service/amplify"
 	"github.com/aws/aws-sdk-go/service/amplifybackend"
 	"github.com/aws/aws-sdk-go/service/amplifyuibuilder"
@@ -151,6 +152,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/aws/aws-sdk-go/service/emrcontainers"
 	"github.com/aws/aws-sdk-go/service/emrserverless"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/aws/aws-sdk-go/service/finspacedata"
 	"github.com/aws/aws-sdk-go/service/firehose"
@@ -168,7 +170,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/groundstation"
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/health"
-	"github.com/aws/aws-sdk-go/service/honeycode"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/aws/aws-sdk-go/service/inspector"
@@ -213,7 +214,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/lookoutforvision"
 	"github.com/aws/aws-sdk-go/service/lookoutmetrics"
 	"github.com/aws/aws-sdk-go/service/machinelearning"
-	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/managedblockchain"
 	"github.com/aws/aws-sdk-go/service/managedgrafana"
@@ -234,7 +234,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/migrationhubconfig"
 	"github.com/aws/aws-sdk-go/service/migrationhubrefactorspaces"
 	"github.com/aws/aws-sdk-go/service/migrationhubstrategyrecommendations"
-	"github.com/aws/aws-sdk-go/service/mobile"
 	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/aws/aws-sdk-go/service/mturk"
 	"github.com/aws/aws-sdk-go/service/mwaa"
@@ -246,6 +245,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/aws/aws-sdk-go/service/opsworkscm"
 	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/osis"
 	"github.com/aws/aws-sdk-go/service/outposts"
 	"github.com/aws/aws-sdk-go/service/panorama"
 	"github.com/aws/aws-sdk-go/service/personalize"
@@ -314,6 +314,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/textract"
 	"github.com/aws/aws-sdk-go/service/timestreamquery"
 	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/aws/aws-sdk-go/service/tnb"
 	"github.com/aws/aws-sdk-go/service/transcribestreamingservice"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/aws/aws-sdk-go/service/translate"
@@ -342,17 +343,20 @@ type AWSClient struct {
 	ReverseDNSPrefix        string
 	ServicePackages         map[string]ServicePackage
 	Session                 *session.Session
+	TagCache                *TagCache
 	TerraformVersion        string
 
 	httpClient *http.Client
 
 	dsClient        lazyClient[*directoryservice_sdkv2.Client]
 	ec2Client       lazyClient[*ec2_sdkv2.Client]
+	elbv2Client     lazyClient[*elasticloadbalancingv2_sdkv2.Client]
 	lambdaClient    lazyClient[*lambda_sdkv2.Client]
 	logsClient      lazyClient[*cloudwatchlogs_sdkv2.Client]
 	rdsClient       lazyClient[*rds_sdkv2.Client]
 	s3controlClient lazyClient[*s3control_sdkv2.Client]
 	ssmClient       lazyClient[*ssm_sdkv2.Client]
+	transferClient  lazyClient[*transfer_sdkv2.Client]
 
 	acmClient                        *acm.Client
 	acmpcaConn                       *acmpca.ACMPCA
@@ -362,7 +366,6 @@ type AWSClient struct {
 	apigatewayv2Conn                 *apigatewayv2.ApiGatewayV2
 	accessanalyzerClient             *accessanalyzer.Client
 	accountClient                    *account.Client
-	alexaforbusinessConn             *alexaforbusiness.AlexaForBusiness
 	amplifyConn                      *amplify.Amplify
 	amplifybackendConn               *amplifybackend.AmplifyBackend
 	amplifyuibuilderConn             *amplifyuibuilder.AmplifyUIBuilder
@@ -464,6 +467,7 @@ type AWSClient struct {
 	elasticinferenceConn             *elasticinference.ElasticInference
 	elastictranscoderConn            *elastictranscoder.ElasticTranscoder
 	esConn                           *elasticsearchservice.ElasticsearchService
+	entityresolutionConn             *entityresolution.EntityResolution
 	eventsConn                       *eventbridge.EventBridge
 	evidentlyConn                    *cloudwatchevidently.CloudWatchEvidently
 	fisClient                        *fis.Client
@@ -486,7 +490,6 @@ type AWSClient struct {
 	guarddutyConn                    *guardduty.GuardDuty
 	healthConn                       *health.Health
 	healthlakeClient                 *healthlake.Client
-	honeycodeConn                    *honeycode.Honeycode
 	iamConn                          *iam.IAM
 	ivsConn                          *ivs.IVS
 	ivschatClient                    *ivschat.Client
@@ -539,7 +542,6 @@ type AWSClient struct {
 	mturkConn                        *mturk.MTurk
 	mwaaConn                         *mwaa.MWAA
 	machinelearningConn              *machinelearning.MachineLearning
-	macieConn                        *macie.Macie
 	macie2Conn                       *macie2.Macie2
 	managedblockchainConn            *managedblockchain.ManagedBlockchain
 	marketplacecatalogConn           *marketplacecatalog.MarketplaceCatalog
@@ -560,13 +562,13 @@ type AWSClient struct {
 	migrationhubconfigConn           *migrationhubconfig.MigrationHubConfig
 	migrationhubrefactorspacesConn   *migrationhubrefactorspaces.MigrationHubRefactorSpaces
 	migrationhubstrategyConn         *migrationhubstrategyrecommendations.MigrationHubStrategyRecommendations
-	mobileConn                       *mobile.Mobile
 	neptuneConn                      *neptune.Neptune
 	networkfirewallConn              *networkfirewall.NetworkFirewall
 	networkmanagerConn               *networkmanager.NetworkManager
 	nimbleConn                       *nimblestudio.NimbleStudio
 	oamClient                        *oam.Client
 	opensearchConn                   *opensearchservice.OpenSearchService
+	osisConn                         *osis.OSIS
 	opensearchserverlessClient       *opensearchserverless.Client
 	opsworksConn                     *opsworks.OpsWorks
 	opsworkscmConn                   *opsworkscm.OpsWorksCM
@@ -649,6 +651,7 @@ type AWSClient struct {
 	storagegatewayConn               *storagegateway.StorageGateway
 	supportConn                      *support.Support
 	syntheticsConn                   *synthetics.Synthetics
+	tnbConn                          *tnb.Tnb
 	textractConn                     *textract.Textract
 	timestreamqueryConn              *timestreamquery.TimestreamQuery
 	timestreamwriteConn              *timestreamwrite.TimestreamWrite
@@ -704,10 +707,6 @@ func (client *AWSClient) AccountClient() *account.Client {
 	return client.accountClient
 }
 
-func (client *AWSClient) AlexaForBusinessConn() *alexaforbusiness.AlexaForBusiness {
-	return client.alexaforbusinessConn
-}
-
 func (client *AWSClient) AmplifyConn() *amplify.Amplify {
 	return client.amplifyConn
 }
@@ -1088,6 +1087,10 @@ func (client *AWSClient) ELBV2Conn() *elbv2.ELBV2 {
 	return client.elbv2Conn
 }
 
+func (client *AWSClient) ELBV2Client() *elasticloadbalancingv2_sdkv2.Client {
+	return client.elbv2Client.Client()
+}
+
 func (client *AWSClient) EMRConn() *emr.EMR {
 	return client.emrConn
 }
@@ -1120,6 +1123,10 @@ func (client *AWSClient) ElasticsearchConn() *elasticsearchservice.Elasticsearch
 	return client.esConn
 }
 
+func (client *AWSClient) EntityResolutionConn() *entityresolution.EntityResolution {
+	return client.entityresolutionConn
+}
+
 func (client *AWSClient) EventsConn() *eventbridge.EventBridge {
 	return client.eventsConn
 }
@@ -1208,10 +1215,6 @@ func (client *AWSClient) HealthLakeClient() *healthlake.Client {
 	return client.healthlakeClient
 }
 
-func (client *AWSClient) HoneycodeConn() *honeycode.Honeycode {
-	return client.honeycodeConn
-}
-
 func (client *AWSClient) IAMConn() *iam.IAM {
 	return client.iamConn
 }
@@ -1428,10 +1431,6 @@ func (client *AWSClient) MachineLearningConn() *machinelearning.MachineLearning
 	return client.machinelearningConn
 }
 
-func (client *AWSClient) MacieConn() *macie.Macie {
-	return client.macieConn
-}
-
 func (client *AWSClient) Macie2Conn() *macie2.Macie2 {
 	return client.macie2Conn
 }
@@ -1512,10 +1511,6 @@ func (client *AWSClient) MigrationHubStrategyConn() *migrationhubstrategyrecomme
 	return client.migrationhubstrategyConn
 }
 
-func (client *AWSClient) MobileConn() *mobile.Mobile {
-	return client.mobileConn
-}
-
 func (client *AWSClient) NeptuneConn() *neptune.Neptune {
 	return client.neptuneConn
 }
@@ -1540,6 +1535,10 @@ func (client *AWSClient) OpenSearchConn() *opensearchservice.OpenSearchService {
 	return client.opensearchConn
 }
 
+func (client *AWSClient) OpenSearchIngestionConn() *osis.OSIS {
+	return client.osisConn
+}
+
 func (client *AWSClient) OpenSearchServerlessClient() *opensearchserverless.Client {
 	return client.opensearchserverlessClient
 }
@@ -1880,6 +1879,10 @@ func (client *AWSClient) SyntheticsConn() *synthetics.Synthetics {
 	return client.syntheticsConn
 }
 
+func (client *AWSClient) TNBConn() *tnb.Tnb {
+	return client.tnbConn
+}
+
 func (client *AWSClient) TextractConn() *textract.Textract {
 	return client.textractConn
 }
@@ -1904,6 +1907,10 @@ func (client *AWSClient) TransferConn() *transfer.Transfer {
 	return client.transferConn
 }
 
+func (client *AWSClient) TransferClient() *transfer_sdkv2.Client {
+	return client.transferClient.Client()
+}
+
 func (client *AWSClient) TranslateConn() *translate.Translate {
 	return client.translateConn
 }
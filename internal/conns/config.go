@@ -3,6 +3,7 @@ package conns
 import (
 	"context"
 	"log"
+	"net/http"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
@@ -48,7 +49,7 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AssumeRole                     []*awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
@@ -56,6 +57,7 @@ type Config struct {
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
 	Endpoints                      map[string]string
+	EndpointTransports             []*EndpointTransport
 	ForbiddenAccountIds            []string
 	HTTPProxy                      string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
@@ -63,6 +65,7 @@ type Config struct {
 	MaxRetries                     int
 	Profile                        string
 	Region                         string
+	RetryConfig                    *RetryConfig
 	S3UsePathStyle                 bool
 	SecretKey                      string
 	SharedConfigFiles              []string
@@ -76,6 +79,7 @@ type Config struct {
 	Token                          string
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
+	UseTagCache                    bool
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -104,8 +108,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:               c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	if len(c.AssumeRole) > 0 && c.AssumeRole[0] != nil && c.AssumeRole[0].RoleARN != "" {
+		awsbaseConfig.AssumeRole = c.AssumeRole[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -142,12 +146,53 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	if len(c.AssumeRole) > 1 {
+		if awsbaseConfig.AssumeRole == nil {
+			return nil, diag.Errorf("configuring Terraform AWS Provider: assume_role (1): role ARN not set")
+		}
+
+		tflog.Debug(ctx, "Assuming chained IAM Roles")
+		cfg, err = chainAssumeRoles(ctx, cfg, c.AssumeRole[1:], c.STSRegion, c.Endpoints[names.STS])
+		if err != nil {
+			return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
+		}
+	}
+
+	if c.RetryConfig != nil {
+		cfg.Retryer = c.RetryConfig.retryerV2(cfg.Retryer)
+	}
+
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
 	sess, err := awsbasev1.GetSession(ctx, &cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("creating AWS SDK v1 session: %s", err)
 	}
 
+	if c.RetryConfig != nil {
+		sess.Config.Retryer = c.RetryConfig.retryerV1(aws.IntValue(sess.Config.MaxRetries))
+	}
+
+	if len(c.EndpointTransports) > 0 {
+		transports, err := c.endpointTransports()
+		if err != nil {
+			return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
+		}
+
+		cfg.HTTPClient = &endpointHTTPClient{
+			next:       cfg.HTTPClient,
+			transports: transports,
+		}
+
+		next := sess.Config.HTTPClient.Transport
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		sess.Config.HTTPClient.Transport = &endpointRoundTripper{
+			next:       next,
+			transports: transports,
+		}
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
@@ -195,6 +240,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
 
+	if c.UseTagCache {
+		client.TagCache = NewTagCache()
+	}
+
 	// API clients (generated).
 	c.sdkv1Conns(client, sess)
 	c.sdkv2Conns(client, cfg)
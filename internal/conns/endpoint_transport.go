@@ -0,0 +1,155 @@
+package conns
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+)
+
+// EndpointTransport contains HTTP transport settings for requests sent to the custom endpoints of the specified services.
+type EndpointTransport struct {
+	CustomCABundle string
+	HTTPProxy      string
+	// Services are provider package names, e.g. "s3".
+	Services []string
+}
+
+func (et *EndpointTransport) transport(insecure bool) (*http.Transport, error) {
+	tr := awshttp.NewBuildableClient().GetTransport()
+
+	if tr.TLSClientConfig == nil {
+		tr.TLSClientConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	if insecure {
+		tr.TLSClientConfig.InsecureSkipVerify = true
+	}
+
+	if et.CustomCABundle != "" {
+		bundle, err := os.ReadFile(et.CustomCABundle)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading custom CA bundle (%s): %w", et.CustomCABundle, err)
+		}
+
+		pool := x509.NewCertPool()
+
+		if !pool.AppendCertsFromPEM(bundle) {
+			return nil, fmt.Errorf("custom CA bundle (%s) contains no PEM certificates", et.CustomCABundle)
+		}
+
+		tr.TLSClientConfig.RootCAs = pool
+	}
+
+	if et.HTTPProxy != "" {
+		proxyURL, err := url.Parse(et.HTTPProxy)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing HTTP proxy URL (%s): %w", et.HTTPProxy, err)
+		}
+
+		tr.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return tr, nil
+}
+
+// endpointTransports returns HTTP transports keyed by the host name of each configured service's custom endpoint.
+// Services whose custom endpoints share a host name must use the same transport settings.
+func (c *Config) endpointTransports() (endpointTransports, error) {
+	transports := make(endpointTransports)
+	hostServices := make(map[string]string)
+
+	for _, et := range c.EndpointTransports {
+		tr, err := et.transport(c.Insecure)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pkg := range et.Services {
+			endpoint := c.Endpoints[pkg]
+
+			if endpoint == "" {
+				return nil, fmt.Errorf("endpoint transport: no custom endpoint configured for service (%s)", pkg)
+			}
+
+			u, err := url.Parse(endpoint)
+
+			if err != nil || u.Hostname() == "" {
+				return nil, fmt.Errorf("endpoint transport: invalid custom endpoint for service (%s): %s", pkg, endpoint)
+			}
+
+			host := strings.ToLower(u.Hostname())
+
+			if v, ok := transports[host]; ok && v != tr {
+				return nil, fmt.Errorf("endpoint transport: services (%s, %s) share custom endpoint host (%s) but have different transport settings", hostServices[host], pkg, host)
+			}
+
+			transports[host] = tr
+			hostServices[host] = pkg
+		}
+	}
+
+	return transports, nil
+}
+
+type endpointTransports map[string]*http.Transport
+
+// transportFor returns the transport for the request's host, or nil if none is configured.
+// Subdomains of a configured host, e.g. S3 virtual hosted-style bucket endpoints, also match.
+// The most specific configured host, i.e. the longest match, is used.
+func (t endpointTransports) transportFor(req *http.Request) *http.Transport {
+	host := strings.ToLower(req.URL.Hostname())
+
+	for {
+		if tr, ok := t[host]; ok {
+			return tr
+		}
+
+		i := strings.IndexByte(host, '.')
+
+		if i < 0 {
+			return nil
+		}
+
+		host = host[i+1:]
+	}
+}
+
+// endpointRoundTripper routes AWS SDK for Go v1 requests to any transport configured for the request's host.
+type endpointRoundTripper struct {
+	next       http.RoundTripper
+	transports endpointTransports
+}
+
+func (rt *endpointRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if tr := rt.transports.transportFor(req); tr != nil {
+		return tr.RoundTrip(req)
+	}
+
+	return rt.next.RoundTrip(req)
+}
+
+// endpointHTTPClient routes AWS SDK for Go v2 requests to any transport configured for the request's host.
+type endpointHTTPClient struct {
+	next       aws_sdkv2.HTTPClient
+	transports endpointTransports
+}
+
+func (c *endpointHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	if tr := c.transports.transportFor(req); tr != nil {
+		return (&http.Client{Transport: tr}).Do(req)
+	}
+
+	return c.next.Do(req)
+}
@@ -0,0 +1,180 @@
+package conns
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestConfigEndpointTransports(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		config      Config
+		expectError bool
+		wantHosts   []string
+	}{
+		{
+			name: "no custom endpoint",
+			config: Config{
+				EndpointTransports: []*EndpointTransport{{
+					HTTPProxy: "http://proxy.example.com:3128",
+					Services:  []string{"s3"},
+				}},
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid proxy",
+			config: Config{
+				Endpoints: map[string]string{
+					"s3": "https://bucket.vpce-1a2b3c4d-5e6f.s3.us-east-1.vpce.amazonaws.com",
+				},
+				EndpointTransports: []*EndpointTransport{{
+					HTTPProxy: "http://proxy.example.com:port",
+					Services:  []string{"s3"},
+				}},
+			},
+			expectError: true,
+		},
+		{
+			name: "missing CA bundle",
+			config: Config{
+				Endpoints: map[string]string{
+					"s3": "https://bucket.vpce-1a2b3c4d-5e6f.s3.us-east-1.vpce.amazonaws.com",
+				},
+				EndpointTransports: []*EndpointTransport{{
+					CustomCABundle: "test-fixtures/does-not-exist.pem",
+					Services:       []string{"s3"},
+				}},
+			},
+			expectError: true,
+		},
+		{
+			name: "shared host",
+			config: Config{
+				Endpoints: map[string]string{
+					"s3":        "https://s3.us-east-1.vpce.amazonaws.com",
+					"s3control": "https://s3.us-east-1.vpce.amazonaws.com",
+				},
+				EndpointTransports: []*EndpointTransport{
+					{
+						HTTPProxy: "http://proxy1.example.com:3128",
+						Services:  []string{"s3"},
+					},
+					{
+						HTTPProxy: "http://proxy2.example.com:3128",
+						Services:  []string{"s3control"},
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "proxy",
+			config: Config{
+				Endpoints: map[string]string{
+					"s3":  "https://bucket.vpce-1a2b3c4d-5e6f.s3.us-east-1.vpce.amazonaws.com",
+					"sts": "https://vpce-1a2b3c4d-5e6f.sts.us-east-1.vpce.amazonaws.com:8443",
+				},
+				EndpointTransports: []*EndpointTransport{{
+					HTTPProxy: "http://proxy.example.com:3128",
+					Services:  []string{"s3", "sts"},
+				}},
+			},
+			wantHosts: []string{
+				"bucket.vpce-1a2b3c4d-5e6f.s3.us-east-1.vpce.amazonaws.com",
+				"vpce-1a2b3c4d-5e6f.sts.us-east-1.vpce.amazonaws.com",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.config.endpointTransports()
+
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error, got none")
+			}
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := len(got), len(testCase.wantHosts); got != want {
+				t.Errorf("got %d transports, expected %d", got, want)
+			}
+
+			for _, host := range testCase.wantHosts {
+				tr, ok := got[host]
+
+				if !ok {
+					t.Errorf("no transport for host %s", host)
+					continue
+				}
+
+				if tr.Proxy == nil {
+					t.Errorf("no proxy configured for host %s", host)
+				}
+			}
+		})
+	}
+}
+
+func TestEndpointTransportsTransportFor(t *testing.T) {
+	t.Parallel()
+
+	tr := &http.Transport{}
+	trBucket := &http.Transport{}
+	transports := endpointTransports{
+		"s3.us-east-1.vpce.amazonaws.com":        tr,
+		"bucket.s3.us-east-1.vpce.amazonaws.com": trBucket,
+	}
+
+	testCases := []struct {
+		url  string
+		want *http.Transport
+	}{
+		{
+			url:  "https://s3.us-east-1.vpce.amazonaws.com/bucket/key",
+			want: tr,
+		},
+		{
+			url:  "https://bucket.s3.us-east-1.vpce.amazonaws.com:443/key",
+			want: trBucket,
+		},
+		{
+			url:  "https://other.s3.us-east-1.vpce.amazonaws.com/key",
+			want: tr,
+		},
+		{
+			url:  "https://accesspoint.bucket.s3.us-east-1.vpce.amazonaws.com/key",
+			want: trBucket,
+		},
+		{
+			url:  "https://S3.US-EAST-1.VPCE.AMAZONAWS.COM/",
+			want: tr,
+		},
+		{
+			url: "https://ec2.us-east-1.amazonaws.com/",
+		},
+		{
+			url: "https://examples3.us-east-1.vpce.amazonaws.com/",
+		},
+	}
+
+	for _, testCase := range testCases {
+		req, err := http.NewRequest(http.MethodGet, testCase.url, nil)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got := transports.transportFor(req); got != testCase.want {
+			t.Errorf("%s: got transport %p, expected %p", testCase.url, got, testCase.want)
+		}
+	}
+}
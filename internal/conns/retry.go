@@ -0,0 +1,132 @@
+package conns
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/client"
+)
+
+// RetryConfig contains provider-level settings for retrying AWS API requests.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of attempts, including the initial request.
+	MaxAttempts int
+	// Mode is the AWS SDK for Go v2 retry mode, "standard" or "adaptive".
+	Mode string
+	// ThrottlingBackoffBase is the base delay before retrying a throttled request.
+	ThrottlingBackoffBase time.Duration
+}
+
+// retryerV2 returns a function creating AWS SDK for Go v2 retryers.
+// The retryers created by next, as configured by aws-sdk-go-base, are wrapped so that only
+// the retry mode, maximum attempts and throttling backoff are overridden.
+func (c *RetryConfig) retryerV2(next func() aws_sdkv2.Retryer) func() aws_sdkv2.Retryer {
+	if next == nil {
+		next = func() aws_sdkv2.Retryer {
+			return retry_sdkv2.NewStandard()
+		}
+	}
+
+	return func() aws_sdkv2.Retryer {
+		retryer := next()
+
+		if c.MaxAttempts > 0 {
+			retryer = retry_sdkv2.AddWithMaxAttempts(retryer, c.MaxAttempts)
+		}
+
+		if c.ThrottlingBackoffBase > 0 {
+			retryer = &withThrottlingBackoff{
+				RetryerV2:  asRetryerV2(retryer),
+				base:       c.ThrottlingBackoffBase,
+				maxBackoff: retry_sdkv2.DefaultMaxBackoff,
+				throttles:  retry_sdkv2.IsErrorThrottles(retry_sdkv2.DefaultThrottles),
+			}
+		}
+
+		if aws_sdkv2.RetryMode(c.Mode) == aws_sdkv2.RetryModeAdaptive {
+			retryer = &withAdaptiveMode{
+				RetryerV2: asRetryerV2(retryer),
+				adaptive:  retry_sdkv2.NewAdaptiveMode(),
+			}
+		}
+
+		return retryer
+	}
+}
+
+// retryerV1 returns an AWS SDK for Go v1 retryer.
+// The v1 SDK counts retries rather than attempts, so a configured maximum number of attempts
+// overrides maxRetries with one fewer retries. The v1 SDK has no adaptive retry mode.
+func (c *RetryConfig) retryerV1(maxRetries int) client.DefaultRetryer {
+	if c.MaxAttempts > 0 {
+		maxRetries = c.MaxAttempts - 1
+	}
+
+	retryer := client.DefaultRetryer{
+		NumMaxRetries: maxRetries,
+	}
+
+	if c.ThrottlingBackoffBase > 0 {
+		retryer.MinThrottleDelay = c.ThrottlingBackoffBase
+	}
+
+	return retryer
+}
+
+// withThrottlingBackoff applies exponential backoff with full jitter from a configurable base to throttled requests.
+// Other retryable errors use the wrapped retryer's backoff.
+type withThrottlingBackoff struct {
+	aws_sdkv2.RetryerV2
+	base       time.Duration
+	maxBackoff time.Duration
+	throttles  retry_sdkv2.IsErrorThrottles
+}
+
+func (r *withThrottlingBackoff) RetryDelay(attempt int, err error) (time.Duration, error) {
+	if r.throttles.IsErrorThrottle(err) != aws_sdkv2.TrueTernary {
+		return r.RetryerV2.RetryDelay(attempt, err)
+	}
+
+	delay := r.maxBackoff
+	if attempt < 32 {
+		if v := r.base << uint(attempt); v > 0 && v < delay {
+			delay = v
+		}
+	}
+
+	return time.Duration(rand.Int63n(int64(delay)) + 1), nil
+}
+
+// withAdaptiveMode adds the client-side rate limiting of the adaptive retry mode to the wrapped retryer.
+// Retry decisions and delays are left to the wrapped retryer.
+type withAdaptiveMode struct {
+	aws_sdkv2.RetryerV2
+	adaptive *retry_sdkv2.AdaptiveMode
+}
+
+func (r *withAdaptiveMode) GetInitialToken() func(error) error {
+	return r.adaptive.GetInitialToken()
+}
+
+func (r *withAdaptiveMode) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	return r.adaptive.GetAttemptToken(ctx)
+}
+
+type wrappedRetryer struct {
+	aws_sdkv2.Retryer
+}
+
+func (w wrappedRetryer) GetAttemptToken(context.Context) (func(error) error, error) {
+	return w.Retryer.GetInitialToken(), nil
+}
+
+func asRetryerV2(r aws_sdkv2.Retryer) aws_sdkv2.RetryerV2 {
+	if v, ok := r.(aws_sdkv2.RetryerV2); ok {
+		return v
+	}
+
+	return wrappedRetryer{Retryer: r}
+}
@@ -0,0 +1,185 @@
+package conns
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+)
+
+// testRetryer stands in for the retryer configured by aws-sdk-go-base.
+type testRetryer struct {
+	aws_sdkv2.RetryerV2
+}
+
+func (r *testRetryer) RetryDelay(int, error) (time.Duration, error) {
+	return -1, nil
+}
+
+func TestRetryConfigRetryerV2(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		config       RetryConfig
+		wantAdaptive bool
+		wantAttempts int
+	}{
+		{
+			name:         "default",
+			config:       RetryConfig{},
+			wantAttempts: retry_sdkv2.DefaultMaxAttempts,
+		},
+		{
+			name: "standard",
+			config: RetryConfig{
+				MaxAttempts: 10,
+				Mode:        "standard",
+			},
+			wantAttempts: 10,
+		},
+		{
+			name: "adaptive",
+			config: RetryConfig{
+				MaxAttempts: 5,
+				Mode:        "adaptive",
+			},
+			wantAdaptive: true,
+			wantAttempts: 5,
+		},
+		{
+			name: "throttling backoff",
+			config: RetryConfig{
+				ThrottlingBackoffBase: time.Second,
+			},
+			wantAttempts: retry_sdkv2.DefaultMaxAttempts,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			retryer := testCase.config.retryerV2(func() aws_sdkv2.Retryer {
+				return &testRetryer{RetryerV2: retry_sdkv2.NewStandard()}
+			})()
+
+			if _, ok := retryer.(*withAdaptiveMode); ok != testCase.wantAdaptive {
+				t.Errorf("got adaptive %t, expected %t", ok, testCase.wantAdaptive)
+			}
+
+			if got, want := retryer.MaxAttempts(), testCase.wantAttempts; got != want {
+				t.Errorf("got max attempts %d, expected %d", got, want)
+			}
+
+			if delay, _ := retryer.RetryDelay(1, errors.New("test")); delay != -1 {
+				t.Errorf("got delay %s, expected the wrapped retryer's delay", delay)
+			}
+		})
+	}
+}
+
+func TestRetryConfigRetryerV1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		config           RetryConfig
+		maxRetries       int
+		wantMaxRetries   int
+		wantThrottleBase time.Duration
+	}{
+		{
+			name:           "default",
+			config:         RetryConfig{},
+			maxRetries:     25,
+			wantMaxRetries: 25,
+		},
+		{
+			name: "max attempts",
+			config: RetryConfig{
+				MaxAttempts: 10,
+			},
+			maxRetries:     25,
+			wantMaxRetries: 9,
+		},
+		{
+			name: "single attempt",
+			config: RetryConfig{
+				MaxAttempts: 1,
+			},
+			maxRetries:     25,
+			wantMaxRetries: 0,
+		},
+		{
+			name: "throttling backoff",
+			config: RetryConfig{
+				ThrottlingBackoffBase: time.Second,
+			},
+			maxRetries:       25,
+			wantMaxRetries:   25,
+			wantThrottleBase: time.Second,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			retryer := testCase.config.retryerV1(testCase.maxRetries)
+
+			if got, want := retryer.MaxRetries(), testCase.wantMaxRetries; got != want {
+				t.Errorf("got max retries %d, expected %d", got, want)
+			}
+
+			if got, want := retryer.MinThrottleDelay, testCase.wantThrottleBase; got != want {
+				t.Errorf("got min throttle delay %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestWithThrottlingBackoffRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	backoff := &withThrottlingBackoff{
+		RetryerV2:  &testRetryer{RetryerV2: retry_sdkv2.NewStandard()},
+		base:       100 * time.Millisecond,
+		maxBackoff: retry_sdkv2.DefaultMaxBackoff,
+		throttles:  retry_sdkv2.IsErrorThrottles(retry_sdkv2.DefaultThrottles),
+	}
+
+	throttleErr := &smithy.GenericAPIError{Code: "ThrottlingException"}
+
+	for _, attempt := range []int{0, 1, 3, 10, 64} {
+		delay, err := backoff.RetryDelay(attempt, throttleErr)
+
+		if err != nil {
+			t.Fatalf("attempt %d: unexpected error: %s", attempt, err)
+		}
+
+		limit := backoff.maxBackoff
+		if attempt < 8 {
+			limit = backoff.base << uint(attempt)
+		}
+
+		if delay <= 0 || delay > limit {
+			t.Errorf("attempt %d: got delay %s, expected (0, %s]", attempt, delay, limit)
+		}
+	}
+
+	delay, err := backoff.RetryDelay(1, errors.New("test"))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if delay != -1 {
+		t.Errorf("got delay %s for non-throttling error, expected the wrapped retryer's delay", delay)
+	}
+}
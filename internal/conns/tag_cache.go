@@ -0,0 +1,141 @@
+package conns
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// TagCache caches resource tags read in bulk using the Resource Groups Tagging API.
+// The tags of all resources in a service namespace and Region are read the first time
+// a resource in that namespace is looked up.
+type TagCache struct {
+	mu          sync.Mutex
+	namespaces  map[string]*tagCacheNamespace
+	invalidated map[string]struct{}
+}
+
+type tagCacheNamespace struct {
+	once sync.Once
+	tags map[string]tftags.KeyValueTags
+	err  error
+}
+
+func NewTagCache() *TagCache {
+	return &TagCache{
+		namespaces:  make(map[string]*tagCacheNamespace),
+		invalidated: make(map[string]struct{}),
+	}
+}
+
+// Get returns the cached tags of the resource with the specified ARN.
+// ok is false if the resource's tags are not cached and must be read from the resource's service API,
+// e.g. because the resource type is not supported by the Resource Groups Tagging API or has no tags.
+func (c *TagCache) Get(ctx context.Context, conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, resourceARN string) (tftags.KeyValueTags, bool) {
+	if c == nil || !arn.IsARN(resourceARN) {
+		return nil, false
+	}
+
+	parsedARN, err := arn.Parse(resourceARN)
+
+	if err != nil {
+		return nil, false
+	}
+
+	// The Resource Groups Tagging API only returns resources in the client's Region.
+	if region := aws.StringValue(conn.Config.Region); parsedARN.Region == "" || parsedARN.Region != region {
+		return nil, false
+	}
+
+	key := parsedARN.Service + "/" + parsedARN.Region
+
+	c.mu.Lock()
+	if _, ok := c.invalidated[resourceARN]; ok {
+		c.mu.Unlock()
+		return nil, false
+	}
+	ns, ok := c.namespaces[key]
+	if !ok {
+		ns = &tagCacheNamespace{}
+		c.namespaces[key] = ns
+	}
+	c.mu.Unlock()
+
+	ns.once.Do(func() {
+		ns.tags, ns.err = getResourceTags(ctx, conn, parsedARN.Service)
+
+		if ns.err != nil {
+			tflog.Warn(ctx, "Reading tags using the Resource Groups Tagging API, falling back to service APIs", map[string]any{
+				"tf_aws.tag_cache.namespace": key,
+				"error":                      ns.err.Error(),
+			})
+		}
+	})
+
+	if ns.err != nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.invalidated[resourceARN]; ok {
+		return nil, false
+	}
+
+	tags, ok := ns.tags[resourceARN]
+
+	return tags, ok
+}
+
+// Invalidate removes the cached tags of the resource with the specified ARN, e.g. after its tags are updated.
+func (c *TagCache) Invalidate(resourceARN string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.invalidated[resourceARN] = struct{}{}
+}
+
+func getResourceTags(ctx context.Context, conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, serviceNamespace string) (map[string]tftags.KeyValueTags, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: aws.StringSlice([]string{serviceNamespace}),
+	}
+	output := make(map[string]tftags.KeyValueTags)
+
+	err := conn.GetResourcesPagesWithContext(ctx, input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			if v == nil {
+				continue
+			}
+
+			m := make(map[string]string, len(v.Tags))
+
+			for _, tag := range v.Tags {
+				m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+
+			output[aws.StringValue(v.ResourceARN)] = tftags.New(ctx, m)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,91 @@
+package conns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestTagCacheGet(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region: aws.String("us-west-2"), //lintignore:AWSAT003
+	}))
+	conn := resourcegroupstaggingapi.New(sess)
+
+	vpcARN := "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678"          //lintignore:AWSAT003,AWSAT005
+	subnetARN := "arn:aws:ec2:us-west-2:123456789012:subnet/subnet-12345678" //lintignore:AWSAT003,AWSAT005
+
+	cache := NewTagCache()
+
+	// Simulate an already loaded namespace.
+	ns := &tagCacheNamespace{
+		tags: map[string]tftags.KeyValueTags{
+			vpcARN: tftags.New(ctx, map[string]string{"key1": "value1"}),
+		},
+	}
+	ns.once.Do(func() {})
+	cache.namespaces["ec2/us-west-2"] = ns //lintignore:AWSAT003
+
+	testCases := []struct {
+		name       string
+		cache      *TagCache
+		identifier string
+		wantOK     bool
+	}{
+		{
+			name:       "nil cache",
+			identifier: vpcARN,
+		},
+		{
+			name:       "not an ARN",
+			cache:      cache,
+			identifier: "vpc-12345678",
+		},
+		{
+			name:       "other Region",
+			cache:      cache,
+			identifier: "arn:aws:ec2:us-east-1:123456789012:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			name:       "global resource",
+			cache:      cache,
+			identifier: "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+		},
+		{
+			name:       "not tagged",
+			cache:      cache,
+			identifier: subnetARN,
+		},
+		{
+			name:       "cached",
+			cache:      cache,
+			identifier: vpcARN,
+			wantOK:     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tags, ok := testCase.cache.Get(ctx, conn, testCase.identifier)
+
+		if ok != testCase.wantOK {
+			t.Errorf("%s: got ok %t, expected %t", testCase.name, ok, testCase.wantOK)
+		}
+
+		if ok && tags.KeyValue("key1") == nil {
+			t.Errorf("%s: expected tag key1", testCase.name)
+		}
+	}
+
+	cache.Invalidate(vpcARN)
+
+	if _, ok := cache.Get(ctx, conn, vpcARN); ok {
+		t.Error("got cached tags after Invalidate")
+	}
+}
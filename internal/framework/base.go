@@ -51,15 +51,24 @@ func (r *ResourceWithConfigure) Configure(_ context.Context, request resource.Co
 	}
 }
 
+// defaultTagsConfig returns the provider's default tags configuration for the resource.
+func (r *ResourceWithConfigure) defaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		return inContext.DefaultConfig
+	}
+
+	return r.Meta().DefaultTagsConfig
+}
+
 // ExpandTags returns the API tags for the specified "tags" value.
 func (r *ResourceWithConfigure) ExpandTags(ctx context.Context, tags types.Map) tftags.KeyValueTags {
-	return r.Meta().DefaultTagsConfig.MergeTags(tftags.New(ctx, tags))
+	return r.defaultTagsConfig(ctx).MergeTags(tftags.New(ctx, tags))
 }
 
 // FlattenTags returns the "tags" value from the specified API tags.
 func (r *ResourceWithConfigure) FlattenTags(ctx context.Context, apiTags tftags.KeyValueTags) types.Map {
 	// AWS APIs often return empty lists of tags when none have been configured.
-	if v := apiTags.IgnoreAWS().IgnoreConfig(r.Meta().IgnoreTagsConfig).RemoveDefaultConfig(r.Meta().DefaultTagsConfig).Map(); len(v) == 0 {
+	if v := apiTags.IgnoreAWS().IgnoreConfig(r.Meta().IgnoreTagsConfig).RemoveDefaultConfig(r.defaultTagsConfig(ctx)).Map(); len(v) == 0 {
 		return tftags.Null
 	} else {
 		return flex.FlattenFrameworkStringValueMapLegacy(ctx, v)
@@ -78,7 +87,7 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 		return
 	}
 
-	defaultTagsConfig := r.Meta().DefaultTagsConfig
+	defaultTagsConfig := r.defaultTagsConfig(ctx)
 	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
 
 	var planTags types.Map
@@ -95,6 +104,30 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 
 			allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
+			// Default tag values not known until apply leave tags_all unknown, unless
+			// suppress_computed_diff resolves all of them from the existing tags_all.
+			if unknownTags := defaultTagsConfig.UnknownTags(resourceTags).IgnoreConfig(ignoreTagsConfig); len(unknownTags) > 0 {
+				var ok bool
+
+				if defaultTagsConfig.SuppressComputedDiff && !request.State.Raw.IsNull() {
+					var stateTagsAll types.Map
+
+					response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("tags_all"), &stateTagsAll)...)
+
+					if response.Diagnostics.HasError() {
+						return
+					}
+
+					allTags, ok = allTags.ResolveUnknownValues(unknownTags, tftags.New(ctx, stateTagsAll))
+				}
+
+				if !ok {
+					response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("tags_all"), tftags.Unknown)...)
+
+					return
+				}
+			}
+
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("tags_all"), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
 		} else {
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("tags_all"), tftags.Unknown)...)
@@ -388,7 +388,9 @@ func (r tagsInterceptor) read(ctx context.Context, request resource.ReadRequest,
 					// If the service package has a generic resource list tags methods, call it.
 					var err error
 
-					if v, ok := sp.(interface {
+					if tags, ok := meta.TagCache.Get(ctx, meta.ResourceGroupsTaggingAPIConn(), identifier); ok {
+						tagsInContext.TagsOut = types.Some(tags)
+					} else if v, ok := sp.(interface {
 						ListTags(context.Context, any, string) error
 					}); ok {
 						err = v.ListTags(ctx, meta, identifier) // Sets tags in Context
@@ -535,6 +537,8 @@ func (r tagsInterceptor) update(ctx context.Context, request resource.UpdateRequ
 
 						return ctx, diags
 					}
+
+					meta.TagCache.Invalidate(identifier)
 				}
 			}
 			// TODO If the only change was to tags it would be nice to not call the resource's U handler.
@@ -5,8 +5,12 @@ import (
 	"fmt"
 
 	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -134,12 +139,13 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"use_tag_cache": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Read resource tags in bulk using the Resource Groups Tagging API when refreshing, falling back to each service's API.",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -229,6 +235,15 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"ignore_resources": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource type patterns, e.g. `aws_autoscaling_*`, to exclude from default resource tags.",
+						},
+						"suppress_computed_diff": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Resolve default tag values that are not known until apply from the resource's existing `tags_all` instead of planning `tags_all` as computed.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -258,6 +273,34 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"retry": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings for retrying AWS API requests.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+							Description: "The maximum number of attempts, including the initial request, for an AWS API request.",
+						},
+						"mode": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("standard", "adaptive"),
+							},
+							Description: "The retry mode. Valid values are `standard` and `adaptive`.",
+						},
+						"throttling_backoff_base": schema.StringAttribute{
+							Optional:    true,
+							Description: "The base delay before retrying a throttled request, e.g. `1s`.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -268,10 +311,84 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRequest, response *provider.ConfigureResponse) {
 	// Provider's parsed configuration (its instance state) is available through the primary provider's Meta() method.
 	v := p.Primary.Meta()
+
+	// The primary provider reads no default_tags if any tag value is not known until apply.
+	if meta, ok := v.(*conns.AWSClient); ok {
+		var defaultTags types.List
+
+		response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("default_tags"), &defaultTags)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if defaultTagsConfig := expandDefaultTagsWithUnknownValues(ctx, defaultTags); defaultTagsConfig != nil {
+			meta.DefaultTagsConfig = defaultTagsConfig
+		}
+	}
+
 	response.DataSourceData = v
 	response.ResourceData = v
 }
 
+// expandDefaultTagsWithUnknownValues returns the default tags configuration
+// if any default tag value is not known until apply, otherwise nil.
+func expandDefaultTagsWithUnknownValues(ctx context.Context, tfList types.List) *tftags.DefaultConfig {
+	if tfList.IsNull() || tfList.IsUnknown() || len(tfList.Elements()) == 0 {
+		return nil
+	}
+
+	tfObject, ok := tfList.Elements()[0].(types.Object)
+
+	if !ok || tfObject.IsNull() || tfObject.IsUnknown() {
+		return nil
+	}
+
+	attributes := tfObject.Attributes()
+	tags, ok := attributes["tags"].(types.Map)
+
+	if !ok || tags.IsNull() || tags.IsUnknown() {
+		return nil
+	}
+
+	knownTags := make(map[string]string)
+	var unknownKeys []string
+
+	for k, v := range tags.Elements() {
+		v, ok := v.(types.String)
+
+		if !ok || v.IsNull() {
+			continue
+		}
+
+		if v.IsUnknown() {
+			unknownKeys = append(unknownKeys, k)
+			continue
+		}
+
+		knownTags[k] = v.ValueString()
+	}
+
+	if len(unknownKeys) == 0 {
+		return nil
+	}
+
+	defaultTagsConfig := &tftags.DefaultConfig{
+		Tags:        tftags.New(ctx, knownTags),
+		UnknownKeys: tftags.New(ctx, unknownKeys),
+	}
+
+	if v, ok := attributes["ignore_resources"].(types.Set); ok {
+		defaultTagsConfig.IgnoreResources = flex.ExpandFrameworkStringValueSet(ctx, v)
+	}
+
+	if v, ok := attributes["suppress_computed_diff"].(types.Bool); ok {
+		defaultTagsConfig.SuppressComputedDiff = v.ValueBool()
+	}
+
+	return defaultTagsConfig
+}
+
 // DataSources returns a slice of functions to instantiate each DataSource
 // implementation.
 //
@@ -296,11 +413,15 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				continue
 			}
 
+			metadataResponse := datasource.MetadataResponse{}
+			inner.Metadata(ctx, datasource.MetadataRequest{}, &metadataResponse)
+			typeName := metadataResponse.TypeName
+
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResourceType(typeName), meta.IgnoreTagsConfig)
 				}
 
 				return ctx
@@ -344,7 +465,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResourceType(typeName), meta.IgnoreTagsConfig)
 				}
 
 				return ctx
@@ -407,6 +528,31 @@ func endpointsBlock() schema.SetNestedBlock {
 	return schema.SetNestedBlock{
 		NestedObject: schema.NestedBlockObject{
 			Attributes: endpointsAttributes,
+			Blocks: map[string]schema.Block{
+				"transport": schema.ListNestedBlock{
+					Description: "HTTP transport settings for requests sent to the custom endpoints of the specified services",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"custom_ca_bundle": schema.StringAttribute{
+								Optional:    true,
+								Description: "File containing custom root and intermediate certificates.",
+							},
+							"http_proxy": schema.StringAttribute{
+								Optional:    true,
+								Description: "The address of an HTTP proxy to use when accessing the service endpoints.",
+							},
+							"services": schema.SetAttribute{
+								ElementType: types.StringType,
+								Required:    true,
+								Validators: []validator.Set{
+									setvalidator.ValueStringsAre(stringvalidator.OneOf(names.Aliases()...)),
+								},
+								Description: "Services, identified by their endpoints argument names, to apply these transport settings to.",
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -280,6 +280,8 @@ func (r tagsInterceptor) run(ctx context.Context, d schemaResourceData, meta any
 							if err != nil {
 								return ctx, sdkdiag.AppendErrorf(diags, "updating tags for %s %s (%s): %s", serviceName, resourceName, identifier, err)
 							}
+
+							meta.(*conns.AWSClient).TagCache.Invalidate(identifier)
 						}
 						// TODO If the only change was to tags it would be nice to not call the resource's U handler.
 					}
@@ -314,7 +316,9 @@ func (r tagsInterceptor) run(ctx context.Context, d schemaResourceData, meta any
 						// If the service package has a generic resource list tags methods, call it.
 						var err error
 
-						if v, ok := sp.(interface {
+						if tags, ok := cachedTags(ctx, meta.(*conns.AWSClient), why, identifier); ok {
+							tagsInContext.TagsOut = types.Some(tags)
+						} else if v, ok := sp.(interface {
 							ListTags(context.Context, any, string) error
 						}); ok {
 							err = v.ListTags(ctx, meta, identifier) // Sets tags in Context
@@ -59,6 +59,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"ignore_resources": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource type patterns, e.g. `aws_autoscaling_*`, to exclude from default resource tags.",
+						},
+						"suppress_computed_diff": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Resolve default tag values that are not known until apply from the resource's existing `tags_all` instead of planning `tags_all` as computed.",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -143,6 +155,35 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings for retrying AWS API requests.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntAtLeast(1),
+							ConflictsWith: []string{"max_retries"},
+							Description:   "The maximum number of attempts, including the initial request, for an AWS API request.",
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"standard", "adaptive"}, false),
+							Description:  "The retry mode. Valid values are `standard` and `adaptive`.",
+						},
+						"throttling_backoff_base": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "The base delay before retrying a throttled request, e.g. `1s`.",
+						},
+					},
+				},
+			},
 			"s3_use_path_style": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -216,6 +257,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"use_tag_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Read resource tags in bulk using the Resource Groups Tagging API when refreshing, falling back to each service's API.",
+			},
 		},
 
 		// Data sources and resources implemented using Terraform Plugin SDK
@@ -257,7 +303,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResourceType(typeName), v.IgnoreTagsConfig)
 				}
 
 				return ctx
@@ -308,7 +354,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResourceType(typeName), v.IgnoreTagsConfig)
 				}
 
 				return ctx
@@ -432,20 +478,30 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		Token:                          d.Get("token").(string),
 		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
+		UseTagCache:                    d.Get("use_tag_cache").(bool),
 	}
 
 	if v, ok := d.GetOk("allowed_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		// Roles are assumed in order, each using the credentials of the previous one.
+		for _, tfMapRaw := range v.([]interface{}) {
+			assumeRole := &awsbase.AssumeRole{}
+
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				assumeRole = expandAssumeRole(ctx, tfMap)
+			}
+
+			config.AssumeRole = append(config.AssumeRole, assumeRole)
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -468,6 +524,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		}
 
 		config.Endpoints = endpoints
+
+		endpointTransports, err := expandEndpointTransports(ctx, v.(*schema.Set).List())
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.EndpointTransports = endpointTransports
 	}
 
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
@@ -482,6 +546,21 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("retry"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		retryConfig, err := expandRetry(v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RetryConfig = retryConfig
+
+		// max_retries counts retries after the initial request, max_attempts includes it.
+		if config.RetryConfig.MaxAttempts == 0 {
+			config.RetryConfig.MaxAttempts = config.MaxRetries + 1
+		}
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -517,7 +596,6 @@ func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -641,6 +719,15 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+// removedEndpointServiceKeys are the endpoints arguments of services that the
+// AWS SDK for Go no longer includes. They are accepted but ignored.
+var removedEndpointServiceKeys = []string{
+	"alexaforbusiness",
+	"honeycode",
+	"macie",
+	"mobile",
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -653,6 +740,44 @@ func endpointsSchema() *schema.Schema {
 		}
 	}
 
+	for _, serviceKey := range removedEndpointServiceKeys {
+		endpointsAttributes[serviceKey] = &schema.Schema{
+			Type:       schema.TypeString,
+			Optional:   true,
+			Default:    "",
+			Deprecated: fmt.Sprintf("The AWS SDK for Go no longer includes the %s service. This argument is ignored.", serviceKey),
+		}
+	}
+
+	endpointsAttributes["transport"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "HTTP transport settings for requests sent to the custom endpoints of the specified services. Services without a custom endpoint are not affected.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"custom_ca_bundle": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "File containing custom root and intermediate certificates.",
+				},
+				"http_proxy": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The address of an HTTP proxy to use when accessing the service endpoints.",
+				},
+				"services": {
+					Type:        schema.TypeSet,
+					Required:    true,
+					Description: "Services, identified by their endpoints argument names, to apply these transport settings to.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+					},
+				},
+			},
+		},
+	}
+
 	return &schema.Schema{
 		Type:     schema.TypeSet,
 		Optional: true,
@@ -755,6 +880,14 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 
 	defaultConfig := &tftags.DefaultConfig{}
 
+	if v, ok := tfMap["ignore_resources"].(*schema.Set); ok {
+		defaultConfig.IgnoreResources = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["suppress_computed_diff"].(bool); ok {
+		defaultConfig.SuppressComputedDiff = v
+	}
+
 	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
@@ -762,6 +895,86 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return defaultConfig
 }
 
+func expandEndpointTransports(_ context.Context, tfList []interface{}) ([]*conns.EndpointTransport, error) {
+	var endpointTransports []*conns.EndpointTransport
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		v, ok := tfMap["transport"].([]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			endpointTransport := &conns.EndpointTransport{}
+
+			if v, ok := tfMap["custom_ca_bundle"].(string); ok && v != "" {
+				endpointTransport.CustomCABundle = v
+			}
+
+			if v, ok := tfMap["http_proxy"].(string); ok && v != "" {
+				endpointTransport.HTTPProxy = v
+			}
+
+			if v, ok := tfMap["services"].(*schema.Set); ok {
+				for _, alias := range flex.ExpandStringValueSet(v) {
+					pkg, err := names.ProviderPackageForAlias(alias)
+
+					if err != nil {
+						return nil, fmt.Errorf("failed to assign endpoint transport (%s): %w", alias, err)
+					}
+
+					endpointTransport.Services = append(endpointTransport.Services, pkg)
+				}
+			}
+
+			endpointTransports = append(endpointTransports, endpointTransport)
+		}
+	}
+
+	return endpointTransports, nil
+}
+
+func expandRetry(tfMap map[string]interface{}) (*conns.RetryConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	retryConfig := &conns.RetryConfig{}
+
+	if v, ok := tfMap["max_attempts"].(int); ok && v != 0 {
+		retryConfig.MaxAttempts = v
+	}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		retryConfig.Mode = v
+	}
+
+	if v, ok := tfMap["throttling_backoff_base"].(string); ok && v != "" {
+		duration, err := time.ParseDuration(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing retry.throttling_backoff_base: %w", err)
+		}
+
+		retryConfig.ThrottlingBackoffBase = duration
+	}
+
+	return retryConfig, nil
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
@@ -87,6 +87,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emr"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/events"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/evidently"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/finspace"
@@ -147,6 +148,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/osis"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
@@ -159,6 +161,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
@@ -180,6 +183,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/serverlessrepo"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalogappregistry"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ses"
@@ -199,12 +203,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/tnb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/wisdom"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/worklink"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
@@ -294,6 +300,7 @@ func servicePackages(context.Context) []conns.ServicePackage {
 		emr.ServicePackage,
 		emrcontainers.ServicePackage,
 		emrserverless.ServicePackage,
+		entityresolution.ServicePackage,
 		events.ServicePackage,
 		evidently.ServicePackage,
 		finspace.ServicePackage,
@@ -354,6 +361,7 @@ func servicePackages(context.Context) []conns.ServicePackage {
 		opensearchserverless.ServicePackage,
 		opsworks.ServicePackage,
 		organizations.ServicePackage,
+		osis.ServicePackage,
 		outposts.ServicePackage,
 		pinpoint.ServicePackage,
 		pipes.ServicePackage,
@@ -366,6 +374,7 @@ func servicePackages(context.Context) []conns.ServicePackage {
 		redshift.ServicePackage,
 		redshiftdata.ServicePackage,
 		redshiftserverless.ServicePackage,
+		resiliencehub.ServicePackage,
 		resourceexplorer2.ServicePackage,
 		resourcegroups.ServicePackage,
 		resourcegroupstaggingapi.ServicePackage,
@@ -387,6 +396,7 @@ func servicePackages(context.Context) []conns.ServicePackage {
 		securitylake.ServicePackage,
 		serverlessrepo.ServicePackage,
 		servicecatalog.ServicePackage,
+		servicecatalogappregistry.ServicePackage,
 		servicediscovery.ServicePackage,
 		servicequotas.ServicePackage,
 		ses.ServicePackage,
@@ -406,12 +416,14 @@ func servicePackages(context.Context) []conns.ServicePackage {
 		swf.ServicePackage,
 		synthetics.ServicePackage,
 		timestreamwrite.ServicePackage,
+		tnb.ServicePackage,
 		transcribe.ServicePackage,
 		transfer.ServicePackage,
 		vpclattice.ServicePackage,
 		waf.ServicePackage,
 		wafregional.ServicePackage,
 		wafv2.ServicePackage,
+		wisdom.ServicePackage,
 		worklink.ServicePackage,
 		workspaces.ServicePackage,
 		xray.ServicePackage,
@@ -90,6 +90,8 @@ func tagsUpdateFunc(ctx context.Context, d schemaResourceData, sp conns.ServiceP
 		return ctx, sdkdiag.AppendErrorf(diags, "updating tags for %s %s (%s): %s", serviceName, resourceName, identifier, err)
 	}
 
+	meta.(*conns.AWSClient).TagCache.Invalidate(identifier)
+
 	return ctx, diags
 }
 
@@ -157,3 +159,13 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 
 	return ctx, diags
 }
+
+// cachedTags returns the tags of the specified resource from the provider's tag cache, if enabled.
+// The cache is only used when refreshing; after Create and Update tags are always read from the service API.
+func cachedTags(ctx context.Context, meta *conns.AWSClient, why why, identifier string) (tftags.KeyValueTags, bool) {
+	if why != Read || meta.TagCache == nil {
+		return nil, false
+	}
+
+	return meta.TagCache.Get(ctx, meta.ResourceGroupsTaggingAPIConn(), identifier)
+}
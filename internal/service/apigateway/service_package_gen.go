@@ -57,6 +57,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceVPCLink,
 			TypeName: "aws_api_gateway_vpc_link",
 		},
+		{
+			Factory:  DataSourceVPCLinks,
+			TypeName: "aws_api_gateway_vpc_links",
+		},
 	}
 }
 
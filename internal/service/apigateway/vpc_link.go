@@ -128,36 +128,38 @@ func resourceVPCLinkUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayConn()
 
-	operations := make([]*apigateway.PatchOperation, 0)
-
-	if d.HasChange("name") {
-		operations = append(operations, &apigateway.PatchOperation{
-			Op:    aws.String("replace"),
-			Path:  aws.String("/name"),
-			Value: aws.String(d.Get("name").(string)),
-		})
-	}
+	if d.HasChanges("name", "description") {
+		operations := make([]*apigateway.PatchOperation, 0)
+
+		if d.HasChange("name") {
+			operations = append(operations, &apigateway.PatchOperation{
+				Op:    aws.String("replace"),
+				Path:  aws.String("/name"),
+				Value: aws.String(d.Get("name").(string)),
+			})
+		}
 
-	if d.HasChange("description") {
-		operations = append(operations, &apigateway.PatchOperation{
-			Op:    aws.String("replace"),
-			Path:  aws.String("/description"),
-			Value: aws.String(d.Get("description").(string)),
-		})
-	}
+		if d.HasChange("description") {
+			operations = append(operations, &apigateway.PatchOperation{
+				Op:    aws.String("replace"),
+				Path:  aws.String("/description"),
+				Value: aws.String(d.Get("description").(string)),
+			})
+		}
 
-	input := &apigateway.UpdateVpcLinkInput{
-		VpcLinkId:       aws.String(d.Id()),
-		PatchOperations: operations,
-	}
+		input := &apigateway.UpdateVpcLinkInput{
+			VpcLinkId:       aws.String(d.Id()),
+			PatchOperations: operations,
+		}
 
-	_, err := conn.UpdateVpcLinkWithContext(ctx, input)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating API Gateway VPC Link (%s): %s", d.Id(), err)
-	}
+		_, err := conn.UpdateVpcLinkWithContext(ctx, input)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating API Gateway VPC Link (%s): %s", d.Id(), err)
+		}
 
-	if err := waitVPCLinkAvailable(ctx, conn, d.Id()); err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating API Gateway VPC Link (%s): waiting for completion: %s", d.Id(), err)
+		if err := waitVPCLinkAvailable(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating API Gateway VPC Link (%s): waiting for completion: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceVPCLinkRead(ctx, d, meta)...)
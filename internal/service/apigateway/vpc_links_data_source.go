@@ -0,0 +1,67 @@
+package apigateway
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_api_gateway_vpc_links")
+func DataSourceVPCLinks() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVPCLinksRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceVPCLinksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	input := &apigateway.GetVpcLinksInput{}
+	var ids, names []*string
+
+	err := conn.GetVpcLinksPagesWithContext(ctx, input, func(page *apigateway.GetVpcLinksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v == nil {
+				continue
+			}
+
+			ids = append(ids, v.Id)
+			names = append(names, v.Name)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway VPC Links: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("ids", aws.StringValueSlice(ids))
+	d.Set("names", aws.StringValueSlice(names))
+
+	return diags
+}
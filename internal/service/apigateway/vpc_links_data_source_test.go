@@ -0,0 +1,41 @@
+package apigateway_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAPIGatewayVPCLinksDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandString(5)
+	dataSourceName := "data.aws_api_gateway_vpc_links.test"
+	resourceName := "aws_api_gateway_vpc_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCLinksDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "ids.#", 0),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", resourceName, "id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCLinksDataSourceConfig_basic(rName string) string {
+	return testAccVPCLinkConfig_basic(rName, "test") + `
+data "aws_api_gateway_vpc_links" "test" {
+  depends_on = [aws_api_gateway_vpc_link.test]
+}
+`
+}
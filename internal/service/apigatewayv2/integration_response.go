@@ -51,6 +51,11 @@ func ResourceIntegrationResponse() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"response_parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"response_templates": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -76,6 +81,9 @@ func resourceIntegrationResponseCreate(ctx context.Context, d *schema.ResourceDa
 	if v, ok := d.GetOk("content_handling_strategy"); ok {
 		req.ContentHandlingStrategy = aws.String(v.(string))
 	}
+	if v, ok := d.GetOk("response_parameters"); ok {
+		req.ResponseParameters = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
 	if v, ok := d.GetOk("response_templates"); ok {
 		req.ResponseTemplates = flex.ExpandStringMap(v.(map[string]interface{}))
 	}
@@ -114,6 +122,10 @@ func resourceIntegrationResponseRead(ctx context.Context, d *schema.ResourceData
 
 	d.Set("content_handling_strategy", resp.ContentHandlingStrategy)
 	d.Set("integration_response_key", resp.IntegrationResponseKey)
+	err = d.Set("response_parameters", flex.PointersMapToStringList(resp.ResponseParameters))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting response_parameters: %s", err)
+	}
 	err = d.Set("response_templates", flex.PointersMapToStringList(resp.ResponseTemplates))
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting response_templates: %s", err)
@@ -138,6 +150,9 @@ func resourceIntegrationResponseUpdate(ctx context.Context, d *schema.ResourceDa
 	if d.HasChange("integration_response_key") {
 		req.IntegrationResponseKey = aws.String(d.Get("integration_response_key").(string))
 	}
+	if d.HasChange("response_parameters") {
+		req.ResponseParameters = flex.ExpandStringMap(d.Get("response_parameters").(map[string]interface{}))
+	}
 	if d.HasChange("response_templates") {
 		req.ResponseTemplates = flex.ExpandStringMap(d.Get("response_templates").(map[string]interface{}))
 	}
@@ -42,6 +42,22 @@ func ResourceRouteResponse() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"response_parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"required": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"response_parameter_key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"route_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -70,6 +86,9 @@ func resourceRouteResponseCreate(ctx context.Context, d *schema.ResourceData, me
 	if v, ok := d.GetOk("response_models"); ok {
 		req.ResponseModels = flex.ExpandStringMap(v.(map[string]interface{}))
 	}
+	if v, ok := d.GetOk("response_parameter"); ok && v.(*schema.Set).Len() > 0 {
+		req.ResponseParameters = expandRouteResponseParameters(v.(*schema.Set).List())
+	}
 
 	log.Printf("[DEBUG] Creating API Gateway v2 route response: %s", req)
 	resp, err := conn.CreateRouteResponseWithContext(ctx, req)
@@ -104,6 +123,9 @@ func resourceRouteResponseRead(ctx context.Context, d *schema.ResourceData, meta
 	if err := d.Set("response_models", flex.PointersMapToStringList(resp.ResponseModels)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting response_models: %s", err)
 	}
+	if err := d.Set("response_parameter", flattenRouteResponseParameters(resp.ResponseParameters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting response_parameter: %s", err)
+	}
 	d.Set("route_response_key", resp.RouteResponseKey)
 
 	return diags
@@ -124,6 +146,12 @@ func resourceRouteResponseUpdate(ctx context.Context, d *schema.ResourceData, me
 	if d.HasChange("response_models") {
 		req.ResponseModels = flex.ExpandStringMap(d.Get("response_models").(map[string]interface{}))
 	}
+	if d.HasChange("response_parameter") {
+		req.ResponseParameters = expandRouteResponseParameters(d.Get("response_parameter").(*schema.Set).List())
+		if req.ResponseParameters == nil {
+			req.ResponseParameters = map[string]*apigatewayv2.ParameterConstraints{}
+		}
+	}
 	if d.HasChange("route_response_key") {
 		req.RouteResponseKey = aws.String(d.Get("route_response_key").(string))
 	}
@@ -169,3 +197,52 @@ func resourceRouteResponseImport(ctx context.Context, d *schema.ResourceData, me
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func expandRouteResponseParameters(tfList []interface{}) map[string]*apigatewayv2.ParameterConstraints {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := map[string]*apigatewayv2.ParameterConstraints{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &apigatewayv2.ParameterConstraints{}
+
+		if v, ok := tfMap["required"].(bool); ok {
+			apiObject.Required = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["response_parameter_key"].(string); ok && v != "" {
+			apiObjects[v] = apiObject
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenRouteResponseParameters(apiObjects map[string]*apigatewayv2.ParameterConstraints) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for k, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"required":               aws.BoolValue(apiObject.Required),
+			"response_parameter_key": k,
+		})
+	}
+
+	return tfList
+}
@@ -112,6 +112,51 @@ func TestAccAPIGatewayV2RouteResponse_model(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayV2RouteResponse_responseParameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	var apiId, routeId string
+	var v apigatewayv2.GetRouteResponseOutput
+	resourceName := "aws_apigatewayv2_route_response.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteResponseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRouteResponseConfig_responseParameters(rName, "route.response.header.x-test", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteResponseExists(ctx, resourceName, &apiId, &routeId, &v),
+					resource.TestCheckResourceAttr(resourceName, "response_parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "response_parameter.*", map[string]string{
+						"response_parameter_key": "route.response.header.x-test",
+						"required":               "true",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccRouteResponseImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRouteResponseConfig_responseParameters(rName, "route.response.header.x-test", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteResponseExists(ctx, resourceName, &apiId, &routeId, &v),
+					resource.TestCheckResourceAttr(resourceName, "response_parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "response_parameter.*", map[string]string{
+						"response_parameter_key": "route.response.header.x-test",
+						"required":               "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRouteResponseDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn()
@@ -226,3 +271,20 @@ resource "aws_apigatewayv2_route_response" "test" {
 }
 `)
 }
+
+func testAccRouteResponseConfig_responseParameters(rName, key string, required bool) string {
+	return acctest.ConfigCompose(
+		testAccRouteConfig_basicWebSocket(rName),
+		fmt.Sprintf(`
+resource "aws_apigatewayv2_route_response" "test" {
+  api_id             = aws_apigatewayv2_api.test.id
+  route_id           = aws_apigatewayv2_route.test.id
+  route_response_key = "$default"
+
+  response_parameter {
+    response_parameter_key = %[1]q
+    required               = %[2]t
+  }
+}
+`, key, required))
+}
@@ -51,8 +51,9 @@ func ResourceStage() *schema.Resource {
 							ValidateFunc: verify.ValidARN,
 						},
 						"format": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validAccessLogFormat,
 						},
 					},
 				},
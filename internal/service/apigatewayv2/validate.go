@@ -1,6 +1,11 @@
 package apigatewayv2
 
 import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -17,3 +22,28 @@ func validHTTPMethod() schema.SchemaValidateFunc {
 		"PUT",
 	}, false)
 }
+
+var accessLogFormatVariableRegexp = regexp.MustCompile(`\$context\.[A-Za-z0-9_.]+`)
+
+// validAccessLogFormat validates a stage access log format.
+// The format must log the request ID and, if it looks like JSON, must be valid
+// JSON once $context variables, quoted or not, are substituted.
+func validAccessLogFormat(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !strings.Contains(value, "$context.requestId") && !strings.Contains(value, "$context.extendedRequestId") {
+		errors = append(errors, fmt.Errorf("%s must include $context.requestId or $context.extendedRequestId", k))
+	}
+
+	if strings.HasPrefix(strings.TrimSpace(value), "{") {
+		if !json.Valid([]byte(accessLogFormatVariableRegexp.ReplaceAllString(value, "0"))) {
+			errors = append(errors, fmt.Errorf("%s is not valid JSON: %q", k, value))
+		}
+	}
+
+	return
+}
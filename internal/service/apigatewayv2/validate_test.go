@@ -0,0 +1,37 @@
+package apigatewayv2
+
+import (
+	"testing"
+)
+
+func TestValidAccessLogFormat(t *testing.T) {
+	t.Parallel()
+
+	validFormats := []string{
+		"$context.requestId",
+		"$context.identity.sourceIp $context.requestId",
+		"$context.extendedRequestId $context.routeKey $context.status",
+		`{"requestId":"$context.requestId","ip":"$context.identity.sourceIp"}`,
+		`{ "requestId": "$context.requestId", "status": $context.status, "responseLength": $context.responseLength }`,
+	}
+	for _, v := range validFormats {
+		_, errors := validAccessLogFormat(v, "format")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid access log format: %q", v, errors)
+		}
+	}
+
+	invalidFormats := []string{
+		"",
+		"$context.identity.sourceIp",
+		`{"ip":"$context.identity.sourceIp"}`,
+		`{"requestId":"$context.requestId",}`,
+		`{"requestId":"$context.requestId"`,
+	}
+	for _, v := range invalidFormats {
+		_, errors := validAccessLogFormat(v, "format")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid access log format", v)
+		}
+	}
+}
@@ -401,6 +401,12 @@ func ResourceFlow() *schema.Resource {
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"data_transfer_api": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(appflow.SalesforceDataTransferApi_Values(), false),
+												},
 												"error_handling_config": {
 													Type:     schema.TypeList,
 													Optional: true,
@@ -690,6 +696,39 @@ func ResourceFlow() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`arn:.*:kms:.*:[0-9]+:.*`), "must be a valid ARN of a Key Management Services (KMS) key"),
 			},
+			"metadata_catalog_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"glue_data_catalog": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"database_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"table_prefix": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"source_flow_config": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -880,6 +919,12 @@ func ResourceFlow() *schema.Resource {
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"data_transfer_api": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(appflow.SalesforceDataTransferApi_Values(), false),
+												},
 												"enable_dynamic_field_update": {
 													Type:     schema.TypeBool,
 													Optional: true,
@@ -907,6 +952,34 @@ func ResourceFlow() *schema.Resource {
 													Required:     true,
 													ValidateFunc: validation.All(validation.StringMatch(regexp.MustCompile(`\S+`), "must not contain any whitespace characters"), validation.StringLenBetween(1, 512)),
 												},
+												"pagination_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"max_page_size": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntBetween(1, 10000),
+															},
+														},
+													},
+												},
+												"parallelism_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"max_parallelism": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntBetween(1, 10),
+															},
+														},
+													},
+												},
 											},
 										},
 									},
@@ -1235,6 +1308,10 @@ func resourceFlowCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		in.KmsArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("metadata_catalog_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.MetadataCatalogConfig = expandMetadataCatalogConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	out, err := conn.CreateFlowWithContext(ctx, in)
 
 	if err != nil {
@@ -1285,6 +1362,14 @@ func resourceFlowRead(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	d.Set("kms_arn", out2.KmsArn)
 
+	if out2.MetadataCatalogConfig != nil {
+		if err := d.Set("metadata_catalog_config", []interface{}{flattenMetadataCatalogConfig(out2.MetadataCatalogConfig)}); err != nil {
+			return diag.Errorf("setting metadata_catalog_config: %s", err)
+		}
+	} else {
+		d.Set("metadata_catalog_config", nil)
+	}
+
 	if out2.SourceFlowConfig != nil {
 		if err := d.Set("source_flow_config", []interface{}{flattenSourceFlowConfig(out2.SourceFlowConfig)}); err != nil {
 			return diag.Errorf("setting source_flow_config: %s", err)
@@ -1324,6 +1409,10 @@ func resourceFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			in.Description = aws.String(d.Get(names.AttrDescription).(string))
 		}
 
+		if v, ok := d.GetOk("metadata_catalog_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			in.MetadataCatalogConfig = expandMetadataCatalogConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		log.Printf("[DEBUG] Updating AppFlow Flow (%s): %#v", d.Id(), in)
 		_, err := conn.UpdateFlowWithContext(ctx, in)
 
@@ -1714,6 +1803,10 @@ func expandSalesforceDestinationProperties(tfMap map[string]interface{}) *appflo
 
 	a := &appflow.SalesforceDestinationProperties{}
 
+	if v, ok := tfMap["data_transfer_api"].(string); ok && v != "" {
+		a.DataTransferApi = aws.String(v)
+	}
+
 	if v, ok := tfMap["error_handling_config"].([]interface{}); ok && len(v) > 0 {
 		a.ErrorHandlingConfig = expandErrorHandlingConfig(v[0].(map[string]interface{}))
 	}
@@ -1877,6 +1970,42 @@ func expandZendeskDestinationProperties(tfMap map[string]interface{}) *appflow.Z
 	return a
 }
 
+func expandMetadataCatalogConfig(tfMap map[string]interface{}) *appflow.MetadataCatalogConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &appflow.MetadataCatalogConfig{}
+
+	if v, ok := tfMap["glue_data_catalog"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.GlueDataCatalog = expandGlueDataCatalogConfig(v[0].(map[string]interface{}))
+	}
+
+	return a
+}
+
+func expandGlueDataCatalogConfig(tfMap map[string]interface{}) *appflow.GlueDataCatalogConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &appflow.GlueDataCatalogConfig{}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		a.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		a.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_prefix"].(string); ok && v != "" {
+		a.TablePrefix = aws.String(v)
+	}
+
+	return a
+}
+
 func expandSourceFlowConfig(tfMap map[string]interface{}) *appflow.SourceFlowConfig {
 	if tfMap == nil {
 		return nil
@@ -2140,6 +2269,10 @@ func expandSalesforceSourceProperties(tfMap map[string]interface{}) *appflow.Sal
 
 	a := &appflow.SalesforceSourceProperties{}
 
+	if v, ok := tfMap["data_transfer_api"].(string); ok && v != "" {
+		a.DataTransferApi = aws.String(v)
+	}
+
 	if v, ok := tfMap["enable_dynamic_field_update"].(bool); ok {
 		a.EnableDynamicFieldUpdate = aws.Bool(v)
 	}
@@ -2166,6 +2299,42 @@ func expandSAPODataSourceProperties(tfMap map[string]interface{}) *appflow.SAPOD
 		a.ObjectPath = aws.String(v)
 	}
 
+	if v, ok := tfMap["pagination_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.PaginationConfig = expandSAPODataPaginationConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["parallelism_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.ParallelismConfig = expandSAPODataParallelismConfig(v[0].(map[string]interface{}))
+	}
+
+	return a
+}
+
+func expandSAPODataPaginationConfig(tfMap map[string]interface{}) *appflow.SAPODataPaginationConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &appflow.SAPODataPaginationConfig{}
+
+	if v, ok := tfMap["max_page_size"].(int); ok && v != 0 {
+		a.MaxPageSize = aws.Int64(int64(v))
+	}
+
+	return a
+}
+
+func expandSAPODataParallelismConfig(tfMap map[string]interface{}) *appflow.SAPODataParallelismConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &appflow.SAPODataParallelismConfig{}
+
+	if v, ok := tfMap["max_parallelism"].(int); ok && v != 0 {
+		a.MaxParallelism = aws.Int64(int64(v))
+	}
+
 	return a
 }
 
@@ -2822,6 +2991,10 @@ func flattenSalesforceDestinationProperties(salesforceDestinationProperties *app
 
 	m := map[string]interface{}{}
 
+	if v := salesforceDestinationProperties.DataTransferApi; v != nil {
+		m["data_transfer_api"] = aws.StringValue(v)
+	}
+
 	if v := salesforceDestinationProperties.ErrorHandlingConfig; v != nil {
 		m["error_handling_config"] = []interface{}{flattenErrorHandlingConfig(v)}
 	}
@@ -2985,6 +3158,42 @@ func flattenZendeskDestinationProperties(zendeskDestinationProperties *appflow.Z
 	return m
 }
 
+func flattenMetadataCatalogConfig(metadataCatalogConfig *appflow.MetadataCatalogConfig) map[string]interface{} {
+	if metadataCatalogConfig == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := metadataCatalogConfig.GlueDataCatalog; v != nil {
+		m["glue_data_catalog"] = []interface{}{flattenGlueDataCatalogConfig(v)}
+	}
+
+	return m
+}
+
+func flattenGlueDataCatalogConfig(glueDataCatalogConfig *appflow.GlueDataCatalogConfig) map[string]interface{} {
+	if glueDataCatalogConfig == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := glueDataCatalogConfig.DatabaseName; v != nil {
+		m["database_name"] = aws.StringValue(v)
+	}
+
+	if v := glueDataCatalogConfig.RoleArn; v != nil {
+		m["role_arn"] = aws.StringValue(v)
+	}
+
+	if v := glueDataCatalogConfig.TablePrefix; v != nil {
+		m["table_prefix"] = aws.StringValue(v)
+	}
+
+	return m
+}
+
 func flattenSourceFlowConfig(sourceFlowConfig *appflow.SourceFlowConfig) map[string]interface{} {
 	if sourceFlowConfig == nil {
 		return nil
@@ -3248,6 +3457,10 @@ func flattenSalesforceSourceProperties(salesforceSourceProperties *appflow.Sales
 
 	m := map[string]interface{}{}
 
+	if v := salesforceSourceProperties.DataTransferApi; v != nil {
+		m["data_transfer_api"] = aws.StringValue(v)
+	}
+
 	if v := salesforceSourceProperties.EnableDynamicFieldUpdate; v != nil {
 		m["enable_dynamic_field_update"] = aws.BoolValue(v)
 	}
@@ -3274,6 +3487,42 @@ func flattenSAPODataSourceProperties(sapoDataSourceProperties *appflow.SAPODataS
 		m[AttrObjectPath] = aws.StringValue(v)
 	}
 
+	if v := sapoDataSourceProperties.PaginationConfig; v != nil {
+		m["pagination_config"] = []interface{}{flattenSAPODataPaginationConfig(v)}
+	}
+
+	if v := sapoDataSourceProperties.ParallelismConfig; v != nil {
+		m["parallelism_config"] = []interface{}{flattenSAPODataParallelismConfig(v)}
+	}
+
+	return m
+}
+
+func flattenSAPODataPaginationConfig(sapoDataPaginationConfig *appflow.SAPODataPaginationConfig) map[string]interface{} {
+	if sapoDataPaginationConfig == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := sapoDataPaginationConfig.MaxPageSize; v != nil {
+		m["max_page_size"] = aws.Int64Value(v)
+	}
+
+	return m
+}
+
+func flattenSAPODataParallelismConfig(sapoDataParallelismConfig *appflow.SAPODataParallelismConfig) map[string]interface{} {
+	if sapoDataParallelismConfig == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := sapoDataParallelismConfig.MaxParallelism; v != nil {
+		m["max_parallelism"] = aws.Int64Value(v)
+	}
+
 	return m
 }
 
@@ -181,6 +181,40 @@ func TestAccAppFlowFlow_TaskProperties(t *testing.T) {
 	})
 }
 
+func TestAccAppFlowFlow_metadataCatalogConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var flowOutput appflow.FlowDefinition
+	rSourceName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rDestinationName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rFlowName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appflow.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_metadataCatalogConfig(rSourceName, rDestinationName, rFlowName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &flowOutput),
+					resource.TestCheckResourceAttr(resourceName, "metadata_catalog_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_catalog_config.0.glue_data_catalog.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "metadata_catalog_config.0.glue_data_catalog.0.database_name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "metadata_catalog_config.0.glue_data_catalog.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_catalog_config.0.glue_data_catalog.0.table_prefix", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAppFlowFlow_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var flowOutput appflow.FlowDefinition
@@ -574,6 +608,108 @@ resource "aws_appflow_flow" "test" {
 	)
 }
 
+func testAccFlowConfig_metadataCatalogConfig(rSourceName string, rDestinationName string, rFlowName string) string {
+	return acctest.ConfigCompose(
+		testAccFlowConfig_base(rSourceName, rDestinationName),
+		fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "appflow.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "glue:BatchCreatePartition",
+        "glue:CreatePartitionIndex",
+        "glue:CreateTable",
+        "glue:DeleteDatabase",
+        "glue:GetPartitions",
+        "glue:GetTableVersions",
+        "glue:GetTables",
+        "glue:GetTable",
+        "glue:UpdateTable",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_appflow_flow" "test" {
+  name = %[1]q
+
+  source_flow_config {
+    connector_type = "S3"
+    source_connector_properties {
+      s3 {
+        bucket_name   = aws_s3_bucket_policy.test_source.bucket
+        bucket_prefix = "flow"
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket_policy.test_destination.bucket
+
+        s3_output_format_config {
+          prefix_config {
+            prefix_type = "PATH"
+          }
+        }
+      }
+    }
+  }
+
+  task {
+    source_fields     = ["testField"]
+    destination_field = "testField"
+    task_type         = "Map"
+
+    connector_operator {
+      s3 = "NO_OP"
+    }
+  }
+
+  trigger_config {
+    trigger_type = "OnDemand"
+  }
+
+  metadata_catalog_config {
+    glue_data_catalog {
+      database_name = aws_glue_catalog_database.test.name
+      role_arn      = aws_iam_role.test.arn
+      table_prefix  = "test"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rFlowName),
+	)
+}
+
 func testAccFlowConfig_tags1(rSourceName string, rDestinationName string, rFlowName string, tagKey1 string, tagValue1 string) string {
 	return acctest.ConfigCompose(
 		testAccFlowConfig_base(rSourceName, rDestinationName),
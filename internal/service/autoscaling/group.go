@@ -152,6 +152,28 @@ func ResourceGroup() *schema.Resource {
 					},
 				},
 			},
+			"instance_maintenance_policy": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_healthy_percentage": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ValidateFunc: validation.Any(
+								validation.IntInSlice([]int{-1}),
+								validation.IntBetween(100, 200),
+							),
+						},
+						"min_healthy_percentage": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(-1, 100),
+						},
+					},
+				},
+			},
 			"instance_refresh": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -164,6 +186,22 @@ func ResourceGroup() *schema.Resource {
 							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"alarm_specification": {
+										Type:     schema.TypeList,
+										MaxItems: 1,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"alarms": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
+									},
 									"auto_rollback": {
 										Type:     schema.TypeBool,
 										Optional: true,
@@ -191,11 +229,23 @@ func ResourceGroup() *schema.Resource {
 										Default:      90,
 										ValidateFunc: validation.IntBetween(0, 100),
 									},
+									"scale_in_protected_instances": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      autoscaling.ScaleInProtectedInstancesIgnore,
+										ValidateFunc: validation.StringInSlice(autoscaling.ScaleInProtectedInstances_Values(), false),
+									},
 									"skip_matching": {
 										Type:     schema.TypeBool,
 										Optional: true,
 										Default:  false,
 									},
+									"standby_instances": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      autoscaling.StandbyInstancesIgnore,
+										ValidateFunc: validation.StringInSlice(autoscaling.StandbyInstances_Values(), false),
+									},
 								},
 							},
 						},
@@ -923,6 +973,10 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		createInput.HealthCheckGracePeriod = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("instance_maintenance_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		createInput.InstanceMaintenancePolicy = expandInstanceMaintenancePolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("launch_configuration"); ok {
 		createInput.LaunchConfigurationName = aws.String(v.(string))
 	}
@@ -1107,6 +1161,9 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 	d.Set("health_check_grace_period", g.HealthCheckGracePeriod)
 	d.Set("health_check_type", g.HealthCheckType)
+	if err := d.Set("instance_maintenance_policy", flattenInstanceMaintenancePolicy(g.InstanceMaintenancePolicy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instance_maintenance_policy: %s", err)
+	}
 	d.Set("launch_configuration", g.LaunchConfigurationName)
 	if g.LaunchTemplate != nil {
 		if err := d.Set("launch_template", []interface{}{flattenLaunchTemplateSpecification(g.LaunchTemplate)}); err != nil {
@@ -1236,6 +1293,19 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.HealthCheckType = aws.String(d.Get("health_check_type").(string))
 		}
 
+		if d.HasChange("instance_maintenance_policy") {
+			// If the instance maintenance policy is removed, we need to explicitly set
+			// both percentages to -1, or the API won't clear it for us.
+			if v, ok := d.GetOk("instance_maintenance_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.InstanceMaintenancePolicy = expandInstanceMaintenancePolicy(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.InstanceMaintenancePolicy = &autoscaling.InstanceMaintenancePolicy{
+					MaxHealthyPercentage: aws.Int64(-1),
+					MinHealthyPercentage: aws.Int64(-1),
+				}
+			}
+		}
+
 		if d.HasChange("launch_configuration") {
 			if v, ok := d.GetOk("launch_configuration"); ok {
 				input.LaunchConfigurationName = aws.String(v.(string))
@@ -3196,6 +3266,10 @@ func expandRefreshPreferences(tfMap map[string]interface{}) *autoscaling.Refresh
 
 	apiObject := &autoscaling.RefreshPreferences{}
 
+	if v, ok := tfMap["alarm_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AlarmSpecification = expandAlarmSpecification(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap["auto_rollback"].(bool); ok {
 		apiObject.AutoRollback = aws.Bool(v)
 	}
@@ -3220,13 +3294,66 @@ func expandRefreshPreferences(tfMap map[string]interface{}) *autoscaling.Refresh
 		apiObject.MinHealthyPercentage = aws.Int64(int64(v))
 	}
 
+	if v, ok := tfMap["scale_in_protected_instances"].(string); ok && v != "" {
+		apiObject.ScaleInProtectedInstances = aws.String(v)
+	}
+
 	if v, ok := tfMap["skip_matching"].(bool); ok {
 		apiObject.SkipMatching = aws.Bool(v)
 	}
 
+	if v, ok := tfMap["standby_instances"].(string); ok && v != "" {
+		apiObject.StandbyInstances = aws.String(v)
+	}
+
 	return apiObject
 }
 
+func expandAlarmSpecification(tfMap map[string]interface{}) *autoscaling.AlarmSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &autoscaling.AlarmSpecification{}
+
+	if v, ok := tfMap["alarms"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Alarms = flex.ExpandStringList(v)
+	}
+
+	return apiObject
+}
+
+func expandInstanceMaintenancePolicy(tfMap map[string]interface{}) *autoscaling.InstanceMaintenancePolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &autoscaling.InstanceMaintenancePolicy{}
+
+	if v, ok := tfMap["max_healthy_percentage"].(int); ok {
+		apiObject.MaxHealthyPercentage = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_healthy_percentage"].(int); ok {
+		apiObject.MinHealthyPercentage = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenInstanceMaintenancePolicy(apiObject *autoscaling.InstanceMaintenancePolicy) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"max_healthy_percentage": aws.Int64Value(apiObject.MaxHealthyPercentage),
+		"min_healthy_percentage": aws.Int64Value(apiObject.MinHealthyPercentage),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandVPCZoneIdentifiers(tfList []interface{}) *string {
 	vpcZoneIDs := make([]string, len(tfList))
 
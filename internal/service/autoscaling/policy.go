@@ -33,6 +33,8 @@ func ResourcePolicy() *schema.Resource {
 			StateContext: resourcePolicyImport,
 		},
 
+		CustomizeDiff: resourcePolicyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"adjustment_type": {
 				Type:     schema.TypeString,
@@ -169,6 +171,12 @@ func ResourcePolicy() *schema.Resource {
 										Type:     schema.TypeList,
 										Optional: true,
 										MaxItems: 1,
+										ConflictsWith: []string{
+											"predictive_scaling_configuration.0.metric_specification.0.customized_load_metric_specification",
+											"predictive_scaling_configuration.0.metric_specification.0.customized_scaling_metric_specification",
+											"predictive_scaling_configuration.0.metric_specification.0.predefined_load_metric_specification",
+											"predictive_scaling_configuration.0.metric_specification.0.predefined_scaling_metric_specification",
+										},
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"predefined_metric_type": {
@@ -667,6 +675,67 @@ func FindScalingPolicy(ctx context.Context, conn *autoscaling.AutoScaling, asgNa
 	return output[0], nil
 }
 
+func resourcePolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.Get("predictive_scaling_configuration").([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	v, ok = v[0].(map[string]interface{})["metric_specification"].([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	metricSpecification := v[0].(map[string]interface{})
+
+	for _, k := range []string{
+		"customized_capacity_metric_specification",
+		"customized_load_metric_specification",
+		"customized_scaling_metric_specification",
+	} {
+		v, ok := metricSpecification[k].([]interface{})
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+
+		if err := validMetricDataQueries(v[0].(map[string]interface{})["metric_data_queries"].([]interface{})); err != nil {
+			return fmt.Errorf("predictive_scaling_configuration.0.metric_specification.0.%s: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+// validMetricDataQueries checks that each query sets either expression or metric_stat
+// and that exactly one query returns data, as required by the predictive scaling API.
+func validMetricDataQueries(tfList []interface{}) error {
+	returnData := 0
+
+	for i, v := range tfList {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		expression, _ := tfMap["expression"].(string)
+		metricStat, _ := tfMap["metric_stat"].([]interface{})
+
+		if expression != "" && len(metricStat) > 0 {
+			return fmt.Errorf("metric_data_queries.%d: only one of expression or metric_stat can be specified", i)
+		}
+
+		if v, ok := tfMap["return_data"].(bool); ok && v {
+			returnData++
+		}
+	}
+
+	if len(tfList) > 0 && returnData != 1 {
+		return fmt.Errorf("exactly one of metric_data_queries must have return_data set to true, got %d", returnData)
+	}
+
+	return nil
+}
+
 // PutScalingPolicy can safely resend all parameters without destroying the
 // resource, so create and update can share this common function. It will error
 // if certain mutually exclusive values are set.
@@ -3,6 +3,7 @@ package autoscaling_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/autoscaling"
@@ -264,6 +265,24 @@ func TestAccAutoScalingPolicy_predictiveScalingCustom(t *testing.T) {
 	})
 }
 
+func TestAccAutoScalingPolicy_predictiveScalingCustomInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_predictiveScalingCustomReturnData(rName),
+				ExpectError: regexp.MustCompile(`exactly one of metric_data_queries must have return_data set to true, got 2`),
+			},
+		},
+	})
+}
+
 func TestAccAutoScalingPolicy_predictiveScalingRemoved(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v autoscaling.ScalingPolicy
@@ -787,6 +806,37 @@ resource "aws_autoscaling_policy" "test" {
 `, rName))
 }
 
+func testAccPolicyConfig_predictiveScalingCustomReturnData(rName string) string {
+	return acctest.ConfigCompose(testAccPolicyConfigBase(rName), fmt.Sprintf(`
+resource "aws_autoscaling_policy" "test" {
+  name                   = "%[1]s-predictive"
+  policy_type            = "PredictiveScaling"
+  autoscaling_group_name = aws_autoscaling_group.test.name
+  predictive_scaling_configuration {
+    metric_specification {
+      target_value = 32
+      customized_load_metric_specification {
+        metric_data_queries {
+          id         = "load_metric"
+          expression = "TIME_SERIES(100)"
+        }
+        metric_data_queries {
+          id         = "other_load_metric"
+          expression = "TIME_SERIES(50)"
+        }
+      }
+      customized_scaling_metric_specification {
+        metric_data_queries {
+          id         = "scaling_metric"
+          expression = "TIME_SERIES(1)"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
 func testAccPolicyConfig_predictiveScalingRemoved(rName string) string {
 	return acctest.ConfigCompose(testAccPolicyConfigBase(rName), fmt.Sprintf(`
 resource "aws_autoscaling_policy" "test" {
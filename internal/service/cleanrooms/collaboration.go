@@ -0,0 +1,406 @@
+package cleanrooms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_collaboration", name="Collaboration")
+// @Tags(identifierAttribute="arn")
+func ResourceCollaboration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCollaborationCreate,
+		ReadWithoutTimeout:   resourceCollaborationRead,
+		UpdateWithoutTimeout: resourceCollaborationUpdate,
+		DeleteWithoutTimeout: resourceCollaborationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creator_display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"creator_member_abilities": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[types.MemberAbility](),
+				},
+			},
+			"data_encryption_metadata": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_clear_text": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"allow_duplicates": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"allow_joins_on_columns_with_different_names": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"preserve_nulls": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"member": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"display_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"member_abilities": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.MemberAbility](),
+							},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"query_log_status": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.CollaborationQueryLogStatus](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCollaborationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	name := d.Get("name").(string)
+	input := &cleanrooms.CreateCollaborationInput{
+		CreatorDisplayName:     aws.String(d.Get("creator_display_name").(string)),
+		CreatorMemberAbilities: flex.ExpandStringyValueSet[types.MemberAbility](d.Get("creator_member_abilities").(*schema.Set)),
+		Description:            aws.String(d.Get("description").(string)),
+		Members:                expandMemberSpecifications(d.Get("member").(*schema.Set).List()),
+		Name:                   aws.String(name),
+		QueryLogStatus:         types.CollaborationQueryLogStatus(d.Get("query_log_status").(string)),
+		Tags:                   GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("data_encryption_metadata"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DataEncryptionMetadata = expandDataEncryptionMetadata(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateCollaboration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Clean Rooms Collaboration (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Collaboration.Id))
+
+	return append(diags, resourceCollaborationRead(ctx, d, meta)...)
+}
+
+func resourceCollaborationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	collaboration, err := FindCollaborationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Collaboration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Clean Rooms Collaboration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", collaboration.Arn)
+	d.Set("create_time", aws.ToTime(collaboration.CreateTime).Format(time.RFC3339))
+	d.Set("creator_display_name", collaboration.CreatorDisplayName)
+	if err := d.Set("data_encryption_metadata", flattenDataEncryptionMetadata(collaboration.DataEncryptionMetadata)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting data_encryption_metadata: %s", err)
+	}
+	d.Set("description", collaboration.Description)
+	d.Set("name", collaboration.Name)
+	d.Set("query_log_status", collaboration.QueryLogStatus)
+	d.Set("update_time", aws.ToTime(collaboration.UpdateTime).Format(time.RFC3339))
+
+	members, err := findMembersByCollaborationID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Clean Rooms Collaboration (%s) members: %s", d.Id(), err)
+	}
+
+	// The collaboration creator is returned as a member but is configured separately.
+	creatorAccountID := aws.ToString(collaboration.CreatorAccountId)
+	var tfList []interface{}
+	for _, member := range members {
+		if aws.ToString(member.AccountId) == creatorAccountID {
+			d.Set("creator_member_abilities", flex.FlattenStringValueSet(enum.Slice(member.Abilities...)))
+			continue
+		}
+
+		tfList = append(tfList, flattenMemberSummary(member))
+	}
+	if err := d.Set("member", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting member: %s", err)
+	}
+
+	return diags
+}
+
+func resourceCollaborationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	if d.HasChanges("description", "name") {
+		input := &cleanrooms.UpdateCollaborationInput{
+			CollaborationIdentifier: aws.String(d.Id()),
+			Description:             aws.String(d.Get("description").(string)),
+			Name:                    aws.String(d.Get("name").(string)),
+		}
+
+		_, err := conn.UpdateCollaboration(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Clean Rooms Collaboration (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCollaborationRead(ctx, d, meta)...)
+}
+
+func resourceCollaborationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	log.Printf("[DEBUG] Deleting Clean Rooms Collaboration: %s", d.Id())
+	_, err := conn.DeleteCollaboration(ctx, &cleanrooms.DeleteCollaborationInput{
+		CollaborationIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Clean Rooms Collaboration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindCollaborationByID(ctx context.Context, conn *cleanrooms.Client, id string) (*types.Collaboration, error) {
+	input := &cleanrooms.GetCollaborationInput{
+		CollaborationIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetCollaboration(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Collaboration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Collaboration, nil
+}
+
+func findMembersByCollaborationID(ctx context.Context, conn *cleanrooms.Client, id string) ([]types.MemberSummary, error) {
+	input := &cleanrooms.ListMembersInput{
+		CollaborationIdentifier: aws.String(id),
+	}
+	var output []types.MemberSummary
+
+	pages := cleanrooms.NewListMembersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.MemberSummaries...)
+	}
+
+	return output, nil
+}
+
+func expandDataEncryptionMetadata(tfMap map[string]interface{}) *types.DataEncryptionMetadata {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.DataEncryptionMetadata{}
+
+	if v, ok := tfMap["allow_clear_text"].(bool); ok {
+		apiObject.AllowCleartext = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["allow_duplicates"].(bool); ok {
+		apiObject.AllowDuplicates = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["allow_joins_on_columns_with_different_names"].(bool); ok {
+		apiObject.AllowJoinsOnColumnsWithDifferentNames = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["preserve_nulls"].(bool); ok {
+		apiObject.PreserveNulls = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandMemberSpecifications(tfList []interface{}) []types.MemberSpecification {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.MemberSpecification
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.MemberSpecification{}
+
+		if v, ok := tfMap["account_id"].(string); ok && v != "" {
+			apiObject.AccountId = aws.String(v)
+		}
+
+		if v, ok := tfMap["display_name"].(string); ok && v != "" {
+			apiObject.DisplayName = aws.String(v)
+		}
+
+		if v, ok := tfMap["member_abilities"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.MemberAbilities = flex.ExpandStringyValueSet[types.MemberAbility](v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDataEncryptionMetadata(apiObject *types.DataEncryptionMetadata) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allow_clear_text": aws.ToBool(apiObject.AllowCleartext),
+		"allow_duplicates": aws.ToBool(apiObject.AllowDuplicates),
+		"allow_joins_on_columns_with_different_names": aws.ToBool(apiObject.AllowJoinsOnColumnsWithDifferentNames),
+		"preserve_nulls": aws.ToBool(apiObject.PreserveNulls),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMemberSummary(apiObject types.MemberSummary) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"account_id":       aws.ToString(apiObject.AccountId),
+		"display_name":     aws.ToString(apiObject.DisplayName),
+		"member_abilities": flex.FlattenStringValueSet(enum.Slice(apiObject.Abilities...)),
+		"status":           string(apiObject.Status),
+	}
+
+	return tfMap
+}
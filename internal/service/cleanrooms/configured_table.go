@@ -0,0 +1,273 @@
+package cleanrooms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table", name="Configured Table")
+// @Tags(identifierAttribute="arn")
+func ResourceConfiguredTable() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableRead,
+		UpdateWithoutTimeout: resourceConfiguredTableUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allowed_columns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+			"analysis_method": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.AnalysisMethod](),
+			},
+			"analysis_rule_types": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"table_reference": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"table_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceConfiguredTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	name := d.Get("name").(string)
+	input := &cleanrooms.CreateConfiguredTableInput{
+		AllowedColumns: flex.ExpandStringValueSet(d.Get("allowed_columns").(*schema.Set)),
+		AnalysisMethod: types.AnalysisMethod(d.Get("analysis_method").(string)),
+		Name:           aws.String(name),
+		Tags:           GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("table_reference"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TableReference = expandTableReference(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateConfiguredTable(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Clean Rooms Configured Table (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ConfiguredTable.Id))
+
+	return append(diags, resourceConfiguredTableRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	configuredTable, err := FindConfiguredTableByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Configured Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Clean Rooms Configured Table (%s): %s", d.Id(), err)
+	}
+
+	d.Set("allowed_columns", configuredTable.AllowedColumns)
+	d.Set("analysis_method", configuredTable.AnalysisMethod)
+	d.Set("analysis_rule_types", flex.FlattenStringValueSet(enum.Slice(configuredTable.AnalysisRuleTypes...)))
+	d.Set("arn", configuredTable.Arn)
+	d.Set("create_time", aws.ToTime(configuredTable.CreateTime).Format(time.RFC3339))
+	d.Set("description", configuredTable.Description)
+	d.Set("name", configuredTable.Name)
+	if err := d.Set("table_reference", flattenTableReference(configuredTable.TableReference)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting table_reference: %s", err)
+	}
+	d.Set("update_time", aws.ToTime(configuredTable.UpdateTime).Format(time.RFC3339))
+
+	return diags
+}
+
+func resourceConfiguredTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	if d.HasChanges("description", "name") {
+		input := &cleanrooms.UpdateConfiguredTableInput{
+			ConfiguredTableIdentifier: aws.String(d.Id()),
+			Description:               aws.String(d.Get("description").(string)),
+			Name:                      aws.String(d.Get("name").(string)),
+		}
+
+		_, err := conn.UpdateConfiguredTable(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Clean Rooms Configured Table (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceConfiguredTableRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	log.Printf("[DEBUG] Deleting Clean Rooms Configured Table: %s", d.Id())
+	_, err := conn.DeleteConfiguredTable(ctx, &cleanrooms.DeleteConfiguredTableInput{
+		ConfiguredTableIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Clean Rooms Configured Table (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindConfiguredTableByID(ctx context.Context, conn *cleanrooms.Client, id string) (*types.ConfiguredTable, error) {
+	input := &cleanrooms.GetConfiguredTableInput{
+		ConfiguredTableIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetConfiguredTable(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfiguredTable == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ConfiguredTable, nil
+}
+
+func expandTableReference(tfMap map[string]interface{}) types.TableReference {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := types.GlueTableReference{}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return &types.TableReferenceMemberGlue{Value: apiObject}
+}
+
+func flattenTableReference(apiObject types.TableReference) []interface{} {
+	v, ok := apiObject.(*types.TableReferenceMemberGlue)
+
+	if !ok {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"database_name": aws.ToString(v.Value.DatabaseName),
+		"table_name":    aws.ToString(v.Value.TableName),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,499 @@
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table_analysis_rule")
+func ResourceConfiguredTableAnalysisRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableAnalysisRuleCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableAnalysisRuleRead,
+		UpdateWithoutTimeout: resourceConfiguredTableAnalysisRuleUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableAnalysisRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aggregation": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aggregate_columns": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_names": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"function": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.AggregateFunctionName](),
+									},
+								},
+							},
+						},
+						"dimension_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"join_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"join_required": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[types.JoinRequiredOption](),
+						},
+						"output_constraints": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"minimum": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(2),
+									},
+									"type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.AggregationType](),
+									},
+								},
+							},
+						},
+						"scalar_functions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.ScalarFunctions](),
+							},
+						},
+					},
+				},
+			},
+			"configured_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"list": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"join_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"list_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			// Changing the analysis rule type requires a new analysis rule.
+			customdiff.ForceNewIfChange("aggregation", analysisRuleBlockAddedOrRemoved),
+			customdiff.ForceNewIfChange("list", analysisRuleBlockAddedOrRemoved),
+		),
+	}
+}
+
+func analysisRuleBlockAddedOrRemoved(_ context.Context, old, new, meta interface{}) bool {
+	return len(old.([]interface{})) != len(new.([]interface{}))
+}
+
+func resourceConfiguredTableAnalysisRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	configuredTableID := d.Get("configured_table_id").(string)
+	analysisRuleType, policy := expandConfiguredTableAnalysisRulePolicy(d)
+	input := &cleanrooms.CreateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        policy,
+		AnalysisRuleType:          analysisRuleType,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	_, err := conn.CreateConfiguredTableAnalysisRule(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Clean Rooms Configured Table (%s) %s Analysis Rule: %s", configuredTableID, analysisRuleType, err)
+	}
+
+	d.SetId(ConfiguredTableAnalysisRuleCreateResourceID(configuredTableID, string(analysisRuleType)))
+
+	return append(diags, resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAnalysisRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	configuredTableID, analysisRuleType, err := ConfiguredTableAnalysisRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	analysisRule, err := FindConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, configuredTableID, analysisRuleType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Configured Table Analysis Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Clean Rooms Configured Table Analysis Rule (%s): %s", d.Id(), err)
+	}
+
+	d.Set("configured_table_id", analysisRule.ConfiguredTableId)
+	d.Set("type", analysisRule.Type)
+
+	var aggregation, list []interface{}
+	if v, ok := analysisRule.Policy.(*types.ConfiguredTableAnalysisRulePolicyMemberV1); ok {
+		switch v := v.Value.(type) {
+		case *types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation:
+			aggregation = flattenAnalysisRuleAggregation(&v.Value)
+		case *types.ConfiguredTableAnalysisRulePolicyV1MemberList:
+			list = flattenAnalysisRuleList(&v.Value)
+		}
+	}
+	if err := d.Set("aggregation", aggregation); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting aggregation: %s", err)
+	}
+	if err := d.Set("list", list); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting list: %s", err)
+	}
+
+	return diags
+}
+
+func resourceConfiguredTableAnalysisRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	configuredTableID, _, err := ConfiguredTableAnalysisRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	analysisRuleType, policy := expandConfiguredTableAnalysisRulePolicy(d)
+	input := &cleanrooms.UpdateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        policy,
+		AnalysisRuleType:          analysisRuleType,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	_, err = conn.UpdateConfiguredTableAnalysisRule(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Clean Rooms Configured Table Analysis Rule (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAnalysisRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	configuredTableID, analysisRuleType, err := ConfiguredTableAnalysisRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Clean Rooms Configured Table Analysis Rule: %s", d.Id())
+	_, err = conn.DeleteConfiguredTableAnalysisRule(ctx, &cleanrooms.DeleteConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          types.ConfiguredTableAnalysisRuleType(analysisRuleType),
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Clean Rooms Configured Table Analysis Rule (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const configuredTableAnalysisRuleResourceIDSeparator = ","
+
+func ConfiguredTableAnalysisRuleCreateResourceID(configuredTableID, analysisRuleType string) string {
+	parts := []string{configuredTableID, analysisRuleType}
+	id := strings.Join(parts, configuredTableAnalysisRuleResourceIDSeparator)
+
+	return id
+}
+
+func ConfiguredTableAnalysisRuleParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, configuredTableAnalysisRuleResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CONFIGURED-TABLE-ID%[2]sANALYSIS-RULE-TYPE", id, configuredTableAnalysisRuleResourceIDSeparator)
+}
+
+func FindConfiguredTableAnalysisRuleByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, configuredTableID, analysisRuleType string) (*types.ConfiguredTableAnalysisRule, error) {
+	input := &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          types.ConfiguredTableAnalysisRuleType(analysisRuleType),
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	output, err := conn.GetConfiguredTableAnalysisRule(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AnalysisRule, nil
+}
+
+func expandConfiguredTableAnalysisRulePolicy(d *schema.ResourceData) (types.ConfiguredTableAnalysisRuleType, types.ConfiguredTableAnalysisRulePolicy) {
+	if v, ok := d.GetOk("aggregation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return types.ConfiguredTableAnalysisRuleTypeAggregation, &types.ConfiguredTableAnalysisRulePolicyMemberV1{
+			Value: &types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation{
+				Value: expandAnalysisRuleAggregation(v.([]interface{})[0].(map[string]interface{})),
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("list"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return types.ConfiguredTableAnalysisRuleTypeList, &types.ConfiguredTableAnalysisRulePolicyMemberV1{
+			Value: &types.ConfiguredTableAnalysisRulePolicyV1MemberList{
+				Value: expandAnalysisRuleList(v.([]interface{})[0].(map[string]interface{})),
+			},
+		}
+	}
+
+	return "", nil
+}
+
+func expandAnalysisRuleAggregation(tfMap map[string]interface{}) types.AnalysisRuleAggregation {
+	apiObject := types.AnalysisRuleAggregation{}
+
+	if v, ok := tfMap["aggregate_columns"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AggregateColumns = expandAggregateColumns(v)
+	}
+
+	if v, ok := tfMap["dimension_columns"].(*schema.Set); ok {
+		apiObject.DimensionColumns = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["join_columns"].(*schema.Set); ok {
+		apiObject.JoinColumns = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["join_required"].(string); ok && v != "" {
+		apiObject.JoinRequired = types.JoinRequiredOption(v)
+	}
+
+	if v, ok := tfMap["output_constraints"].([]interface{}); ok && len(v) > 0 {
+		apiObject.OutputConstraints = expandAggregationConstraints(v)
+	}
+
+	if v, ok := tfMap["scalar_functions"].(*schema.Set); ok {
+		apiObject.ScalarFunctions = flex.ExpandStringyValueSet[types.ScalarFunctions](v)
+	}
+
+	return apiObject
+}
+
+func expandAggregateColumns(tfList []interface{}) []types.AggregateColumn {
+	var apiObjects []types.AggregateColumn
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.AggregateColumn{}
+
+		if v, ok := tfMap["column_names"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ColumnNames = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["function"].(string); ok && v != "" {
+			apiObject.Function = types.AggregateFunctionName(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAggregationConstraints(tfList []interface{}) []types.AggregationConstraint {
+	var apiObjects []types.AggregationConstraint
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.AggregationConstraint{}
+
+		if v, ok := tfMap["column_name"].(string); ok && v != "" {
+			apiObject.ColumnName = aws.String(v)
+		}
+
+		if v, ok := tfMap["minimum"].(int); ok {
+			apiObject.Minimum = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = types.AggregationType(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAnalysisRuleList(tfMap map[string]interface{}) types.AnalysisRuleList {
+	apiObject := types.AnalysisRuleList{}
+
+	if v, ok := tfMap["join_columns"].(*schema.Set); ok {
+		apiObject.JoinColumns = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["list_columns"].(*schema.Set); ok {
+		apiObject.ListColumns = flex.ExpandStringValueSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenAnalysisRuleAggregation(apiObject *types.AnalysisRuleAggregation) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"aggregate_columns":  flattenAggregateColumns(apiObject.AggregateColumns),
+		"dimension_columns":  apiObject.DimensionColumns,
+		"join_columns":       apiObject.JoinColumns,
+		"join_required":      string(apiObject.JoinRequired),
+		"output_constraints": flattenAggregationConstraints(apiObject.OutputConstraints),
+		"scalar_functions":   enum.Slice(apiObject.ScalarFunctions...),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAggregateColumns(apiObjects []types.AggregateColumn) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"column_names": apiObject.ColumnNames,
+			"function":     string(apiObject.Function),
+		})
+	}
+
+	return tfList
+}
+
+func flattenAggregationConstraints(apiObjects []types.AggregationConstraint) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"column_name": aws.ToString(apiObject.ColumnName),
+			"minimum":     int(aws.ToInt32(apiObject.Minimum)),
+			"type":        string(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenAnalysisRuleList(apiObject *types.AnalysisRuleList) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"join_columns": apiObject.JoinColumns,
+		"list_columns": apiObject.ListColumns,
+	}
+
+	return []interface{}{tfMap}
+}
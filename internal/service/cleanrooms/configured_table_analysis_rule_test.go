@@ -0,0 +1,231 @@
+package cleanrooms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_aggregation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ConfiguredTableAnalysisRule
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CleanRoomsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_aggregation(rName, 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.aggregate_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.aggregate_columns.0.function", "SUM"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.dimension_columns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.join_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.output_constraints.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.output_constraints.0.minimum", "100"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.scalar_functions.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_id", "aws_cleanrooms_configured_table.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "list.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "AGGREGATION"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_aggregation(rName, 200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.output_constraints.0.minimum", "200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_list(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ConfiguredTableAnalysisRule
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CleanRoomsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "list.0.join_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "list.0.list_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "type", "LIST"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ConfiguredTableAnalysisRule
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CleanRoomsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredTableAnalysisRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableAnalysisRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_configured_table_analysis_rule" {
+				continue
+			}
+
+			configuredTableID, analysisRuleType, err := tfcleanrooms.ConfiguredTableAnalysisRuleParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcleanrooms.FindConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, configuredTableID, analysisRuleType)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Clean Rooms Configured Table Analysis Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfiguredTableAnalysisRuleExists(ctx context.Context, n string, v *types.ConfiguredTableAnalysisRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Clean Rooms Configured Table Analysis Rule ID is set")
+		}
+
+		configuredTableID, analysisRuleType, err := tfcleanrooms.ConfiguredTableAnalysisRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient()
+
+		output, err := tfcleanrooms.FindConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, configuredTableID, analysisRuleType)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_aggregation(rName string, minimum int) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_basic(rName, rName, "test"), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+
+  aggregation {
+    aggregate_columns {
+      column_names = ["my_column_2"]
+      function     = "SUM"
+    }
+
+    dimension_columns = []
+    join_columns      = ["my_column_1"]
+    scalar_functions  = ["ABS"]
+
+    output_constraints {
+      column_name = "my_column_1"
+      minimum     = %[1]d
+      type        = "COUNT_DISTINCT"
+    }
+  }
+}
+`, minimum))
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_list(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_basic(rName, rName, "test"), `
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+
+  list {
+    join_columns = ["my_column_1"]
+    list_columns = ["my_column_2"]
+  }
+}
+`)
+}
@@ -0,0 +1,252 @@
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table_association", name="Configured Table Association")
+// @Tags(identifierAttribute="arn")
+func ResourceConfiguredTableAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableAssociationCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableAssociationRead,
+		UpdateWithoutTimeout: resourceConfiguredTableAssociationUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"membership_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceConfiguredTableAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	name := d.Get("name").(string)
+	membershipID := d.Get("membership_id").(string)
+	input := &cleanrooms.CreateConfiguredTableAssociationInput{
+		ConfiguredTableIdentifier: aws.String(d.Get("configured_table_id").(string)),
+		MembershipIdentifier:      aws.String(membershipID),
+		Name:                      aws.String(name),
+		RoleArn:                   aws.String(d.Get("role_arn").(string)),
+		Tags:                      GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateConfiguredTableAssociation(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Clean Rooms Configured Table Association (%s): %s", name, err)
+	}
+
+	d.SetId(ConfiguredTableAssociationCreateResourceID(aws.ToString(output.ConfiguredTableAssociation.Id), membershipID))
+
+	return append(diags, resourceConfiguredTableAssociationRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	associationID, membershipID, err := ConfiguredTableAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	association, err := FindConfiguredTableAssociationByTwoPartKey(ctx, conn, associationID, membershipID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Configured Table Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Clean Rooms Configured Table Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", association.Arn)
+	d.Set("configured_table_association_id", association.Id)
+	d.Set("configured_table_id", association.ConfiguredTableId)
+	d.Set("create_time", aws.ToTime(association.CreateTime).Format(time.RFC3339))
+	d.Set("description", association.Description)
+	d.Set("membership_id", association.MembershipId)
+	d.Set("name", association.Name)
+	d.Set("role_arn", association.RoleArn)
+	d.Set("update_time", aws.ToTime(association.UpdateTime).Format(time.RFC3339))
+
+	return diags
+}
+
+func resourceConfiguredTableAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	if d.HasChanges("description", "role_arn") {
+		associationID, membershipID, err := ConfiguredTableAssociationParseResourceID(d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &cleanrooms.UpdateConfiguredTableAssociationInput{
+			ConfiguredTableAssociationIdentifier: aws.String(associationID),
+			Description:                          aws.String(d.Get("description").(string)),
+			MembershipIdentifier:                 aws.String(membershipID),
+			RoleArn:                              aws.String(d.Get("role_arn").(string)),
+		}
+
+		_, err = conn.UpdateConfiguredTableAssociation(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Clean Rooms Configured Table Association (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceConfiguredTableAssociationRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	associationID, membershipID, err := ConfiguredTableAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Clean Rooms Configured Table Association: %s", d.Id())
+	_, err = conn.DeleteConfiguredTableAssociation(ctx, &cleanrooms.DeleteConfiguredTableAssociationInput{
+		ConfiguredTableAssociationIdentifier: aws.String(associationID),
+		MembershipIdentifier:                 aws.String(membershipID),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Clean Rooms Configured Table Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const configuredTableAssociationResourceIDSeparator = ","
+
+func ConfiguredTableAssociationCreateResourceID(associationID, membershipID string) string {
+	parts := []string{associationID, membershipID}
+	id := strings.Join(parts, configuredTableAssociationResourceIDSeparator)
+
+	return id
+}
+
+func ConfiguredTableAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, configuredTableAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CONFIGURED-TABLE-ASSOCIATION-ID%[2]sMEMBERSHIP-ID", id, configuredTableAssociationResourceIDSeparator)
+}
+
+func FindConfiguredTableAssociationByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, associationID, membershipID string) (*types.ConfiguredTableAssociation, error) {
+	input := &cleanrooms.GetConfiguredTableAssociationInput{
+		ConfiguredTableAssociationIdentifier: aws.String(associationID),
+		MembershipIdentifier:                 aws.String(membershipID),
+	}
+
+	output, err := conn.GetConfiguredTableAssociation(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfiguredTableAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ConfiguredTableAssociation, nil
+}
@@ -0,0 +1,218 @@
+package cleanrooms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_membership", name="Membership")
+// @Tags(identifierAttribute="arn")
+func ResourceMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMembershipCreate,
+		ReadWithoutTimeout:   resourceMembershipRead,
+		UpdateWithoutTimeout: resourceMembershipUpdate,
+		DeleteWithoutTimeout: resourceMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_creator_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_creator_display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"collaboration_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"member_abilities": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"query_log_status": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.MembershipQueryLogStatus](),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	collaborationID := d.Get("collaboration_id").(string)
+	input := &cleanrooms.CreateMembershipInput{
+		CollaborationIdentifier: aws.String(collaborationID),
+		QueryLogStatus:          types.MembershipQueryLogStatus(d.Get("query_log_status").(string)),
+		Tags:                    GetTagsIn(ctx),
+	}
+
+	output, err := conn.CreateMembership(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Clean Rooms Membership (%s): %s", collaborationID, err)
+	}
+
+	d.SetId(aws.ToString(output.Membership.Id))
+
+	return append(diags, resourceMembershipRead(ctx, d, meta)...)
+}
+
+func resourceMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	membership, err := FindMembershipByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Clean Rooms Membership (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", membership.Arn)
+	d.Set("collaboration_arn", membership.CollaborationArn)
+	d.Set("collaboration_creator_account_id", membership.CollaborationCreatorAccountId)
+	d.Set("collaboration_creator_display_name", membership.CollaborationCreatorDisplayName)
+	d.Set("collaboration_id", membership.CollaborationId)
+	d.Set("collaboration_name", membership.CollaborationName)
+	d.Set("create_time", aws.ToTime(membership.CreateTime).Format(time.RFC3339))
+	d.Set("member_abilities", flex.FlattenStringValueSet(enum.Slice(membership.MemberAbilities...)))
+	d.Set("query_log_status", membership.QueryLogStatus)
+	d.Set("status", membership.Status)
+	d.Set("update_time", aws.ToTime(membership.UpdateTime).Format(time.RFC3339))
+
+	return diags
+}
+
+func resourceMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	if d.HasChange("query_log_status") {
+		input := &cleanrooms.UpdateMembershipInput{
+			MembershipIdentifier: aws.String(d.Id()),
+			QueryLogStatus:       types.MembershipQueryLogStatus(d.Get("query_log_status").(string)),
+		}
+
+		_, err := conn.UpdateMembership(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Clean Rooms Membership (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMembershipRead(ctx, d, meta)...)
+}
+
+func resourceMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	log.Printf("[DEBUG] Deleting Clean Rooms Membership: %s", d.Id())
+	_, err := conn.DeleteMembership(ctx, &cleanrooms.DeleteMembershipInput{
+		MembershipIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Clean Rooms Membership (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindMembershipByID(ctx context.Context, conn *cleanrooms.Client, id string) (*types.Membership, error) {
+	input := &cleanrooms.GetMembershipInput{
+		MembershipIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetMembership(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Membership == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Deleted memberships remain visible with status REMOVED.
+	if output.Membership.Status == types.MembershipStatusRemoved {
+		return nil, &retry.NotFoundError{
+			Message:     string(output.Membership.Status),
+			LastRequest: input,
+		}
+	}
+
+	return output.Membership, nil
+}
@@ -24,7 +24,44 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceCollaboration,
+			TypeName: "aws_cleanrooms_collaboration",
+			Name:     "Collaboration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceConfiguredTable,
+			TypeName: "aws_cleanrooms_configured_table",
+			Name:     "Configured Table",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceConfiguredTableAnalysisRule,
+			TypeName: "aws_cleanrooms_configured_table_analysis_rule",
+		},
+		{
+			Factory:  ResourceConfiguredTableAssociation,
+			TypeName: "aws_cleanrooms_configured_table_association",
+			Name:     "Configured Table Association",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceMembership,
+			TypeName: "aws_cleanrooms_membership",
+			Name:     "Membership",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -248,7 +249,27 @@ func ResourceCloudTrail() *schema.Resource { // nosemgrep:ci.cloudtrail-in-func-
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				plan := d.GetRawPlan().GetAttr("advanced_event_selector")
+
+				if !plan.IsKnown() || plan.IsNull() {
+					return nil
+				}
+
+				tfList := d.Get("advanced_event_selector").([]interface{})
+
+				// Unknown nested values read as zero values, so skip any selector that isn't wholly known.
+				for i, v := range plan.AsValueSlice() {
+					if i < len(tfList) && !v.IsWhollyKnown() {
+						tfList[i] = nil
+					}
+				}
+
+				return validAdvancedEventSelectors(tfList)
+			},
+		),
 	}
 }
 
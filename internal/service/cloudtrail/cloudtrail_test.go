@@ -33,21 +33,22 @@ func TestAccCloudTrail_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"Trail": {
-			"basic":                 testAcc_basic,
-			"cloudwatch":            testAcc_cloudWatch,
-			"enableLogging":         testAcc_enableLogging,
-			"globalServiceEvents":   testAcc_globalServiceEvents,
-			"multiRegion":           testAcc_multiRegion,
-			"organization":          testAcc_organization,
-			"logValidation":         testAcc_logValidation,
-			"kmsKey":                testAcc_kmsKey,
-			"tags":                  testAcc_tags,
-			"eventSelector":         testAcc_eventSelector,
-			"eventSelectorDynamoDB": testAcc_eventSelectorDynamoDB,
-			"eventSelectorExclude":  testAcc_eventSelectorExclude,
-			"insightSelector":       testAcc_insightSelector,
-			"advancedEventSelector": testAcc_advanced_event_selector,
-			"disappears":            testAcc_disappears,
+			"basic":                        testAcc_basic,
+			"cloudwatch":                   testAcc_cloudWatch,
+			"enableLogging":                testAcc_enableLogging,
+			"globalServiceEvents":          testAcc_globalServiceEvents,
+			"multiRegion":                  testAcc_multiRegion,
+			"organization":                 testAcc_organization,
+			"logValidation":                testAcc_logValidation,
+			"kmsKey":                       testAcc_kmsKey,
+			"tags":                         testAcc_tags,
+			"eventSelector":                testAcc_eventSelector,
+			"eventSelectorDynamoDB":        testAcc_eventSelectorDynamoDB,
+			"eventSelectorExclude":         testAcc_eventSelectorExclude,
+			"insightSelector":              testAcc_insightSelector,
+			"advancedEventSelector":        testAcc_advanced_event_selector,
+			"advancedEventSelectorUnknown": testAcc_advancedEventSelectorUnknown,
+			"disappears":                   testAcc_disappears,
 		},
 	}
 
@@ -741,6 +742,32 @@ func testAcc_advanced_event_selector(t *testing.T) {
 	})
 }
 
+func testAcc_advancedEventSelectorUnknown(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudtrail.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudtrail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrailDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudTrailConfig_advancedEventSelectorUnknown(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "advanced_event_selector.0.field_selector.*", map[string]string{
+						"field":    "eventCategory",
+						"equals.#": "1",
+						"equals.0": "Data",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAcc_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var trail cloudtrail.Trail
@@ -1613,3 +1640,39 @@ resource "aws_s3_bucket" "test2" {
 }
 `, rName))
 }
+
+func testAccCloudTrailConfig_advancedEventSelectorUnknown(rName string) string {
+	return acctest.ConfigCompose(testAccBaseConfig(rName), fmt.Sprintf(`
+resource "aws_cloudtrail" "test" {
+  # Must have bucket policy attached first
+  depends_on = [aws_s3_bucket_policy.test]
+
+  name           = %[1]q
+  s3_bucket_name = aws_s3_bucket.test.id
+
+  advanced_event_selector {
+    name = "s3Custom"
+    field_selector {
+      field = "eventCategory"
+      # Unknown until aws_s3_bucket.test2 is created.
+      equals = [aws_s3_bucket.test2.arn != "" ? "Data" : "Management"]
+    }
+
+    field_selector {
+      field  = "resources.ARN"
+      equals = ["${aws_s3_bucket.test2.arn}/"]
+    }
+
+    field_selector {
+      field  = "resources.type"
+      equals = ["AWS::S3::Object"]
+    }
+  }
+}
+
+resource "aws_s3_bucket" "test2" {
+  bucket        = "%[1]s-2"
+  force_destroy = true
+}
+`, rName))
+}
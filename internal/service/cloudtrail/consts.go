@@ -21,22 +21,46 @@ func resourceType_Values() []string {
 }
 
 const (
-	fieldEventCategory = "eventCategory"
-	fieldEventName     = "eventName"
-	fieldEventSource   = "eventSource"
-	fieldReadOnly      = "readOnly"
-	fieldResourcesARN  = "resources.ARN"
-	fieldResourcesType = "resources.type"
+	fieldErrorCode                    = "errorCode"
+	fieldEventCategory                = "eventCategory"
+	fieldEventName                    = "eventName"
+	fieldEventSource                  = "eventSource"
+	fieldEventType                    = "eventType"
+	fieldReadOnly                     = "readOnly"
+	fieldResourcesARN                 = "resources.ARN"
+	fieldResourcesType                = "resources.type"
+	fieldSessionCredentialFromConsole = "sessionCredentialFromConsole"
+	fieldUserIdentityARN              = "userIdentity.arn"
+	fieldVPCEndpointID                = "vpcEndpointId"
 )
 
 func field_Values() []string {
 	return []string{
+		fieldErrorCode,
 		fieldEventCategory,
 		fieldEventName,
 		fieldEventSource,
+		fieldEventType,
 		fieldReadOnly,
 		fieldResourcesARN,
 		fieldResourcesType,
+		fieldSessionCredentialFromConsole,
+		fieldUserIdentityARN,
+		fieldVPCEndpointID,
+	}
+}
+
+const (
+	eventCategoryData            = "Data"
+	eventCategoryManagement      = "Management"
+	eventCategoryNetworkActivity = "NetworkActivity"
+)
+
+func eventCategory_Values() []string {
+	return []string{
+		eventCategoryData,
+		eventCategoryManagement,
+		eventCategoryNetworkActivity,
 	}
 }
 
@@ -0,0 +1,142 @@
+package cloudtrail
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
+)
+
+var (
+	// Fields that only support the equals operator.
+	advancedEventSelectorEqualsOnlyFields = []string{
+		fieldEventCategory,
+		fieldReadOnly,
+		fieldResourcesType,
+	}
+
+	// Fields that can be used with each event category.
+	advancedEventSelectorEventCategoryFields = map[string][]string{
+		eventCategoryData: {
+			fieldEventCategory,
+			fieldEventName,
+			fieldEventSource,
+			fieldEventType,
+			fieldReadOnly,
+			fieldResourcesARN,
+			fieldResourcesType,
+			fieldSessionCredentialFromConsole,
+			fieldUserIdentityARN,
+		},
+		eventCategoryManagement: {
+			fieldEventCategory,
+			fieldEventSource,
+			fieldReadOnly,
+		},
+		eventCategoryNetworkActivity: {
+			fieldErrorCode,
+			fieldEventCategory,
+			fieldEventName,
+			fieldEventSource,
+			fieldVPCEndpointID,
+		},
+	}
+
+	// Fields that must be specified for each event category.
+	advancedEventSelectorEventCategoryRequiredFields = map[string][]string{
+		eventCategoryData:            {fieldResourcesType},
+		eventCategoryNetworkActivity: {fieldEventSource},
+	}
+)
+
+// validAdvancedEventSelectors checks the combinations of fields and operators in
+// advanced event selectors that the CloudTrail API would otherwise reject at apply time.
+func validAdvancedEventSelectors(tfList []interface{}) error {
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		v, ok := tfMap["field_selector"].(*schema.Set)
+
+		if !ok {
+			continue
+		}
+
+		if err := validAdvancedEventSelectorFieldSelectors(v.List()); err != nil {
+			return fmt.Errorf("advanced_event_selector.%d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validAdvancedEventSelectorFieldSelectors(tfList []interface{}) error {
+	fieldSelectors := make(map[string]map[string]interface{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		field, _ := tfMap["field"].(string)
+
+		if _, ok := fieldSelectors[field]; ok {
+			return fmt.Errorf("field %q is specified more than once", field)
+		}
+		fieldSelectors[field] = tfMap
+
+		var operators []string
+		for _, operator := range []string{"ends_with", "equals", "not_ends_with", "not_equals", "not_starts_with", "starts_with"} {
+			if v, ok := tfMap[operator].([]interface{}); ok && len(v) > 0 {
+				operators = append(operators, operator)
+			}
+		}
+
+		if len(operators) == 0 {
+			return fmt.Errorf("field %q must specify at least one operator", field)
+		}
+
+		if slices.Contains(advancedEventSelectorEqualsOnlyFields, field) && (len(operators) > 1 || operators[0] != "equals") {
+			return fmt.Errorf("field %q only supports the equals operator", field)
+		}
+	}
+
+	tfMap, ok := fieldSelectors[fieldEventCategory]
+
+	if !ok {
+		return fmt.Errorf("field %q must be specified", fieldEventCategory)
+	}
+
+	eventCategories, _ := tfMap["equals"].([]interface{})
+
+	if len(eventCategories) != 1 {
+		return fmt.Errorf("field %q must equal exactly one of: %s", fieldEventCategory, strings.Join(eventCategory_Values(), ", "))
+	}
+
+	eventCategory, _ := eventCategories[0].(string)
+	fields, ok := advancedEventSelectorEventCategoryFields[eventCategory]
+
+	if !ok {
+		return fmt.Errorf("field %q must equal exactly one of: %s", fieldEventCategory, strings.Join(eventCategory_Values(), ", "))
+	}
+
+	for field := range fieldSelectors {
+		if !slices.Contains(fields, field) {
+			return fmt.Errorf("field %q is not supported for %s events", field, eventCategory)
+		}
+	}
+
+	for _, field := range advancedEventSelectorEventCategoryRequiredFields[eventCategory] {
+		if _, ok := fieldSelectors[field]; !ok {
+			return fmt.Errorf("field %q must be specified for %s events", field, eventCategory)
+		}
+	}
+
+	return nil
+}
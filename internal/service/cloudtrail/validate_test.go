@@ -0,0 +1,173 @@
+package cloudtrail
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+func testAdvancedEventSelector(fieldSelectors ...map[string]interface{}) []interface{} {
+	s := schema.NewSet(func(v interface{}) int {
+		return create.StringHashcode(fmt.Sprint(v))
+	}, nil)
+
+	for _, v := range fieldSelectors {
+		s.Add(v)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"field_selector": s,
+		},
+	}
+}
+
+func testFieldSelector(field, operator string, values ...interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"field":  field,
+		operator: values,
+	}
+}
+
+func TestValidAdvancedEventSelectors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []interface{}
+		hasError bool
+	}{
+		{
+			name: "management",
+			input: testAdvancedEventSelector(
+				testFieldSelector("eventCategory", "equals", "Management"),
+				testFieldSelector("readOnly", "equals", "true"),
+			),
+		},
+		{
+			name: "data",
+			input: testAdvancedEventSelector(
+				testFieldSelector("eventCategory", "equals", "Data"),
+				testFieldSelector("resources.type", "equals", "AWS::S3::Object"),
+				testFieldSelector("resources.ARN", "not_starts_with", "arn:aws:s3:::example/"),
+			),
+		},
+		{
+			name: "data identity fields",
+			input: testAdvancedEventSelector(
+				testFieldSelector("eventCategory", "equals", "Data"),
+				testFieldSelector("resources.type", "equals", "AWS::S3::Object"),
+				testFieldSelector("eventType", "equals", "AwsApiCall"),
+				testFieldSelector("sessionCredentialFromConsole", "equals", "true"),
+				testFieldSelector("userIdentity.arn", "not_starts_with", "arn:aws:iam::123456789012:role/example"),
+			),
+		},
+		{
+			name: "network activity",
+			input: testAdvancedEventSelector(
+				testFieldSelector("eventCategory", "equals", "NetworkActivity"),
+				testFieldSelector("eventSource", "equals", "s3.amazonaws.com"),
+				testFieldSelector("errorCode", "equals", "VpceAccessDenied"),
+				testFieldSelector("vpcEndpointId", "not_equals", "vpce-12345678"),
+			),
+		},
+		{
+			name: "missing event category",
+			input: testAdvancedEventSelector(
+				testFieldSelector("readOnly", "equals", "true"),
+			),
+			hasError: true,
+		},
+		{
+			name: "invalid event category",
+			input: testAdvancedEventSelector(
+				testFieldSelector("eventCategory", "equals", "Insight"),
+			),
+			hasError: true,
+		},
+		{
+			name: "multiple event categories",
+			input: testAdvancedEventSelector(
+				testFieldSelector("eventCategory", "equals", "Data", "Management"),
+			),
+			hasError: true,
+		},
+		{
+			name: "equals only field",
+			input: testAdvancedEventSelector(
+				testFieldSelector("eventCategory", "equals", "Data"),
+				testFieldSelector("resources.type", "starts_with", "AWS::S3::"),
+			),
+			hasError: true,
+		},
+		{
+			name: "no operator",
+			input: testAdvancedEventSelector(
+				testFieldSelector("eventCategory", "equals", "Management"),
+				map[string]interface{}{"field": "readOnly"},
+			),
+			hasError: true,
+		},
+		{
+			name: "data without resource type",
+			input: testAdvancedEventSelector(
+				testFieldSelector("eventCategory", "equals", "Data"),
+				testFieldSelector("eventName", "equals", "PutObject"),
+			),
+			hasError: true,
+		},
+		{
+			name: "network activity without event source",
+			input: testAdvancedEventSelector(
+				testFieldSelector("eventCategory", "equals", "NetworkActivity"),
+				testFieldSelector("vpcEndpointId", "equals", "vpce-12345678"),
+			),
+			hasError: true,
+		},
+		{
+			name: "network activity unsupported field",
+			input: testAdvancedEventSelector(
+				testFieldSelector("eventCategory", "equals", "NetworkActivity"),
+				testFieldSelector("eventSource", "equals", "s3.amazonaws.com"),
+				testFieldSelector("readOnly", "equals", "true"),
+			),
+			hasError: true,
+		},
+		{
+			name: "data unsupported field",
+			input: testAdvancedEventSelector(
+				testFieldSelector("eventCategory", "equals", "Data"),
+				testFieldSelector("resources.type", "equals", "AWS::S3::Object"),
+				testFieldSelector("vpcEndpointId", "equals", "vpce-12345678"),
+			),
+			hasError: true,
+		},
+		{
+			name: "management unsupported field",
+			input: testAdvancedEventSelector(
+				testFieldSelector("eventCategory", "equals", "Management"),
+				testFieldSelector("resources.type", "equals", "AWS::S3::Object"),
+			),
+			hasError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validAdvancedEventSelectors(testCase.input)
+
+			if testCase.hasError && err == nil {
+				t.Errorf("expected error, got none")
+			}
+
+			if !testCase.hasError && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
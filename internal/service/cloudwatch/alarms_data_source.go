@@ -0,0 +1,102 @@
+package cloudwatch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_cloudwatch_alarms")
+func DataSourceAlarms() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAlarmsRead,
+
+		Schema: map[string]*schema.Schema{
+			"alarm_name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"alarm_names": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"alarm_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(cloudwatch.AlarmType_Values(), false),
+				},
+			},
+			"arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"state_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(cloudwatch.StateValue_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceAlarmsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn()
+
+	input := &cloudwatch.DescribeAlarmsInput{
+		// Only metric alarms are returned unless alarm types are specified.
+		AlarmTypes: aws.StringSlice(cloudwatch.AlarmType_Values()),
+	}
+
+	if v, ok := d.GetOk("alarm_name_prefix"); ok {
+		input.AlarmNamePrefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("alarm_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.AlarmTypes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("state_value"); ok {
+		input.StateValue = aws.String(v.(string))
+	}
+
+	var alarmNames, arns []string
+
+	err := conn.DescribeAlarmsPagesWithContext(ctx, input, func(page *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CompositeAlarms {
+			alarmNames = append(alarmNames, aws.StringValue(v.AlarmName))
+			arns = append(arns, aws.StringValue(v.AlarmArn))
+		}
+
+		for _, v := range page.MetricAlarms {
+			alarmNames = append(alarmNames, aws.StringValue(v.AlarmName))
+			arns = append(arns, aws.StringValue(v.AlarmArn))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch Alarms: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("alarm_names", alarmNames)
+	d.Set("arns", arns)
+
+	return nil
+}
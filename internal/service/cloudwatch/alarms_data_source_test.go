@@ -0,0 +1,79 @@
+package cloudwatch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudWatchAlarmsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_alarms.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "alarm_names.#", "3"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "alarm_names.*", "aws_cloudwatch_metric_alarm.test.0", "alarm_name"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "alarm_names.*", "aws_cloudwatch_metric_alarm.test.1", "alarm_name"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "alarm_names.*", "aws_cloudwatch_composite_alarm.test", "alarm_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "3"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", "aws_cloudwatch_composite_alarm.test", "arn"),
+				),
+			},
+			{
+				Config: testAccAlarmsDataSourceConfig_alarmTypes(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "alarm_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "alarm_names.*", "aws_cloudwatch_composite_alarm.test", "alarm_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAlarmsDataSourceConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccCompositeAlarmConfig_basic(rName), `
+resource "aws_cloudwatch_metric_alarm" "other" {
+  alarm_name          = "other-${aws_cloudwatch_composite_alarm.test.alarm_name}"
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 2
+  metric_name         = "CPUUtilization"
+  namespace           = "AWS/EC2"
+  period              = 120
+  statistic           = "Average"
+  threshold           = 80
+}
+`)
+}
+
+func testAccAlarmsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAlarmsDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_cloudwatch_alarms" "test" {
+  alarm_name_prefix = %[1]q
+
+  depends_on = [aws_cloudwatch_composite_alarm.test, aws_cloudwatch_metric_alarm.other]
+}
+`, rName))
+}
+
+func testAccAlarmsDataSourceConfig_alarmTypes(rName string) string {
+	return acctest.ConfigCompose(testAccAlarmsDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_cloudwatch_alarms" "test" {
+  alarm_name_prefix = %[1]q
+  alarm_types       = ["CompositeAlarm"]
+
+  depends_on = [aws_cloudwatch_composite_alarm.test, aws_cloudwatch_metric_alarm.other]
+}
+`, rName))
+}
@@ -40,6 +40,28 @@ func ResourceCompositeAlarm() *schema.Resource {
 				Default:  true,
 				ForceNew: true,
 			},
+			"actions_suppressor": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1600),
+						},
+						"extension_period": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"wait_period": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
 			"alarm_actions": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -158,6 +180,13 @@ func resourceCompositeAlarmRead(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	d.Set("actions_enabled", alarm.ActionsEnabled)
+	if alarm.ActionsSuppressor != nil {
+		if err := d.Set("actions_suppressor", []interface{}{flattenActionsSuppressor(alarm)}); err != nil {
+			return diag.Errorf("setting actions_suppressor: %s", err)
+		}
+	} else {
+		d.Set("actions_suppressor", nil)
+	}
 	d.Set("alarm_actions", aws.StringValueSlice(alarm.AlarmActions))
 	d.Set("alarm_description", alarm.AlarmDescription)
 	d.Set("alarm_name", alarm.AlarmName)
@@ -240,6 +269,22 @@ func expandPutCompositeAlarmInput(ctx context.Context, d *schema.ResourceData) *
 		Tags:           GetTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("actions_suppressor"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if v, ok := tfMap["alarm"].(string); ok && v != "" {
+			apiObject.ActionsSuppressor = aws.String(v)
+		}
+
+		if v, ok := tfMap["extension_period"].(int); ok {
+			apiObject.ActionsSuppressorExtensionPeriod = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["wait_period"].(int); ok {
+			apiObject.ActionsSuppressorWaitPeriod = aws.Int64(int64(v))
+		}
+	}
+
 	if v, ok := d.GetOk("alarm_actions"); ok {
 		apiObject.AlarmActions = flex.ExpandStringSet(v.(*schema.Set))
 	}
@@ -266,3 +311,25 @@ func expandPutCompositeAlarmInput(ctx context.Context, d *schema.ResourceData) *
 
 	return apiObject
 }
+
+func flattenActionsSuppressor(apiObject *cloudwatch.CompositeAlarm) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ActionsSuppressor; v != nil {
+		tfMap["alarm"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ActionsSuppressorExtensionPeriod; v != nil {
+		tfMap["extension_period"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ActionsSuppressorWaitPeriod; v != nil {
+		tfMap["wait_period"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
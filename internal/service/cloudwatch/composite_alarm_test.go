@@ -153,6 +153,52 @@ func TestAccCloudWatchCompositeAlarm_actionsEnabled(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchCompositeAlarm_actionsSuppressor(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_composite_alarm.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCompositeAlarmDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCompositeAlarmConfig_actionsSuppressor(rName, 60, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCompositeAlarmExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "actions_suppressor.0.alarm", "aws_cloudwatch_metric_alarm.test.0", "alarm_name"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.0.extension_period", "60"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.0.wait_period", "120"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCompositeAlarmConfig_actionsSuppressor(rName, 30, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCompositeAlarmExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.0.extension_period", "30"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.0.wait_period", "60"),
+				),
+			},
+			{
+				Config: testAccCompositeAlarmConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCompositeAlarmExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchCompositeAlarm_alarmActions(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -498,6 +544,21 @@ resource "aws_cloudwatch_composite_alarm" "test" {
 `, rName, enabled))
 }
 
+func testAccCompositeAlarmConfig_actionsSuppressor(rName string, extensionPeriod, waitPeriod int) string {
+	return acctest.ConfigCompose(testAccCompositeAlarmConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_composite_alarm" "test" {
+  alarm_name = %[1]q
+  alarm_rule = "ALARM(${aws_cloudwatch_metric_alarm.test[1].alarm_name})"
+
+  actions_suppressor {
+    alarm            = aws_cloudwatch_metric_alarm.test[0].alarm_name
+    extension_period = %[2]d
+    wait_period      = %[3]d
+  }
+}
+`, rName, extensionPeriod, waitPeriod))
+}
+
 func testAccCompositeAlarmConfig_actions(rName string) string {
 	return acctest.ConfigCompose(testAccCompositeAlarmConfig_base(rName), fmt.Sprintf(`
 resource "aws_sns_topic" "test" {
@@ -0,0 +1,225 @@
+package cloudwatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	dashboardMaxWidgets      = 500
+	dashboardGridWidth       = 24
+	dashboardMaxWidgetHeight = 1000
+)
+
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html#CloudWatch-Dashboard-Properties-Widgets-Structure.
+func dashboardWidgetType_Values() []string {
+	return []string{
+		"alarm",
+		"custom",
+		"explorer",
+		"log",
+		"metric",
+		"text",
+	}
+}
+
+// @SDKDataSource("aws_cloudwatch_dashboard_body")
+func DataSourceDashboardBody() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDashboardBodyRead,
+
+		Schema: map[string]*schema.Schema{
+			"end": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"start"},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"auto", "inherit"}, false),
+			},
+			"start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"widgets": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDashboardBodyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var fragments []string
+	for _, v := range d.Get("widgets").([]interface{}) {
+		v, ok := v.(string)
+		if !ok {
+			continue
+		}
+
+		fragments = append(fragments, v)
+	}
+
+	widgets, err := expandDashboardWidgets(fragments)
+
+	if err != nil {
+		return diag.Errorf("building CloudWatch Dashboard body: %s", err)
+	}
+
+	body := map[string]interface{}{
+		"widgets": widgets,
+	}
+
+	if v, ok := d.GetOk("end"); ok {
+		body["end"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("period_override"); ok {
+		body["periodOverride"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("start"); ok {
+		body["start"] = v.(string)
+	}
+
+	b, err := json.Marshal(body)
+
+	if err != nil {
+		return diag.Errorf("building CloudWatch Dashboard body: %s", err)
+	}
+
+	jsonString := string(b)
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set("json", jsonString)
+
+	return nil
+}
+
+// expandDashboardWidgets merges widget fragments into a single list of widgets.
+// Each fragment is either a single widget, a list of widgets or a dashboard body with a "widgets" key.
+func expandDashboardWidgets(fragments []string) ([]interface{}, error) {
+	widgets := make([]interface{}, 0)
+
+	for i, fragment := range fragments {
+		var v interface{}
+
+		if err := json.Unmarshal([]byte(fragment), &v); err != nil {
+			return nil, fmt.Errorf("widgets.%d: %w", i, err)
+		}
+
+		switch v := v.(type) {
+		case []interface{}:
+			widgets = append(widgets, v...)
+		case map[string]interface{}:
+			if w, ok := v["widgets"]; ok {
+				w, ok := w.([]interface{})
+				if !ok {
+					return nil, fmt.Errorf("widgets.%d: \"widgets\" must be a list", i)
+				}
+
+				widgets = append(widgets, w...)
+			} else {
+				widgets = append(widgets, v)
+			}
+		default:
+			return nil, fmt.Errorf("widgets.%d: must be a widget object, a list of widgets or a dashboard body", i)
+		}
+	}
+
+	if n := len(widgets); n > dashboardMaxWidgets {
+		return nil, fmt.Errorf("a dashboard can contain at most %d widgets, got %d", dashboardMaxWidgets, n)
+	}
+
+	for i, widget := range widgets {
+		if err := validDashboardWidget(widget); err != nil {
+			return nil, fmt.Errorf("widget %d: %w", i, err)
+		}
+	}
+
+	return widgets, nil
+}
+
+func validDashboardWidget(v interface{}) error {
+	widget, ok := v.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("must be an object")
+	}
+
+	widgetType, ok := widget["type"].(string)
+	if !ok {
+		return fmt.Errorf("\"type\" is required")
+	}
+
+	if !slices.Contains(dashboardWidgetType_Values(), widgetType) {
+		return fmt.Errorf("\"type\" must be one of %v, got %q", dashboardWidgetType_Values(), widgetType)
+	}
+
+	if _, ok := widget["properties"].(map[string]interface{}); !ok {
+		return fmt.Errorf("\"properties\" is required and must be an object")
+	}
+
+	x, err := dashboardWidgetInt(widget, "x", 0, dashboardGridWidth-1)
+	if err != nil {
+		return err
+	}
+
+	if _, err := dashboardWidgetInt(widget, "y", 0, math.MaxInt32); err != nil {
+		return err
+	}
+
+	width, err := dashboardWidgetInt(widget, "width", 1, dashboardGridWidth)
+	if err != nil {
+		return err
+	}
+
+	if _, err := dashboardWidgetInt(widget, "height", 1, dashboardMaxWidgetHeight); err != nil {
+		return err
+	}
+
+	if x != nil && width != nil && *x+*width > dashboardGridWidth {
+		return fmt.Errorf("\"x\" + \"width\" must not exceed %d, got %d", dashboardGridWidth, *x+*width)
+	}
+
+	return nil
+}
+
+// dashboardWidgetInt returns the value of an optional integer widget field, checking that it is within [min, max].
+func dashboardWidgetInt(widget map[string]interface{}, key string, min, max int) (*int, error) {
+	v, ok := widget[key]
+	if !ok {
+		return nil, nil
+	}
+
+	f, ok := v.(float64)
+	if !ok || f != math.Trunc(f) {
+		return nil, fmt.Errorf("%q must be an integer", key)
+	}
+
+	n := int(f)
+	if n < min || n > max {
+		return nil, fmt.Errorf("%q must be between %d and %d, got %d", key, min, max, n)
+	}
+
+	return &n, nil
+}
@@ -0,0 +1,110 @@
+package cloudwatch_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudWatchDashboardBodyDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_dashboard_body.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardBodyDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", `{"periodOverride":"inherit","widgets":[{"height":6,"properties":{"markdown":"Hello"},"type":"text","width":12,"x":0,"y":0},{"height":6,"properties":{"markdown":"World"},"type":"text","width":12,"x":12,"y":0},{"height":6,"properties":{"alarms":["arn:aws:cloudwatch:us-west-2:123456789012:alarm:test"]},"type":"alarm","width":24,"x":0,"y":6}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardBodyDataSource_invalidWidget(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardBodyDataSourceConfig_invalidType,
+				ExpectError: regexp.MustCompile(`"type" must be one of`),
+			},
+			{
+				Config:      testAccDashboardBodyDataSourceConfig_invalidWidth,
+				ExpectError: regexp.MustCompile(`"x" \+ "width" must not exceed 24`),
+			},
+		},
+	})
+}
+
+const testAccDashboardBodyDataSourceConfig_basic = `
+data "aws_cloudwatch_dashboard_body" "test" {
+  period_override = "inherit"
+
+  widgets = [
+    jsonencode({
+      type       = "text"
+      x          = 0
+      y          = 0
+      width      = 12
+      height     = 6
+      properties = { markdown = "Hello" }
+    }),
+    jsonencode({
+      widgets = [
+        {
+          type       = "text"
+          x          = 12
+          y          = 0
+          width      = 12
+          height     = 6
+          properties = { markdown = "World" }
+        },
+        {
+          type       = "alarm"
+          x          = 0
+          y          = 6
+          width      = 24
+          height     = 6
+          properties = { alarms = ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:test"] }
+        },
+      ]
+    }),
+  ]
+}
+`
+
+const testAccDashboardBodyDataSourceConfig_invalidType = `
+data "aws_cloudwatch_dashboard_body" "test" {
+  widgets = [
+    jsonencode({
+      type       = "graph"
+      properties = {}
+    }),
+  ]
+}
+`
+
+const testAccDashboardBodyDataSourceConfig_invalidWidth = `
+data "aws_cloudwatch_dashboard_body" "test" {
+  widgets = [
+    jsonencode([{
+      type       = "text"
+      x          = 12
+      width      = 24
+      properties = { markdown = "Too wide" }
+    }]),
+  ]
+}
+`
@@ -20,7 +20,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceAlarms,
+			TypeName: "aws_cloudwatch_alarms",
+		},
+		{
+			Factory:  DataSourceDashboardBody,
+			TypeName: "aws_cloudwatch_dashboard_body",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
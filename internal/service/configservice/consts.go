@@ -17,4 +17,5 @@ const (
 	ResNameOrganizationManagedRule     = "Organization Managed Rule"
 	ResNameOrganizationCustomRule      = "Organization Custom Rule"
 	ResNameRemediationConfiguration    = "Remediation Configuration"
+	ResNameRetentionConfiguration      = "Retention Configuration"
 )
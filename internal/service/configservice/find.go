@@ -57,3 +57,32 @@ func FindOrganizationConfigRule(ctx aws.Context, conn *configservice.ConfigServi
 
 	return output.OrganizationConfigRules[0], nil
 }
+
+func FindRetentionConfigurationByName(ctx context.Context, conn *configservice.ConfigService, name string) (*configservice.RetentionConfiguration, error) {
+	input := &configservice.DescribeRetentionConfigurationsInput{
+		RetentionConfigurationNames: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeRetentionConfigurationsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchRetentionConfigurationException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.RetentionConfigurations) == 0 || output.RetentionConfigurations[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.RetentionConfigurations); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.RetentionConfigurations[0], nil
+}
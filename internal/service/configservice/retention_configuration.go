@@ -0,0 +1,103 @@
+package configservice
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_config_retention_configuration")
+func ResourceRetentionConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRetentionConfigurationPut,
+		ReadWithoutTimeout:   resourceRetentionConfigurationRead,
+		UpdateWithoutTimeout: resourceRetentionConfigurationPut,
+		DeleteWithoutTimeout: resourceRetentionConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"retention_period_in_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(30, 2557),
+			},
+		},
+	}
+}
+
+func resourceRetentionConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	input := &configservice.PutRetentionConfigurationInput{
+		RetentionPeriodInDays: aws.Int64(int64(d.Get("retention_period_in_days").(int))),
+	}
+
+	output, err := conn.PutRetentionConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionCreating, ResNameRetentionConfiguration, d.Id(), err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(aws.StringValue(output.RetentionConfiguration.Name))
+	}
+
+	return resourceRetentionConfigurationRead(ctx, d, meta)
+}
+
+func resourceRetentionConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	retentionConfiguration, err := FindRetentionConfigurationByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.ConfigService, create.ErrActionReading, ResNameRetentionConfiguration, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionReading, ResNameRetentionConfiguration, d.Id(), err)
+	}
+
+	d.Set("name", retentionConfiguration.Name)
+	d.Set("retention_period_in_days", retentionConfiguration.RetentionPeriodInDays)
+
+	return nil
+}
+
+func resourceRetentionConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	log.Printf("[DEBUG] Deleting ConfigService Retention Configuration: %s", d.Id())
+	_, err := conn.DeleteRetentionConfigurationWithContext(ctx, &configservice.DeleteRetentionConfigurationInput{
+		RetentionConfigurationName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchRetentionConfigurationException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionDeleting, ResNameRetentionConfiguration, d.Id(), err)
+	}
+
+	return nil
+}
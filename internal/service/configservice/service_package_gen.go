@@ -85,6 +85,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceRemediationConfiguration,
 			TypeName: "aws_config_remediation_configuration",
 		},
+		{
+			Factory:  ResourceRetentionConfiguration,
+			TypeName: "aws_config_retention_configuration",
+		},
 	}
 }
 
@@ -35,6 +35,9 @@ func DataSourcePipeline() *schema.Resource {
 func dataSourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DataPipelineConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		defaultTagsConfig = inContext.DefaultConfig
+	}
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	pipelineId := d.Get("pipeline_id").(string)
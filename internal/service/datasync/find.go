@@ -159,3 +159,52 @@ func FindLocationObjectStorageByARN(ctx context.Context, conn *datasync.DataSync
 
 	return output, nil
 }
+
+func FindTaskExecutionByARN(ctx context.Context, conn *datasync.DataSync, arn string) (*datasync.DescribeTaskExecutionOutput, error) {
+	input := &datasync.DescribeTaskExecutionInput{
+		TaskExecutionArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeTaskExecutionWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findTaskExecutions(ctx context.Context, conn *datasync.DataSync, input *datasync.ListTaskExecutionsInput) ([]*datasync.TaskExecutionListEntry, error) {
+	var output []*datasync.TaskExecutionListEntry
+
+	err := conn.ListTaskExecutionsPagesWithContext(ctx, input, func(page *datasync.ListTaskExecutionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TaskExecutions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,200 @@
+package datasync
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_datasync_task_executions")
+func DataSourceTaskExecutions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTaskExecutionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"task_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"task_executions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bytes_compressed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bytes_transferred": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bytes_written": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"estimated_bytes_to_transfer": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"estimated_files_to_transfer": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"files_transferred": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"result": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"error_code": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"error_detail": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"prepare_duration": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"prepare_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"total_duration": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"transfer_duration": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"transfer_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"verify_duration": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"verify_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTaskExecutionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataSyncConn()
+
+	taskARN := d.Get("task_arn").(string)
+	input := &datasync.ListTaskExecutionsInput{
+		TaskArn: aws.String(taskARN),
+	}
+
+	executions, err := findTaskExecutions(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing DataSync Task (%s) executions: %s", taskARN, err)
+	}
+
+	var tfList []interface{}
+
+	for _, v := range executions {
+		arn := aws.StringValue(v.TaskExecutionArn)
+		output, err := FindTaskExecutionByARN(ctx, conn, arn)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading DataSync Task Execution (%s): %s", arn, err)
+		}
+
+		tfList = append(tfList, flattenTaskExecution(output))
+	}
+
+	d.SetId(taskARN)
+	if err := d.Set("task_executions", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting task_executions: %s", err)
+	}
+
+	return diags
+}
+
+func flattenTaskExecution(apiObject *datasync.DescribeTaskExecutionOutput) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":                         aws.StringValue(apiObject.TaskExecutionArn),
+		"bytes_compressed":            aws.Int64Value(apiObject.BytesCompressed),
+		"bytes_transferred":           aws.Int64Value(apiObject.BytesTransferred),
+		"bytes_written":               aws.Int64Value(apiObject.BytesWritten),
+		"estimated_bytes_to_transfer": aws.Int64Value(apiObject.EstimatedBytesToTransfer),
+		"estimated_files_to_transfer": aws.Int64Value(apiObject.EstimatedFilesToTransfer),
+		"files_transferred":           aws.Int64Value(apiObject.FilesTransferred),
+		"status":                      aws.StringValue(apiObject.Status),
+	}
+
+	if v := apiObject.Result; v != nil {
+		tfMap["result"] = []interface{}{flattenTaskExecutionResultDetail(v)}
+	}
+
+	if v := apiObject.StartTime; v != nil {
+		tfMap["start_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenTaskExecutionResultDetail(apiObject *datasync.TaskExecutionResultDetail) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"error_code":        aws.StringValue(apiObject.ErrorCode),
+		"error_detail":      aws.StringValue(apiObject.ErrorDetail),
+		"prepare_duration":  aws.Int64Value(apiObject.PrepareDuration),
+		"prepare_status":    aws.StringValue(apiObject.PrepareStatus),
+		"total_duration":    aws.Int64Value(apiObject.TotalDuration),
+		"transfer_duration": aws.Int64Value(apiObject.TransferDuration),
+		"transfer_status":   aws.StringValue(apiObject.TransferStatus),
+		"verify_duration":   aws.Int64Value(apiObject.VerifyDuration),
+		"verify_status":     aws.StringValue(apiObject.VerifyStatus),
+	}
+
+	return tfMap
+}
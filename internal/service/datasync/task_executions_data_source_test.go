@@ -0,0 +1,41 @@
+package datasync_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/datasync"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccDataSyncTaskExecutionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_datasync_task_executions.test"
+	resourceName := "aws_datasync_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, datasync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskExecutionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "task_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "task_executions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTaskExecutionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTaskConfig_basic(rName), `
+data "aws_datasync_task_executions" "test" {
+  task_arn = aws_datasync_task.test.arn
+}
+`)
+}
@@ -30,6 +30,7 @@ func init() {
 			"aws_transfer_server",
 			"aws_workspaces_directory",
 			"aws_directory_service_region",
+			"aws_directory_service_trust",
 		},
 	})
 
@@ -37,6 +38,11 @@ func init() {
 		Name: "aws_directory_service_region",
 		F:    sweepRegions,
 	})
+
+	resource.AddTestSweepers("aws_directory_service_trust", &resource.Sweeper{
+		Name: "aws_directory_service_trust",
+		F:    sweepTrusts,
+	})
 }
 
 func sweepDirectories(region string) error {
@@ -158,3 +164,57 @@ func sweepRegions(region string) error {
 
 	return errs.ErrorOrNil()
 }
+
+func sweepTrusts(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).DSConn()
+
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	input := &directoryservice.DescribeTrustsInput{}
+	err = describeTrustsPages(ctx, conn, input, func(page *directoryservice.DescribeTrustsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, trust := range page.Trusts {
+			if trust == nil {
+				continue
+			}
+
+			sweepResources = append(sweepResources, sweep.NewSweepFrameworkResource(newResourceTrust, aws.StringValue(trust.TrustId), client,
+				sweep.FrameworkSupplementalAttribute{
+					Path:  "directory_id",
+					Value: aws.StringValue(trust.DirectoryId),
+				},
+				sweep.FrameworkSupplementalAttribute{
+					Path:  "delete_associated_conditional_forwarder",
+					Value: true,
+				},
+			))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Directory Service Trust sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing Directory Service Trusts (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping Directory Service Trusts (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -294,3 +294,10 @@ const (
 	gatewayIDLocal      = "local"
 	gatewayIDVPCLattice = "VpcLattice"
 )
+
+const (
+	// https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_Image.html#API_Image_Contents
+	// The AWS SDK for Go does not define constants for the image deregistration protection states.
+	imageDeregistrationProtectionEnabled             = "enabled"
+	imageDeregistrationProtectionEnabledWithCooldown = "enabled-with-cooldown"
+)
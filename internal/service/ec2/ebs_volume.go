@@ -83,7 +83,6 @@ func ResourceEBSVolume() *schema.Resource {
 			"multi_attach_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
 			},
 			"outpost_arn": {
 				Type:         schema.TypeString,
@@ -117,6 +116,11 @@ func ResourceEBSVolume() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"wait_for_modification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -226,7 +230,7 @@ func resourceEBSVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept("final_snapshot", "tags", "tags_all", "wait_for_modification") {
 		input := &ec2.ModifyVolumeInput{
 			VolumeId: aws.String(d.Id()),
 		}
@@ -235,6 +239,10 @@ func resourceEBSVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			input.Iops = aws.Int64(int64(d.Get("iops").(int)))
 		}
 
+		if d.HasChange("multi_attach_enabled") {
+			input.MultiAttachEnabled = aws.Bool(d.Get("multi_attach_enabled").(bool))
+		}
+
 		if d.HasChange("size") {
 			input.Size = aws.Int64(int64(d.Get("size").(int)))
 		}
@@ -267,6 +275,12 @@ func resourceEBSVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		if _, err := WaitVolumeUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EBS Volume (%s) update: %s", d.Id(), err)
 		}
+
+		if d.Get("wait_for_modification").(bool) {
+			if _, err := WaitVolumeModificationOptimized(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for EBS Volume (%s) modification: %s", d.Id(), err)
+			}
+		}
 	}
 
 	return append(diags, resourceEBSVolumeRead(ctx, d, meta)...)
@@ -373,6 +387,11 @@ func resourceEBSVolumeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff
 	} else {
 		// Update.
 
+		// MultiAttachEnabled is supported with io1 & io2 volumes only.
+		if diff.HasChange("multi_attach_enabled") && multiAttachEnabled && volumeType != ec2.VolumeTypeIo1 && volumeType != ec2.VolumeTypeIo2 {
+			return fmt.Errorf("'multi_attach_enabled' must not be set when 'type' is '%s'", volumeType)
+		}
+
 		// Setting 'iops = 0' is a no-op if the volume type does not require Iops to be specified.
 		if diff.HasChange("iops") && volumeType != ec2.VolumeTypeIo1 && volumeType != ec2.VolumeTypeIo2 && volumeType != ec2.VolumeTypeGp3 && iops == 0 {
 			return diff.Clear("iops")
@@ -48,7 +48,7 @@ func TestAccEC2EBSVolume_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 		},
 	})
@@ -101,7 +101,7 @@ func TestAccEC2EBSVolume_updateAttachedEBSVolume(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 			{
 				Config: testAccEBSVolumeConfig_attachedUpdateSize(rName),
@@ -139,7 +139,7 @@ func TestAccEC2EBSVolume_updateSize(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 			{
 				Config: testAccEBSVolumeConfig_updateSize(rName),
@@ -153,6 +153,38 @@ func TestAccEC2EBSVolume_updateSize(t *testing.T) {
 	})
 }
 
+func TestAccEC2EBSVolume_waitForModification(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.Volume
+	resourceName := "aws_ebs_volume.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVolumeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSVolumeConfig_waitForModification(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "size", "1"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_modification", "true"),
+				),
+			},
+			{
+				Config: testAccEBSVolumeConfig_waitForModification(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "size", "2"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_modification", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2EBSVolume_updateType(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v ec2.Volume
@@ -177,7 +209,7 @@ func TestAccEC2EBSVolume_updateType(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 			{
 				Config: testAccEBSVolumeConfig_updateType(rName),
@@ -215,7 +247,7 @@ func TestAccEC2EBSVolume_UpdateIops_io1(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 			{
 				Config: testAccEBSVolumeConfig_iopsIo1Updated(rName),
@@ -253,7 +285,7 @@ func TestAccEC2EBSVolume_UpdateIops_io2(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 			{
 				Config: testAccEBSVolumeConfig_iopsIo2Updated(rName),
@@ -293,7 +325,7 @@ func TestAccEC2EBSVolume_kmsKey(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 		},
 	})
@@ -322,7 +354,7 @@ func TestAccEC2EBSVolume_noIops(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 		},
 	})
@@ -384,7 +416,7 @@ func TestAccEC2EBSVolume_withTags(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 			{
 				Config: testAccEBSVolumeConfig_tags2("key1", "value1updated", "key2", "value2"),
@@ -432,7 +464,7 @@ func TestAccEC2EBSVolume_multiAttach_io1(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 		},
 	})
@@ -463,7 +495,45 @@ func TestAccEC2EBSVolume_multiAttach_io2(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
+			},
+		},
+	})
+}
+
+func TestAccEC2EBSVolume_multiAttach_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.Volume
+	resourceName := "aws_ebs_volume.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVolumeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSVolumeConfig_multiAttachEnabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "multi_attach_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "type", "io2"),
+				),
+			},
+			{
+				Config: testAccEBSVolumeConfig_multiAttachEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "multi_attach_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "type", "io2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 		},
 	})
@@ -509,7 +579,7 @@ func TestAccEC2EBSVolume_outpost(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 		},
 	})
@@ -549,7 +619,7 @@ func TestAccEC2EBSVolume_GP3_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 		},
 	})
@@ -589,7 +659,7 @@ func TestAccEC2EBSVolume_GP3_iops(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 			{
 				Config: testAccEBSVolumeConfig_sizeTypeIOPSThroughput(rName, "10", "gp3", "5000", "200"),
@@ -647,7 +717,7 @@ func TestAccEC2EBSVolume_GP3_throughput(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 			{
 				Config: testAccEBSVolumeConfig_sizeTypeIOPSThroughput(rName, "10", "gp3", "", "600"),
@@ -705,7 +775,7 @@ func TestAccEC2EBSVolume_gp3ToGP2(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 			{
 				Config: testAccEBSVolumeConfig_sizeTypeIOPSThroughput(rName, "10", "gp2", "", ""),
@@ -763,7 +833,7 @@ func TestAccEC2EBSVolume_io1ToGP3(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 			{
 				Config: testAccEBSVolumeConfig_sizeTypeIOPSThroughput(rName, "100", "gp3", "4000", "125"),
@@ -822,7 +892,7 @@ func TestAccEC2EBSVolume_snapshotID(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 		},
 	})
@@ -863,7 +933,7 @@ func TestAccEC2EBSVolume_snapshotIDAndSize(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 		},
 	})
@@ -891,7 +961,7 @@ func TestAccEC2EBSVolume_finalSnapshot(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"final_snapshot"},
+				ImportStateVerifyIgnore: []string{"final_snapshot", "wait_for_modification"},
 			},
 			{
 				Config:  testAccEBSVolumeConfig_finalSnapshot(rName),
@@ -1084,6 +1154,23 @@ resource "aws_ebs_volume" "test" {
 `, rName))
 }
 
+func testAccEBSVolumeConfig_waitForModification(rName string, size int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone     = data.aws_availability_zones.available.names[0]
+  type                  = "gp2"
+  size                  = %[2]d
+  wait_for_modification = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, size))
+}
+
 func testAccEBSVolumeConfig_updateType(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAvailableAZsNoOptIn(),
@@ -1317,6 +1404,22 @@ resource "aws_ebs_volume" "test" {
 `, rName, volumeType))
 }
 
+func testAccEBSVolumeConfig_multiAttachEnabled(rName string, multiAttachEnabled bool) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone    = data.aws_availability_zones.available.names[0]
+  type                 = "io2"
+  multi_attach_enabled = %[2]t
+  size                 = 4
+  iops                 = 100
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, multiAttachEnabled))
+}
+
 func testAccEBSVolumeConfig_sizeTypeIOPSThroughput(rName, size, volumeType, iops, throughput string) string {
 	if volumeType == "" {
 		volumeType = "null"
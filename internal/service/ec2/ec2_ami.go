@@ -78,6 +78,14 @@ func ResourceAMI() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"deregistration_protection_with_cooldown": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -378,6 +386,12 @@ func resourceAMICreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.Get("deregistration_protection").(bool) {
+		if err := enableImageDeregistrationProtection(ctx, conn, d.Id(), d.Get("deregistration_protection_with_cooldown").(bool)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s): %s", name, err)
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
 
@@ -425,6 +439,13 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("boot_mode", image.BootMode)
 	d.Set("description", image.Description)
 	d.Set("deprecation_time", image.DeprecationTime)
+	switch v := aws.StringValue(image.DeregistrationProtection); {
+	case strings.HasPrefix(v, imageDeregistrationProtectionEnabled):
+		d.Set("deregistration_protection", true)
+		d.Set("deregistration_protection_with_cooldown", v == imageDeregistrationProtectionEnabledWithCooldown)
+	default:
+		d.Set("deregistration_protection", false)
+	}
 	d.Set("ena_support", image.EnaSupport)
 	d.Set("hypervisor", image.Hypervisor)
 	d.Set("image_location", image.ImageLocation)
@@ -476,8 +497,26 @@ func resourceAMIUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	if d.HasChange("deprecation_time") {
-		if err := enableImageDeprecation(ctx, conn, d.Id(), d.Get("deprecation_time").(string)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+		if v := d.Get("deprecation_time").(string); v != "" {
+			if err := enableImageDeprecation(ctx, conn, d.Id(), v); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+			}
+		} else {
+			if err := disableImageDeprecation(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChanges("deregistration_protection", "deregistration_protection_with_cooldown") {
+		if d.Get("deregistration_protection").(bool) {
+			if err := enableImageDeregistrationProtection(ctx, conn, d.Id(), d.Get("deregistration_protection_with_cooldown").(bool)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+			}
+		} else if d.HasChange("deregistration_protection") {
+			if err := disableImageDeregistrationProtection(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -551,6 +590,49 @@ func enableImageDeprecation(ctx context.Context, conn *ec2.EC2, id string, depre
 	return nil
 }
 
+func disableImageDeprecation(ctx context.Context, conn *ec2.EC2, id string) error {
+	input := &ec2.DisableImageDeprecationInput{
+		ImageId: aws.String(id),
+	}
+
+	_, err := conn.DisableImageDeprecationWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("disabling deprecation: %w", err)
+	}
+
+	return nil
+}
+
+func enableImageDeregistrationProtection(ctx context.Context, conn *ec2.EC2, id string, withCooldown bool) error {
+	input := &ec2.EnableImageDeregistrationProtectionInput{
+		ImageId:      aws.String(id),
+		WithCooldown: aws.Bool(withCooldown),
+	}
+
+	_, err := conn.EnableImageDeregistrationProtectionWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("enabling deregistration protection: %w", err)
+	}
+
+	return nil
+}
+
+func disableImageDeregistrationProtection(ctx context.Context, conn *ec2.EC2, id string) error {
+	input := &ec2.DisableImageDeregistrationProtectionInput{
+		ImageId: aws.String(id),
+	}
+
+	_, err := conn.DisableImageDeregistrationProtectionWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("disabling deregistration protection: %w", err)
+	}
+
+	return nil
+}
+
 func expandBlockDeviceMappingForAMIEBSBlockDevice(tfMap map[string]interface{}) *ec2.BlockDeviceMapping {
 	if tfMap == nil {
 		return nil
@@ -58,6 +58,14 @@ func ResourceAMICopy() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"deregistration_protection_with_cooldown": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -312,5 +320,11 @@ func resourceAMICopyCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.Get("deregistration_protection").(bool) {
+		if err := enableImageDeregistrationProtection(ctx, conn, d.Id(), d.Get("deregistration_protection_with_cooldown").(bool)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s) from source EC2 AMI (%s): %s", name, sourceImageID, err)
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
@@ -57,6 +57,14 @@ func ResourceAMIFromInstance() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"deregistration_protection_with_cooldown": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -280,5 +288,11 @@ func resourceAMIFromInstanceCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.Get("deregistration_protection").(bool) {
+		if err := enableImageDeregistrationProtection(ctx, conn, d.Id(), d.Get("deregistration_protection_with_cooldown").(bool)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s) from EC2 Instance (%s): %s", name, instanceID, err)
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
@@ -169,6 +169,53 @@ func TestAccEC2AMI_deprecateAt(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "virtualization_type", "hvm"),
 				),
 			},
+			{
+				Config: testAccAMIConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAMIExists(ctx, resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "deprecation_time", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2AMI_deregistrationProtection(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ami ec2.Image
+	resourceName := "aws_ami.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAMIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMIConfig_deregistrationProtection(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAMIExists(ctx, resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "deregistration_protection", "true"),
+					resource.TestCheckResourceAttr(resourceName, "deregistration_protection_with_cooldown", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"manage_ebs_snapshots",
+				},
+			},
+			{
+				// Deregistration protection must be disabled before the AMI can be destroyed.
+				Config: testAccAMIConfig_deregistrationProtection(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAMIExists(ctx, resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "deregistration_protection", "false"),
+				),
+			},
 		},
 	})
 }
@@ -710,6 +757,25 @@ resource "aws_ami" "test" {
 `, rName, deprecateAt))
 }
 
+func testAccAMIConfig_deregistrationProtection(rName string, deregistrationProtection bool) string {
+	return acctest.ConfigCompose(
+		testAccAMIConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_ami" "test" {
+  ena_support               = true
+  name                      = %[1]q
+  root_device_name          = "/dev/sda1"
+  virtualization_type       = "hvm"
+  deregistration_protection = %[2]t
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = aws_ebs_snapshot.test.id
+  }
+}
+`, rName, deregistrationProtection))
+}
+
 func testAccAMIConfig_desc(rName, desc string) string {
 	return acctest.ConfigCompose(
 		testAccAMIConfig_base(rName),
@@ -0,0 +1,129 @@
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_ec2_byoip_cidr_advertisement")
+func ResourceBYOIPCIDRAdvertisement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBYOIPCIDRAdvertisementCreate,
+		ReadWithoutTimeout:   resourceBYOIPCIDRAdvertisementRead,
+		DeleteWithoutTimeout: resourceBYOIPCIDRAdvertisementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					verify.ValidIPv4CIDRNetworkAddress,
+					verify.ValidIPv6CIDRNetworkAddress,
+				),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBYOIPCIDRAdvertisementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	cidr := d.Get("cidr").(string)
+	input := &ec2.AdvertiseByoipCidrInput{
+		Cidr: aws.String(cidr),
+	}
+
+	_, err := conn.AdvertiseByoipCidrWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "advertising EC2 BYOIP CIDR (%s): %s", cidr, err)
+	}
+
+	d.SetId(cidr)
+
+	if _, err := WaitBYOIPCIDRAdvertised(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 BYOIP CIDR (%s) advertise: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBYOIPCIDRAdvertisementRead(ctx, d, meta)...)
+}
+
+func resourceBYOIPCIDRAdvertisementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	output, err := FindBYOIPCIDRByCIDR(ctx, conn, d.Id())
+
+	if err == nil && aws.StringValue(output.State) != ec2.ByoipCidrStateAdvertised {
+		err = &retry.NotFoundError{
+			Message: aws.StringValue(output.State),
+		}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 BYOIP CIDR Advertisement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 BYOIP CIDR Advertisement (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cidr", output.Cidr)
+	d.Set("description", output.Description)
+	d.Set("state", output.State)
+
+	return diags
+}
+
+func resourceBYOIPCIDRAdvertisementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	log.Printf("[INFO] Withdrawing EC2 BYOIP CIDR: %s", d.Id())
+	_, err := conn.WithdrawByoipCidrWithContext(ctx, &ec2.WithdrawByoipCidrInput{
+		Cidr: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "withdrawing EC2 BYOIP CIDR (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitBYOIPCIDRWithdrawn(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 BYOIP CIDR (%s) withdraw: %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,113 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// The CIDR must already be provisioned to the account, either directly or through an IPAM pool.
+func TestAccEC2BYOIPCIDRAdvertisement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "EC2_BYOIP_ADVERTISEMENT_CIDR"
+	cidr := os.Getenv(key)
+	if cidr == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+	resourceName := "aws_ec2_byoip_cidr_advertisement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBYOIPCIDRAdvertisementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBYOIPCIDRAdvertisementConfig_basic(cidr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBYOIPCIDRAdvertisementExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cidr", cidr),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.ByoipCidrStateAdvertised),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBYOIPCIDRAdvertisementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 BYOIP CIDR Advertisement ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		output, err := tfec2.FindBYOIPCIDRByCIDR(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if state := aws.StringValue(output.State); state != ec2.ByoipCidrStateAdvertised {
+			return fmt.Errorf("EC2 BYOIP CIDR (%s) is not advertised: %s", rs.Primary.ID, state)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBYOIPCIDRAdvertisementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_byoip_cidr_advertisement" {
+				continue
+			}
+
+			output, err := tfec2.FindBYOIPCIDRByCIDR(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if aws.StringValue(output.State) == ec2.ByoipCidrStateAdvertised {
+				return fmt.Errorf("EC2 BYOIP CIDR %s is still advertised", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccBYOIPCIDRAdvertisementConfig_basic(cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_byoip_cidr_advertisement" "test" {
+  cidr = %[1]q
+}
+`, cidr)
+}
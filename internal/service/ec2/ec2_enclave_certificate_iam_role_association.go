@@ -0,0 +1,158 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_ec2_enclave_certificate_iam_role_association")
+func ResourceEnclaveCertificateIAMRoleAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEnclaveCertificateIAMRoleAssociationCreate,
+		ReadWithoutTimeout:   resourceEnclaveCertificateIAMRoleAssociationRead,
+		DeleteWithoutTimeout: resourceEnclaveCertificateIAMRoleAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"certificate_s3_bucket_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_s3_object_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encryption_kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceEnclaveCertificateIAMRoleAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	certificateARN := d.Get("certificate_arn").(string)
+	roleARN := d.Get("role_arn").(string)
+	id := EnclaveCertificateIAMRoleAssociationCreateResourceID(certificateARN, roleARN)
+	input := &ec2.AssociateEnclaveCertificateIamRoleInput{
+		CertificateArn: aws.String(certificateARN),
+		RoleArn:        aws.String(roleARN),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Enclave Certificate IAM Role Association: %s", input)
+	_, err := conn.AssociateEnclaveCertificateIamRoleWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Enclave Certificate IAM Role Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceEnclaveCertificateIAMRoleAssociationRead(ctx, d, meta)...)
+}
+
+func resourceEnclaveCertificateIAMRoleAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	certificateARN, roleARN, err := EnclaveCertificateIAMRoleAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return FindEnclaveCertificateIAMRoleAssociation(ctx, conn, certificateARN, roleARN)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Enclave Certificate IAM Role Association %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Enclave Certificate IAM Role Association (%s): %s", d.Id(), err)
+	}
+
+	output := outputRaw.(*ec2.AssociatedRole)
+
+	d.Set("certificate_arn", certificateARN)
+	d.Set("certificate_s3_bucket_name", output.CertificateS3BucketName)
+	d.Set("certificate_s3_object_key", output.CertificateS3ObjectKey)
+	d.Set("encryption_kms_key_id", output.EncryptionKmsKeyId)
+	d.Set("role_arn", output.AssociatedRoleArn)
+
+	return diags
+}
+
+func resourceEnclaveCertificateIAMRoleAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	certificateARN, roleARN, err := EnclaveCertificateIAMRoleAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Enclave Certificate IAM Role Association: %s", d.Id())
+	_, err = conn.DisassociateEnclaveCertificateIamRoleWithContext(ctx, &ec2.DisassociateEnclaveCertificateIamRoleInput{
+		CertificateArn: aws.String(certificateARN),
+		RoleArn:        aws.String(roleARN),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Enclave Certificate IAM Role Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const enclaveCertificateIAMRoleAssociationResourceIDSeparator = ","
+
+func EnclaveCertificateIAMRoleAssociationCreateResourceID(certificateARN, roleARN string) string {
+	parts := []string{certificateARN, roleARN}
+	id := strings.Join(parts, enclaveCertificateIAMRoleAssociationResourceIDSeparator)
+
+	return id
+}
+
+func EnclaveCertificateIAMRoleAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, enclaveCertificateIAMRoleAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CertificateARN%[2]sRoleARN", id, enclaveCertificateIAMRoleAssociationResourceIDSeparator)
+}
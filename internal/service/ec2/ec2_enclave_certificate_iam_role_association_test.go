@@ -0,0 +1,168 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2EnclaveCertificateIAMRoleAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_enclave_certificate_iam_role_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnclaveCertificateIAMRoleAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnclaveCertificateIAMRoleAssociationConfig_basic(rName, certificate, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnclaveCertificateIAMRoleAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_arn", "aws_acm_certificate.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_s3_bucket_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_s3_object_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "encryption_kms_key_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2EnclaveCertificateIAMRoleAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_enclave_certificate_iam_role_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnclaveCertificateIAMRoleAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnclaveCertificateIAMRoleAssociationConfig_basic(rName, certificate, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnclaveCertificateIAMRoleAssociationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceEnclaveCertificateIAMRoleAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEnclaveCertificateIAMRoleAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_enclave_certificate_iam_role_association" {
+				continue
+			}
+
+			certificateARN, roleARN, err := tfec2.EnclaveCertificateIAMRoleAssociationParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfec2.FindEnclaveCertificateIAMRoleAssociation(ctx, conn, certificateARN, roleARN)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Enclave Certificate IAM Role Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnclaveCertificateIAMRoleAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Enclave Certificate IAM Role Association ID is set")
+		}
+
+		certificateARN, roleARN, err := tfec2.EnclaveCertificateIAMRoleAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		_, err = tfec2.FindEnclaveCertificateIAMRoleAssociation(ctx, conn, certificateARN, roleARN)
+
+		return err
+	}
+}
+
+func testAccEnclaveCertificateIAMRoleAssociationConfig_base(rName, certificate, key string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  certificate_body = "%[2]s"
+  private_key      = "%[3]s"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.amazonaws.com"
+      }
+    }]
+  })
+}
+`, rName, acctest.TLSPEMEscapeNewlines(certificate), acctest.TLSPEMEscapeNewlines(key))
+}
+
+func testAccEnclaveCertificateIAMRoleAssociationConfig_basic(rName, certificate, key string) string {
+	return acctest.ConfigCompose(testAccEnclaveCertificateIAMRoleAssociationConfig_base(rName, certificate, key), `
+resource "aws_ec2_enclave_certificate_iam_role_association" "test" {
+  certificate_arn = aws_acm_certificate.test.arn
+  role_arn        = aws_iam_role.test.arn
+}
+`)
+}
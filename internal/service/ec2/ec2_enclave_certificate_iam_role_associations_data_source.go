@@ -0,0 +1,94 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_ec2_enclave_certificate_iam_role_associations")
+func DataSourceEnclaveCertificateIAMRoleAssociations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEnclaveCertificateIAMRoleAssociationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"associated_roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_s3_bucket_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_s3_object_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"encryption_kms_key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"certificate_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceEnclaveCertificateIAMRoleAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	certificateARN := d.Get("certificate_arn").(string)
+	output, err := FindEnclaveCertificateIAMRoleAssociations(ctx, conn, certificateARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Enclave Certificate (%s) IAM Role Associations: %s", certificateARN, err)
+	}
+
+	d.SetId(certificateARN)
+	if err := d.Set("associated_roles", flattenAssociatedRoles(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting associated_roles: %s", err)
+	}
+
+	return diags
+}
+
+func flattenAssociatedRoles(apiObjects []*ec2.AssociatedRole) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"certificate_s3_bucket_name": aws.StringValue(apiObject.CertificateS3BucketName),
+			"certificate_s3_object_key":  aws.StringValue(apiObject.CertificateS3ObjectKey),
+			"encryption_kms_key_id":      aws.StringValue(apiObject.EncryptionKmsKeyId),
+			"role_arn":                   aws.StringValue(apiObject.AssociatedRoleArn),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,46 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2EnclaveCertificateIAMRoleAssociationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_enclave_certificate_iam_role_associations.test"
+	resourceName := "aws_ec2_enclave_certificate_iam_role_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnclaveCertificateIAMRoleAssociationsDataSourceConfig_basic(rName, certificate, key),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "certificate_arn", resourceName, "certificate_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "associated_roles.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "associated_roles.0.certificate_s3_bucket_name", resourceName, "certificate_s3_bucket_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "associated_roles.0.certificate_s3_object_key", resourceName, "certificate_s3_object_key"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "associated_roles.0.encryption_kms_key_id", resourceName, "encryption_kms_key_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "associated_roles.0.role_arn", resourceName, "role_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnclaveCertificateIAMRoleAssociationsDataSourceConfig_basic(rName, certificate, key string) string {
+	return acctest.ConfigCompose(testAccEnclaveCertificateIAMRoleAssociationConfig_basic(rName, certificate, key), `
+data "aws_ec2_enclave_certificate_iam_role_associations" "test" {
+  certificate_arn = aws_ec2_enclave_certificate_iam_role_association.test.certificate_arn
+}
+`)
+}
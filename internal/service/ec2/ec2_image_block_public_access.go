@@ -0,0 +1,80 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// @SDKResource("aws_ec2_image_block_public_access")
+func ResourceImageBlockPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceImageBlockPublicAccessPut,
+		ReadWithoutTimeout:   resourceImageBlockPublicAccessRead,
+		UpdateWithoutTimeout: resourceImageBlockPublicAccessPut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"state": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing,
+					ec2.ImageBlockPublicAccessDisabledStateUnblocked,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceImageBlockPublicAccessPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	state := d.Get("state").(string)
+	var err error
+
+	if state == ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing {
+		_, err = conn.EnableImageBlockPublicAccessWithContext(ctx, &ec2.EnableImageBlockPublicAccessInput{
+			ImageBlockPublicAccessState: aws.String(state),
+		})
+	} else {
+		_, err = conn.DisableImageBlockPublicAccessWithContext(ctx, &ec2.DisableImageBlockPublicAccessInput{})
+	}
+
+	if err != nil {
+		return diag.Errorf("setting EC2 Image Block Public Access (%s): %s", state, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	if _, err := waitImageBlockPublicAccessState(ctx, conn, state); err != nil {
+		return diag.Errorf("waiting for EC2 Image Block Public Access (%s) update: %s", state, err)
+	}
+
+	return resourceImageBlockPublicAccessRead(ctx, d, meta)
+}
+
+func resourceImageBlockPublicAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	output, err := FindImageBlockPublicAccessState(ctx, conn)
+
+	if err != nil {
+		return diag.Errorf("reading EC2 Image Block Public Access: %s", err)
+	}
+
+	d.Set("state", output)
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccEC2ImageBlockPublicAccess_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		"basic": testAccImageBlockPublicAccess_basic,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccImageBlockPublicAccess_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_image_block_public_access.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageBlockPublicAccessConfig_basic(ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageBlockPublicAccessState(ctx, ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccImageBlockPublicAccessConfig_basic(ec2.ImageBlockPublicAccessDisabledStateUnblocked),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageBlockPublicAccessState(ctx, ec2.ImageBlockPublicAccessDisabledStateUnblocked),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.ImageBlockPublicAccessDisabledStateUnblocked),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImageBlockPublicAccessState(ctx context.Context, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		output, err := tfec2.FindImageBlockPublicAccessState(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if output != expected {
+			return fmt.Errorf("EC2 Image Block Public Access state is %s, expected %s", output, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccImageBlockPublicAccessConfig_basic(state string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_image_block_public_access" "test" {
+  state = %[1]q
+}
+`, state)
+}
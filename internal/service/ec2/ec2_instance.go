@@ -587,6 +587,36 @@ func ResourceInstance() *schema.Resource {
 							Required: true,
 							ForceNew: true,
 						},
+						"ena_srd_specification": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ena_srd_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"ena_srd_udp_specification": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"ena_srd_udp_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"network_card_index": {
 							Type:     schema.TypeInt,
 							Optional: true,
@@ -1191,6 +1221,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 			for _, index := range configuredDeviceIndexes {
 				if index == int(aws.Int64Value(iNi.Attachment.DeviceIndex)) {
 					ni["device_index"] = aws.Int64Value(iNi.Attachment.DeviceIndex)
+					ni["ena_srd_specification"] = flattenInstanceAttachmentEnaSrdSpecification(iNi.Attachment.EnaSrdSpecification)
 					ni["network_card_index"] = aws.Int64Value(iNi.Attachment.NetworkCardIndex)
 					ni["network_interface_id"] = aws.StringValue(iNi.NetworkInterfaceId)
 					ni["delete_on_termination"] = aws.BoolValue(iNi.Attachment.DeleteOnTermination)
@@ -2385,6 +2416,11 @@ func buildNetworkInterfaceOpts(d *schema.ResourceData, groups []*string, nInterf
 				NetworkInterfaceId:  aws.String(ini["network_interface_id"].(string)),
 				DeleteOnTermination: aws.Bool(ini["delete_on_termination"].(bool)),
 			}
+
+			if v, ok := ini["ena_srd_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				ni.EnaSrdSpecification = expandEnaSrdSpecificationRequest(v[0].(map[string]interface{}))
+			}
+
 			networkInterfaces = append(networkInterfaces, ni)
 		}
 	}
@@ -2392,6 +2428,49 @@ func buildNetworkInterfaceOpts(d *schema.ResourceData, groups []*string, nInterf
 	return networkInterfaces
 }
 
+func expandEnaSrdSpecificationRequest(tfMap map[string]interface{}) *ec2.EnaSrdSpecificationRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.EnaSrdSpecificationRequest{}
+
+	if v, ok := tfMap["ena_srd_enabled"].(bool); ok {
+		apiObject.EnaSrdEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["ena_srd_udp_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.EnaSrdUdpSpecification = &ec2.EnaSrdUdpSpecificationRequest{}
+
+		if v, ok := tfMap["ena_srd_udp_enabled"].(bool); ok {
+			apiObject.EnaSrdUdpSpecification.EnaSrdUdpEnabled = aws.Bool(v)
+		}
+	}
+
+	return apiObject
+}
+
+// flattenInstanceAttachmentEnaSrdSpecification returns no configuration block
+// unless ENA Express is enabled, as the network_interface set is keyed on it.
+func flattenInstanceAttachmentEnaSrdSpecification(apiObject *ec2.InstanceAttachmentEnaSrdSpecification) []interface{} {
+	if apiObject == nil || !aws.BoolValue(apiObject.EnaSrdEnabled) {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"ena_srd_enabled": true,
+	}
+
+	if v := apiObject.EnaSrdUdpSpecification; v != nil && aws.BoolValue(v.EnaSrdUdpEnabled) {
+		tfMap["ena_srd_udp_specification"] = []interface{}{map[string]interface{}{
+			"ena_srd_udp_enabled": true,
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
 func readBlockDeviceMappingsFromConfig(ctx context.Context, d *schema.ResourceData, conn *ec2.EC2) ([]*ec2.BlockDeviceMapping, error) {
 	blockDevices := make([]*ec2.BlockDeviceMapping, 0)
 
@@ -2783,6 +2783,43 @@ func TestAccEC2Instance_networkCardIndex(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_NetworkInterface_enaSrdSpecification(t *testing.T) {
+	ctx := acctest.Context(t)
+	var instance ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ena-express.html#ena-express-supported-instance-types.
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_networkInterfaceEnaSrdSpecification(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "network_interface.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "network_interface.*", map[string]string{
+						"device_index":                                        "0",
+						"ena_srd_specification.#":                             "1",
+						"ena_srd_specification.0.ena_srd_enabled":             "true",
+						"ena_srd_specification.0.ena_srd_udp_specification.#": "1",
+						"ena_srd_specification.0.ena_srd_udp_specification.0.ena_srd_udp_enabled": "true",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"network_interface", "user_data_replace_on_change"},
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_primaryNetworkInterfaceSourceDestCheck(t *testing.T) {
 	ctx := acctest.Context(t)
 	var instance ec2.Instance
@@ -7572,6 +7609,45 @@ resource "aws_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_networkInterfaceEnaSrdSpecification(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		acctest.AvailableEC2InstanceTypeForAvailabilityZone("data.aws_availability_zones.available.names[0]", "c6in.32xlarge", "m6i.32xlarge"),
+		fmt.Sprintf(`
+resource "aws_network_interface" "test" {
+  subnet_id   = aws_subnet.test.id
+  private_ips = ["10.1.1.42"]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  network_interface {
+    network_interface_id = aws_network_interface.test.id
+    device_index         = 0
+
+    ena_srd_specification {
+      ena_srd_enabled = true
+
+      ena_srd_udp_specification {
+        ena_srd_udp_enabled = true
+      }
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccInstanceConfig_primaryNetworkInterfaceSourceDestCheck(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
@@ -0,0 +1,110 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+)
+
+// @SDKDataSource("aws_ec2_network_performance_metric_subscriptions")
+func DataSourceNetworkPerformanceMetricSubscriptions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceNetworkPerformanceMetricSubscriptionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"destination": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"metric": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.MetricType](),
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subscriptions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metric": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"period": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"statistic": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkPerformanceMetricSubscriptionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Client()
+
+	output, err := FindNetworkPerformanceMetricSubscriptions(ctx, conn, &ec2.DescribeAwsNetworkPerformanceMetricSubscriptionsInput{})
+
+	if err != nil {
+		return diag.Errorf("reading EC2 AWS Network Performance Metric Subscriptions: %s", err)
+	}
+
+	source, destination, metric := d.Get("source").(string), d.Get("destination").(string), d.Get("metric").(string)
+	var subscriptions []interface{}
+
+	for _, v := range output {
+		if source != "" && aws.ToString(v.Source) != source {
+			continue
+		}
+
+		if destination != "" && aws.ToString(v.Destination) != destination {
+			continue
+		}
+
+		if metric != "" && string(v.Metric) != metric {
+			continue
+		}
+
+		subscriptions = append(subscriptions, flattenNetworkPerformanceMetricSubscription(v))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("subscriptions", subscriptions); err != nil {
+		return diag.Errorf("setting subscriptions: %s", err)
+	}
+
+	return nil
+}
+
+func flattenNetworkPerformanceMetricSubscription(apiObject types.Subscription) map[string]interface{} {
+	return map[string]interface{}{
+		"destination": aws.ToString(apiObject.Destination),
+		"metric":      string(apiObject.Metric),
+		"period":      string(apiObject.Period),
+		"source":      aws.ToString(apiObject.Source),
+		"statistic":   string(apiObject.Statistic),
+	}
+}
@@ -0,0 +1,50 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccNetworkPerformanceMetricSubscriptionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_network_performance_metric_subscriptions.test"
+	resourceName := "aws_vpc_network_performance_metric_subscription.test"
+	src := acctest.AlternateRegion()
+	dst := acctest.Region()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkPerformanceMetricSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkPerformanceMetricSubscriptionsDataSourceConfig_basic(src, dst),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "subscriptions.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subscriptions.0.destination", resourceName, "destination"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subscriptions.0.metric", resourceName, "metric"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subscriptions.0.period", resourceName, "period"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subscriptions.0.source", resourceName, "source"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subscriptions.0.statistic", resourceName, "statistic"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkPerformanceMetricSubscriptionsDataSourceConfig_basic(src, dst string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkPerformanceMetricSubscription_basic(src, dst), fmt.Sprintf(`
+data "aws_ec2_network_performance_metric_subscriptions" "test" {
+  source      = %[1]q
+  destination = %[2]q
+  metric      = "aggregate-latency"
+
+  depends_on = [aws_vpc_network_performance_metric_subscription.test]
+}
+`, src, dst))
+}
@@ -0,0 +1,131 @@
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_ec2_spot_placement_score")
+func DataSourceSpotPlacementScore() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSpotPlacementScoreRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"region_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"single_availability_zone": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"spot_placement_scores": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"score": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"target_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 2000000000),
+			},
+			"target_capacity_unit_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2.TargetCapacityUnitType_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceSpotPlacementScoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	input := &ec2.GetSpotPlacementScoresInput{
+		InstanceTypes:  flex.ExpandStringSet(d.Get("instance_types").(*schema.Set)),
+		TargetCapacity: aws.Int64(int64(d.Get("target_capacity").(int))),
+	}
+
+	if v, ok := d.GetOk("region_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.RegionNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("single_availability_zone"); ok {
+		input.SingleAvailabilityZone = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("target_capacity_unit_type"); ok {
+		input.TargetCapacityUnitType = aws.String(v.(string))
+	}
+
+	output, err := FindSpotPlacementScores(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Spot Placement Scores: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("spot_placement_scores", flattenSpotPlacementScores(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting spot_placement_scores: %s", err)
+	}
+
+	return diags
+}
+
+func flattenSpotPlacementScores(apiObjects []*ec2.SpotPlacementScore) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"availability_zone_id": aws.StringValue(apiObject.AvailabilityZoneId),
+			"region":               aws.StringValue(apiObject.Region),
+			"score":                aws.Int64Value(apiObject.Score),
+		})
+	}
+
+	return tfList
+}
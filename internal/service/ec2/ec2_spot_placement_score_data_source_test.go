@@ -0,0 +1,81 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2SpotPlacementScoreDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_spot_placement_score.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotPlacementScoreDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "spot_placement_scores.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "spot_placement_scores.0.availability_zone_id", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "spot_placement_scores.0.region", acctest.Region()),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.score"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotPlacementScoreDataSource_singleAvailabilityZone(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_spot_placement_score.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotPlacementScoreDataSourceConfig_singleAvailabilityZone(),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "spot_placement_scores.#", 0),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.availability_zone_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "spot_placement_scores.0.region", acctest.Region()),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.score"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpotPlacementScoreDataSourceConfig_basic() string {
+	return `
+data "aws_region" "current" {}
+
+data "aws_ec2_spot_placement_score" "test" {
+  instance_types  = ["t3.micro", "t3a.micro"]
+  region_names    = [data.aws_region.current.name]
+  target_capacity = 1
+}
+`
+}
+
+func testAccSpotPlacementScoreDataSourceConfig_singleAvailabilityZone() string {
+	return `
+data "aws_region" "current" {}
+
+data "aws_ec2_spot_placement_score" "test" {
+  instance_types            = ["t3.micro", "t3a.micro"]
+  region_names              = [data.aws_region.current.name]
+  single_availability_zone  = true
+  target_capacity           = 2
+  target_capacity_unit_type = "units"
+}
+`
+}
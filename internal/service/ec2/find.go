@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -1237,6 +1238,30 @@ func FindInstanceTypeOfferings(ctx context.Context, conn *ec2.EC2, input *ec2.De
 	return output, nil
 }
 
+func FindSpotPlacementScores(ctx context.Context, conn *ec2.EC2, input *ec2.GetSpotPlacementScoresInput) ([]*ec2.SpotPlacementScore, error) {
+	var output []*ec2.SpotPlacementScore
+
+	err := conn.GetSpotPlacementScoresPagesWithContext(ctx, input, func(page *ec2.GetSpotPlacementScoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SpotPlacementScores {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindPublicIPv4Pool(ctx context.Context, conn *ec2.EC2, input *ec2.DescribePublicIpv4PoolsInput) (*ec2.PublicIpv4Pool, error) {
 	output, err := FindPublicIPv4Pools(ctx, conn, input)
 
@@ -6931,3 +6956,587 @@ func FindInstanceStateByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.
 
 	return instanceState, nil
 }
+
+func FindVerifiedAccessInstance(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessInstancesInput) (*ec2.VerifiedAccessInstance, error) {
+	output, err := FindVerifiedAccessInstances(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindVerifiedAccessInstances(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessInstancesInput) ([]*ec2.VerifiedAccessInstance, error) {
+	var output []*ec2.VerifiedAccessInstance
+
+	err := conn.DescribeVerifiedAccessInstancesPagesWithContext(ctx, input, func(page *ec2.DescribeVerifiedAccessInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VerifiedAccessInstances {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessInstanceIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessInstanceByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.VerifiedAccessInstance, error) {
+	input := &ec2.DescribeVerifiedAccessInstancesInput{
+		VerifiedAccessInstanceIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindVerifiedAccessInstance(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.VerifiedAccessInstanceId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessInstanceLoggingConfiguration(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessInstanceLoggingConfigurationsInput) (*ec2.VerifiedAccessInstanceLoggingConfiguration, error) {
+	output, err := FindVerifiedAccessInstanceLoggingConfigurations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindVerifiedAccessInstanceLoggingConfigurations(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessInstanceLoggingConfigurationsInput) ([]*ec2.VerifiedAccessInstanceLoggingConfiguration, error) {
+	var output []*ec2.VerifiedAccessInstanceLoggingConfiguration
+
+	err := conn.DescribeVerifiedAccessInstanceLoggingConfigurationsPagesWithContext(ctx, input, func(page *ec2.DescribeVerifiedAccessInstanceLoggingConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LoggingConfigurations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessInstanceIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessInstanceLoggingConfigurationByInstanceID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.VerifiedAccessInstanceLoggingConfiguration, error) {
+	input := &ec2.DescribeVerifiedAccessInstanceLoggingConfigurationsInput{
+		VerifiedAccessInstanceIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindVerifiedAccessInstanceLoggingConfiguration(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.AccessLogs == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.VerifiedAccessInstanceId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessInstanceTrustProviderAttachmentExists(ctx context.Context, conn *ec2.EC2, vaiID, vatpID string) error {
+	output, err := FindVerifiedAccessInstanceByID(ctx, conn, vaiID)
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range output.VerifiedAccessTrustProviders {
+		if aws.StringValue(v.VerifiedAccessTrustProviderId) == vatpID {
+			return nil
+		}
+	}
+
+	return &retry.NotFoundError{
+		LastError: fmt.Errorf("Verified Access Instance (%s) Trust Provider (%s) Attachment not found", vaiID, vatpID),
+	}
+}
+
+func FindVerifiedAccessTrustProvider(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessTrustProvidersInput) (*ec2.VerifiedAccessTrustProvider, error) {
+	output, err := FindVerifiedAccessTrustProviders(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindVerifiedAccessTrustProviders(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessTrustProvidersInput) ([]*ec2.VerifiedAccessTrustProvider, error) {
+	var output []*ec2.VerifiedAccessTrustProvider
+
+	err := conn.DescribeVerifiedAccessTrustProvidersPagesWithContext(ctx, input, func(page *ec2.DescribeVerifiedAccessTrustProvidersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VerifiedAccessTrustProviders {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessTrustProviderIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessTrustProviderByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.VerifiedAccessTrustProvider, error) {
+	input := &ec2.DescribeVerifiedAccessTrustProvidersInput{
+		VerifiedAccessTrustProviderIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindVerifiedAccessTrustProvider(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.VerifiedAccessTrustProviderId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessGroup(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessGroupsInput) (*ec2.VerifiedAccessGroup, error) {
+	output, err := FindVerifiedAccessGroups(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindVerifiedAccessGroups(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessGroupsInput) ([]*ec2.VerifiedAccessGroup, error) {
+	var output []*ec2.VerifiedAccessGroup
+
+	err := conn.DescribeVerifiedAccessGroupsPagesWithContext(ctx, input, func(page *ec2.DescribeVerifiedAccessGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VerifiedAccessGroups {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessGroupIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessGroupByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.VerifiedAccessGroup, error) {
+	input := &ec2.DescribeVerifiedAccessGroupsInput{
+		VerifiedAccessGroupIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindVerifiedAccessGroup(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.StringValue(output.DeletionTime) != "" {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.VerifiedAccessGroupId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessGroupPolicyByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.GetVerifiedAccessGroupPolicyOutput, error) {
+	input := &ec2.GetVerifiedAccessGroupPolicyInput{
+		VerifiedAccessGroupId: aws.String(id),
+	}
+
+	output, err := conn.GetVerifiedAccessGroupPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessGroupIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessEndpoint(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessEndpointsInput) (*ec2.VerifiedAccessEndpoint, error) {
+	output, err := FindVerifiedAccessEndpoints(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindVerifiedAccessEndpoints(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVerifiedAccessEndpointsInput) ([]*ec2.VerifiedAccessEndpoint, error) {
+	var output []*ec2.VerifiedAccessEndpoint
+
+	err := conn.DescribeVerifiedAccessEndpointsPagesWithContext(ctx, input, func(page *ec2.DescribeVerifiedAccessEndpointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VerifiedAccessEndpoints {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessEndpointIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessEndpointByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.VerifiedAccessEndpoint, error) {
+	input := &ec2.DescribeVerifiedAccessEndpointsInput{
+		VerifiedAccessEndpointIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindVerifiedAccessEndpoint(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Status != nil {
+		if status := aws.StringValue(output.Status.Code); status == ec2.VerifiedAccessEndpointStatusCodeDeleted {
+			return nil, &retry.NotFoundError{
+				Message:     status,
+				LastRequest: input,
+			}
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.VerifiedAccessEndpointId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessEndpointPolicyByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.GetVerifiedAccessEndpointPolicyOutput, error) {
+	input := &ec2.GetVerifiedAccessEndpointPolicyInput{
+		VerifiedAccessEndpointId: aws.String(id),
+	}
+
+	output, err := conn.GetVerifiedAccessEndpointPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessEndpointIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindBYOIPCIDRs(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeByoipCidrsInput) ([]*ec2.ByoipCidr, error) {
+	var output []*ec2.ByoipCidr
+
+	err := conn.DescribeByoipCidrsPagesWithContext(ctx, input, func(page *ec2.DescribeByoipCidrsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ByoipCidrs {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindBYOIPCIDRByCIDR(ctx context.Context, conn *ec2.EC2, cidr string) (*ec2.ByoipCidr, error) {
+	input := &ec2.DescribeByoipCidrsInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	output, err := FindBYOIPCIDRs(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output {
+		if aws.StringValue(v.Cidr) != cidr {
+			continue
+		}
+
+		if state := aws.StringValue(v.State); state == ec2.ByoipCidrStateDeprovisioned {
+			return nil, &retry.NotFoundError{
+				Message:     state,
+				LastRequest: input,
+			}
+		}
+
+		return v, nil
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+func FindEnclaveCertificateIAMRoleAssociations(ctx context.Context, conn *ec2.EC2, certificateARN string) ([]*ec2.AssociatedRole, error) {
+	input := &ec2.GetAssociatedEnclaveCertificateIamRolesInput{
+		CertificateArn: aws.String(certificateARN),
+	}
+
+	output, err := conn.GetAssociatedEnclaveCertificateIamRolesWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AssociatedRoles, nil
+}
+
+func FindEnclaveCertificateIAMRoleAssociation(ctx context.Context, conn *ec2.EC2, certificateARN, roleARN string) (*ec2.AssociatedRole, error) {
+	output, err := FindEnclaveCertificateIAMRoleAssociations(ctx, conn, certificateARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output {
+		if aws.StringValue(v.AssociatedRoleArn) == roleARN {
+			return v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastError: fmt.Errorf("EC2 Enclave Certificate (%s) IAM Role (%s) Association not found", certificateARN, roleARN),
+	}
+}
+
+func FindImageBlockPublicAccessState(ctx context.Context, conn *ec2.EC2) (string, error) {
+	input := &ec2.GetImageBlockPublicAccessStateInput{}
+
+	output, err := conn.GetImageBlockPublicAccessStateWithContext(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.ImageBlockPublicAccessState == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.ImageBlockPublicAccessState), nil
+}
+
+func FindIPAMDiscoveredPublicAddresses(ctx context.Context, conn *ec2.EC2, input *ec2.GetIpamDiscoveredPublicAddressesInput) ([]*ec2.IpamDiscoveredPublicAddress, *time.Time, error) {
+	var output []*ec2.IpamDiscoveredPublicAddress
+	var oldestSampleTime *time.Time
+
+	for {
+		page, err := conn.GetIpamDiscoveredPublicAddressesWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidIPAMResourceDiscoveryIdNotFound) {
+			return nil, nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		for _, v := range page.IpamDiscoveredPublicAddresses {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if page.OldestSampleTime != nil {
+			oldestSampleTime = page.OldestSampleTime
+		}
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, oldestSampleTime, nil
+}
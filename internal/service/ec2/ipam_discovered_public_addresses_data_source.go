@@ -0,0 +1,211 @@
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_vpc_ipam_discovered_public_addresses")
+func DataSourceIPAMDiscoveredPublicAddresses() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceIPAMDiscoveredPublicAddressesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_region": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address_allocation_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address_owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"association_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_border_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_interface_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_ipv4_pool_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sample_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_groups": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"group_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"filter": DataSourceFiltersSchema(),
+			"ipam_resource_discovery_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"oldest_sample_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceIPAMDiscoveredPublicAddressesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	discoveryID := d.Get("ipam_resource_discovery_id").(string)
+	addressRegion := d.Get("address_region").(string)
+	input := &ec2.GetIpamDiscoveredPublicAddressesInput{
+		AddressRegion:           aws.String(addressRegion),
+		IpamResourceDiscoveryId: aws.String(discoveryID),
+	}
+
+	input.Filters = append(input.Filters, BuildFiltersDataSource(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	output, oldestSampleTime, err := FindIPAMDiscoveredPublicAddresses(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IPAM Discovered Public Addresses: %s", err)
+	}
+
+	d.SetId(discoveryID + "," + addressRegion)
+	if err := d.Set("addresses", flattenIPAMDiscoveredPublicAddresses(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting addresses: %s", err)
+	}
+	if oldestSampleTime != nil {
+		d.Set("oldest_sample_time", aws.TimeValue(oldestSampleTime).Format(time.RFC3339))
+	} else {
+		d.Set("oldest_sample_time", nil)
+	}
+
+	return diags
+}
+
+func flattenIPAMDiscoveredPublicAddresses(apiObjects []*ec2.IpamDiscoveredPublicAddress) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenIPAMDiscoveredPublicAddress(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenIPAMDiscoveredPublicAddress(apiObject *ec2.IpamDiscoveredPublicAddress) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"address":                       aws.StringValue(apiObject.Address),
+		"address_allocation_id":         aws.StringValue(apiObject.AddressAllocationId),
+		"address_owner_id":              aws.StringValue(apiObject.AddressOwnerId),
+		"address_region":                aws.StringValue(apiObject.AddressRegion),
+		"address_type":                  aws.StringValue(apiObject.AddressType),
+		"association_status":            aws.StringValue(apiObject.AssociationStatus),
+		"instance_id":                   aws.StringValue(apiObject.InstanceId),
+		"network_border_group":          aws.StringValue(apiObject.NetworkBorderGroup),
+		"network_interface_description": aws.StringValue(apiObject.NetworkInterfaceDescription),
+		"network_interface_id":          aws.StringValue(apiObject.NetworkInterfaceId),
+		"public_ipv4_pool_id":           aws.StringValue(apiObject.PublicIpv4PoolId),
+		"service":                       aws.StringValue(apiObject.Service),
+		"service_resource":              aws.StringValue(apiObject.ServiceResource),
+		"subnet_id":                     aws.StringValue(apiObject.SubnetId),
+		"vpc_id":                        aws.StringValue(apiObject.VpcId),
+	}
+
+	if v := apiObject.SampleTime; v != nil {
+		tfMap["sample_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	securityGroups := []interface{}{}
+	for _, v := range apiObject.SecurityGroups {
+		securityGroups = append(securityGroups, map[string]interface{}{
+			"group_id":   aws.StringValue(v.GroupId),
+			"group_name": aws.StringValue(v.GroupName),
+		})
+	}
+	tfMap["security_groups"] = securityGroups
+
+	return tfMap
+}
@@ -0,0 +1,38 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIPAMDiscoveredPublicAddressesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_vpc_ipam_discovered_public_addresses.test"
+	ipamName := "aws_vpc_ipam.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMDiscoveredPublicAddressesDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "address_region", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "addresses.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ipam_resource_discovery_id", ipamName, "default_resource_discovery_id"),
+				),
+			},
+		},
+	})
+}
+
+var testAccIPAMDiscoveredPublicAddressesDataSourceConfig_basic = acctest.ConfigCompose(testAccIPAMConfig_basic, `
+data "aws_vpc_ipam_discovered_public_addresses" "test" {
+  address_region             = data.aws_region.current.name
+  ipam_resource_discovery_id = aws_vpc_ipam.test.default_resource_discovery_id
+}
+`)
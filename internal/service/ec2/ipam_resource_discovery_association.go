@@ -53,6 +53,7 @@ func ResourceIPAMResourceDiscoveryAssociation() *schema.Resource {
 			"ipam_id": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"ipam_region": {
 				Type:     schema.TypeString,
@@ -61,6 +62,7 @@ func ResourceIPAMResourceDiscoveryAssociation() *schema.Resource {
 			"ipam_resource_discovery_id": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"is_default": {
 				Type:     schema.TypeBool,
@@ -135,7 +137,7 @@ func resourceIPAMResourceDiscoveryAssociationRead(ctx context.Context, d *schema
 
 	SetTagsOut(ctx, rda.Tags)
 
-	return nil
+	return diags
 }
 
 func resourceIPAMResourceDiscoveryAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -99,6 +99,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceCoIPPools,
 			TypeName: "aws_ec2_coip_pools",
 		},
+		{
+			Factory:  DataSourceEnclaveCertificateIAMRoleAssociations,
+			TypeName: "aws_ec2_enclave_certificate_iam_role_associations",
+		},
 		{
 			Factory:  DataSourceHost,
 			TypeName: "aws_ec2_host",
@@ -163,6 +167,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceNetworkInsightsPath,
 			TypeName: "aws_ec2_network_insights_path",
 		},
+		{
+			Factory:  DataSourceNetworkPerformanceMetricSubscriptions,
+			TypeName: "aws_ec2_network_performance_metric_subscriptions",
+		},
 		{
 			Factory:  DataSourcePublicIPv4Pool,
 			TypeName: "aws_ec2_public_ipv4_pool",
@@ -175,6 +183,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceSerialConsoleAccess,
 			TypeName: "aws_ec2_serial_console_access",
 		},
+		{
+			Factory:  DataSourceSpotPlacementScore,
+			TypeName: "aws_ec2_spot_placement_score",
+		},
 		{
 			Factory:  DataSourceSpotPrice,
 			TypeName: "aws_ec2_spot_price",
@@ -335,6 +347,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceVPCEndpointService,
 			TypeName: "aws_vpc_endpoint_service",
 		},
+		{
+			Factory:  DataSourceIPAMDiscoveredPublicAddresses,
+			TypeName: "aws_vpc_ipam_discovered_public_addresses",
+		},
 		{
 			Factory:  DataSourceIPAMPool,
 			TypeName: "aws_vpc_ipam_pool",
@@ -500,6 +516,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceAvailabilityZoneGroup,
 			TypeName: "aws_ec2_availability_zone_group",
 		},
+		{
+			Factory:  ResourceBYOIPCIDRAdvertisement,
+			TypeName: "aws_ec2_byoip_cidr_advertisement",
+		},
 		{
 			Factory:  ResourceCapacityReservation,
 			TypeName: "aws_ec2_capacity_reservation",
@@ -536,6 +556,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceClientVPNRoute,
 			TypeName: "aws_ec2_client_vpn_route",
 		},
+		{
+			Factory:  ResourceEnclaveCertificateIAMRoleAssociation,
+			TypeName: "aws_ec2_enclave_certificate_iam_role_association",
+		},
 		{
 			Factory:  ResourceFleet,
 			TypeName: "aws_ec2_fleet",
@@ -552,6 +576,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceImageBlockPublicAccess,
+			TypeName: "aws_ec2_image_block_public_access",
+		},
 		{
 			Factory:  ResourceInstanceState,
 			TypeName: "aws_ec2_instance_state",
@@ -924,6 +952,46 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceVerifiedAccessEndpoint,
+			TypeName: "aws_verifiedaccess_endpoint",
+			Name:     "Verified Access Endpoint",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceVerifiedAccessGroup,
+			TypeName: "aws_verifiedaccess_group",
+			Name:     "Verified Access Group",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceVerifiedAccessInstance,
+			TypeName: "aws_verifiedaccess_instance",
+			Name:     "Verified Access Instance",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceVerifiedAccessInstanceLoggingConfiguration,
+			TypeName: "aws_verifiedaccess_instance_logging_configuration",
+		},
+		{
+			Factory:  ResourceVerifiedAccessInstanceTrustProviderAttachment,
+			TypeName: "aws_verifiedaccess_instance_trust_provider_attachment",
+		},
+		{
+			Factory:  ResourceVerifiedAccessTrustProvider,
+			TypeName: "aws_verifiedaccess_trust_provider",
+			Name:     "Verified Access Trust Provider",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceVolumeAttachment,
 			TypeName: "aws_volume_attachment",
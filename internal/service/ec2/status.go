@@ -1446,3 +1446,55 @@ func StatusIPAMScopeState(ctx context.Context, conn *ec2.EC2, id string) retry.S
 		return output, aws.StringValue(output.State), nil
 	}
 }
+
+func StatusVerifiedAccessEndpoint(ctx context.Context, conn *ec2.EC2, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVerifiedAccessEndpointByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.Status.Code), nil
+	}
+}
+
+func StatusBYOIPCIDR(ctx context.Context, conn *ec2.EC2, cidr string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindBYOIPCIDRByCIDR(ctx, conn, cidr)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+func statusImageBlockPublicAccessState(ctx context.Context, conn *ec2.EC2) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindImageBlockPublicAccessState(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, output, nil
+	}
+}
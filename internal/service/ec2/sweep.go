@@ -265,6 +265,11 @@ func init() {
 		F:    sweepTransitGatewayMulticastDomains,
 	})
 
+	resource.AddTestSweepers("aws_ec2_transit_gateway_policy_table", &resource.Sweeper{
+		Name: "aws_ec2_transit_gateway_policy_table",
+		F:    sweepTransitGatewayPolicyTables,
+	})
+
 	resource.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway",
 		F:    sweepTransitGateways,
@@ -272,6 +277,7 @@ func init() {
 			"aws_dx_gateway_association",
 			"aws_ec2_transit_gateway_vpc_attachment",
 			"aws_ec2_transit_gateway_peering_attachment",
+			"aws_ec2_transit_gateway_policy_table",
 			"aws_vpn_connection",
 		},
 	})
@@ -1925,6 +1931,54 @@ func sweepTransitGatewayMulticastDomains(region string) error {
 	return nil
 }
 
+func sweepTransitGatewayPolicyTables(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).EC2Conn()
+	input := &ec2.DescribeTransitGatewayPolicyTablesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.DescribeTransitGatewayPolicyTablesPagesWithContext(ctx, input, func(page *ec2.DescribeTransitGatewayPolicyTablesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TransitGatewayPolicyTables {
+			if aws.StringValue(v.State) == ec2.TransitGatewayPolicyTableStateDeleted {
+				continue
+			}
+
+			r := ResourceTransitGatewayPolicyTable()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.TransitGatewayPolicyTableId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Transit Gateway Policy Table sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing EC2 Transit Gateway Policy Tables (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Transit Gateway Policy Tables (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepTransitGatewayPeeringAttachments(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(region)
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func validSecurityGroupRuleDescription(v interface{}, k string) (ws []string, errors []error) {
@@ -42,3 +44,21 @@ func validNestedExactlyOneOf(m map[string]interface{}, valid []string) error {
 	}
 	return nil
 }
+
+var availabilityZoneIDRegexp = regexp.MustCompile(`^[a-z]{2,5}\d+-az\d+$`)
+
+// validNetworkPerformanceMetricSubscriptionLocation validates that the value is a Region name (e.g. us-east-1)
+// or an Availability Zone ID (e.g. use1-az1).
+func validNetworkPerformanceMetricSubscriptionLocation(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" || availabilityZoneIDRegexp.MatchString(value) {
+		return
+	}
+
+	if _, es := verify.ValidRegionName(value, k); len(es) > 0 {
+		errors = append(errors, fmt.Errorf("%q must be a Region name or an Availability Zone ID: %q", k, value))
+	}
+
+	return
+}
@@ -32,3 +32,36 @@ func TestValidSecurityGroupRuleDescription(t *testing.T) {
 		}
 	}
 }
+
+func TestValidNetworkPerformanceMetricSubscriptionLocation(t *testing.T) {
+	t.Parallel()
+
+	validLocations := []string{
+		"us-east-1",
+		"eu-west-2",
+		"us-gov-west-1",
+		"use1-az1",
+		"apne1-az4",
+		"usgw1-az2",
+	}
+	for _, v := range validLocations {
+		_, errors := validNetworkPerformanceMetricSubscriptionLocation(v, "source")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid network performance metric subscription location: %q", v, errors)
+		}
+	}
+
+	invalidLocations := []string{
+		"us-east-1a",
+		"use1-az",
+		"az1",
+		"US-EAST-1",
+		"use1-az1/use1-az2",
+	}
+	for _, v := range invalidLocations {
+		_, errors := validNetworkPerformanceMetricSubscriptionLocation(v, "source")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid network performance metric subscription location", v)
+		}
+	}
+}
@@ -0,0 +1,292 @@
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_verifiedaccess_instance_logging_configuration")
+func ResourceVerifiedAccessInstanceLoggingConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVerifiedAccessInstanceLoggingConfigurationCreate,
+		ReadWithoutTimeout:   resourceVerifiedAccessInstanceLoggingConfigurationRead,
+		UpdateWithoutTimeout: resourceVerifiedAccessInstanceLoggingConfigurationUpdate,
+		DeleteWithoutTimeout: resourceVerifiedAccessInstanceLoggingConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_logs": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"log_group": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"kinesis_data_firehose": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delivery_stream": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"s3": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"bucket_owner": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"verifiedaccess_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVerifiedAccessInstanceLoggingConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	vaiID := d.Get("verifiedaccess_instance_id").(string)
+	input := &ec2.ModifyVerifiedAccessInstanceLoggingConfigurationInput{
+		AccessLogs:               expandVerifiedAccessLogOptions(d.Get("access_logs").([]interface{})),
+		ClientToken:              aws.String(id.UniqueId()),
+		VerifiedAccessInstanceId: aws.String(vaiID),
+	}
+
+	_, err := conn.ModifyVerifiedAccessInstanceLoggingConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Verified Access Instance Logging Configuration (%s): %s", vaiID, err)
+	}
+
+	d.SetId(vaiID)
+
+	return append(diags, resourceVerifiedAccessInstanceLoggingConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceVerifiedAccessInstanceLoggingConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	output, err := FindVerifiedAccessInstanceLoggingConfigurationByInstanceID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Access Instance Logging Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Verified Access Instance Logging Configuration (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("access_logs", flattenVerifiedAccessLogs(output.AccessLogs)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting access_logs: %s", err)
+	}
+	d.Set("verifiedaccess_instance_id", output.VerifiedAccessInstanceId)
+
+	return diags
+}
+
+func resourceVerifiedAccessInstanceLoggingConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	input := &ec2.ModifyVerifiedAccessInstanceLoggingConfigurationInput{
+		AccessLogs:               expandVerifiedAccessLogOptions(d.Get("access_logs").([]interface{})),
+		ClientToken:              aws.String(id.UniqueId()),
+		VerifiedAccessInstanceId: aws.String(d.Id()),
+	}
+
+	_, err := conn.ModifyVerifiedAccessInstanceLoggingConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Verified Access Instance Logging Configuration (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceVerifiedAccessInstanceLoggingConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceVerifiedAccessInstanceLoggingConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	// Disable all log destinations.
+	log.Printf("[INFO] Deleting Verified Access Instance Logging Configuration: %s", d.Id())
+	_, err := conn.ModifyVerifiedAccessInstanceLoggingConfigurationWithContext(ctx, &ec2.ModifyVerifiedAccessInstanceLoggingConfigurationInput{
+		AccessLogs: &ec2.VerifiedAccessLogOptions{
+			CloudWatchLogs: &ec2.VerifiedAccessLogCloudWatchLogsDestinationOptions{
+				Enabled: aws.Bool(false),
+			},
+			KinesisDataFirehose: &ec2.VerifiedAccessLogKinesisDataFirehoseDestinationOptions{
+				Enabled: aws.Bool(false),
+			},
+			S3: &ec2.VerifiedAccessLogS3DestinationOptions{
+				Enabled: aws.Bool(false),
+			},
+		},
+		ClientToken:              aws.String(id.UniqueId()),
+		VerifiedAccessInstanceId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessInstanceIdNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Verified Access Instance Logging Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandVerifiedAccessLogOptions(tfList []interface{}) *ec2.VerifiedAccessLogOptions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &ec2.VerifiedAccessLogOptions{}
+
+	if v, ok := tfMap["cloudwatch_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CloudWatchLogs = &ec2.VerifiedAccessLogCloudWatchLogsDestinationOptions{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		}
+
+		if v, ok := tfMap["log_group"].(string); ok && v != "" {
+			apiObject.CloudWatchLogs.LogGroup = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["kinesis_data_firehose"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.KinesisDataFirehose = &ec2.VerifiedAccessLogKinesisDataFirehoseDestinationOptions{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		}
+
+		if v, ok := tfMap["delivery_stream"].(string); ok && v != "" {
+			apiObject.KinesisDataFirehose.DeliveryStream = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3 = &ec2.VerifiedAccessLogS3DestinationOptions{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		}
+
+		if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+			apiObject.S3.BucketName = aws.String(v)
+		}
+
+		if v, ok := tfMap["bucket_owner"].(string); ok && v != "" {
+			apiObject.S3.BucketOwner = aws.String(v)
+		}
+
+		if v, ok := tfMap["prefix"].(string); ok && v != "" {
+			apiObject.S3.Prefix = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenVerifiedAccessLogs(apiObject *ec2.VerifiedAccessLogs) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CloudWatchLogs; v != nil && aws.BoolValue(v.Enabled) {
+		tfMap["cloudwatch_logs"] = []interface{}{map[string]interface{}{
+			"enabled":   aws.BoolValue(v.Enabled),
+			"log_group": aws.StringValue(v.LogGroup),
+		}}
+	}
+
+	if v := apiObject.KinesisDataFirehose; v != nil && aws.BoolValue(v.Enabled) {
+		tfMap["kinesis_data_firehose"] = []interface{}{map[string]interface{}{
+			"delivery_stream": aws.StringValue(v.DeliveryStream),
+			"enabled":         aws.BoolValue(v.Enabled),
+		}}
+	}
+
+	if v := apiObject.S3; v != nil && aws.BoolValue(v.Enabled) {
+		tfMap["s3"] = []interface{}{map[string]interface{}{
+			"bucket_name":  aws.StringValue(v.BucketName),
+			"bucket_owner": aws.StringValue(v.BucketOwner),
+			"enabled":      aws.BoolValue(v.Enabled),
+			"prefix":       aws.StringValue(v.Prefix),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,66 @@
+package ec2_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccVerifiedAccess_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"Endpoint": {
+			"basic":      testAccVerifiedAccessEndpoint_basic,
+			"disappears": testAccVerifiedAccessEndpoint_disappears,
+			"tags":       testAccVerifiedAccessEndpoint_tags,
+		},
+		"Group": {
+			"basic":      testAccVerifiedAccessGroup_basic,
+			"disappears": testAccVerifiedAccessGroup_disappears,
+			"policy":     testAccVerifiedAccessGroup_policy,
+			"tags":       testAccVerifiedAccessGroup_tags,
+		},
+		"Instance": {
+			"basic":       testAccVerifiedAccessInstance_basic,
+			"description": testAccVerifiedAccessInstance_description,
+			"disappears":  testAccVerifiedAccessInstance_disappears,
+			"tags":        testAccVerifiedAccessInstance_tags,
+		},
+		"InstanceLoggingConfiguration": {
+			"basic":      testAccVerifiedAccessInstanceLoggingConfiguration_basic,
+			"disappears": testAccVerifiedAccessInstanceLoggingConfiguration_disappears,
+		},
+		"InstanceTrustProviderAttachment": {
+			"basic":      testAccVerifiedAccessInstanceTrustProviderAttachment_basic,
+			"disappears": testAccVerifiedAccessInstanceTrustProviderAttachment_disappears,
+		},
+		"TrustProvider": {
+			"basic":         testAccVerifiedAccessTrustProvider_basic,
+			"deviceOptions": testAccVerifiedAccessTrustProvider_deviceOptions,
+			"disappears":    testAccVerifiedAccessTrustProvider_disappears,
+			"oidcOptions":   testAccVerifiedAccessTrustProvider_oidcOptions,
+			"tags":          testAccVerifiedAccessTrustProvider_tags,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
+
+func testAccPreCheckVerifiedAccess(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+	input := &ec2.DescribeVerifiedAccessInstancesInput{}
+	_, err := conn.DescribeVerifiedAccessInstancesWithContext(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
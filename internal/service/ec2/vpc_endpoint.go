@@ -87,6 +87,11 @@ func ResourceVPCEndpoint() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: validation.StringInSlice(ec2.DnsRecordIpType_Values(), false),
 						},
+						"private_dns_only_for_inbound_resolver_endpoint": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -372,6 +377,14 @@ func resourceVPCEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta
 			if v, ok := d.GetOk("dns_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.DnsOptions = expandDNSOptionsSpecification(v.([]interface{})[0].(map[string]interface{}))
 			}
+
+			// Explicitly send "false" when private DNS only for inbound resolver endpoints is being disabled.
+			if k := "dns_options.0.private_dns_only_for_inbound_resolver_endpoint"; d.HasChange(k) {
+				if input.DnsOptions == nil {
+					input.DnsOptions = &ec2.DnsOptionsSpecification{}
+				}
+				input.DnsOptions.PrivateDnsOnlyForInboundResolverEndpoint = aws.Bool(d.Get(k).(bool))
+			}
 		}
 
 		if d.HasChange("ip_address_type") {
@@ -482,6 +495,10 @@ func expandDNSOptionsSpecification(tfMap map[string]interface{}) *ec2.DnsOptions
 		apiObject.DnsRecordIpType = aws.String(v)
 	}
 
+	if v, ok := tfMap["private_dns_only_for_inbound_resolver_endpoint"].(bool); ok && v {
+		apiObject.PrivateDnsOnlyForInboundResolverEndpoint = aws.Bool(v)
+	}
+
 	return apiObject
 }
 
@@ -532,6 +549,10 @@ func flattenDNSOptions(apiObject *ec2.DnsOptions) map[string]interface{} {
 		tfMap["dns_record_ip_type"] = aws.StringValue(v)
 	}
 
+	if v := apiObject.PrivateDnsOnlyForInboundResolverEndpoint; v != nil {
+		tfMap["private_dns_only_for_inbound_resolver_endpoint"] = aws.BoolValue(v)
+	}
+
 	return tfMap
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -158,10 +159,39 @@ func ResourceFlowLog() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceFlowLogCustomizeDiff,
+		),
 	}
 }
 
+func resourceFlowLogCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// All arguments force replacement, so only validate new resources.
+	if diff.Id() != "" {
+		return nil
+	}
+
+	// Transit gateway flow logs only support an aggregation interval of 60 seconds.
+	for _, k := range []string{"transit_gateway_id", "transit_gateway_attachment_id"} {
+		if diff.GetRawConfig().GetAttr(k).IsNull() {
+			continue
+		}
+
+		if v := diff.Get("max_aggregation_interval").(int); v != 60 {
+			return fmt.Errorf("max_aggregation_interval must be 60 when %s is set, got: %d", k, v)
+		}
+	}
+
+	if v, ok := diff.GetOk("destination_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if v := diff.Get("log_destination_type").(string); v != ec2.LogDestinationTypeS3 {
+			return fmt.Errorf("destination_options can only be set when log_destination_type is %q, got: %q", ec2.LogDestinationTypeS3, v)
+		}
+	}
+
+	return nil
+}
+
 func resourceLogFlowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
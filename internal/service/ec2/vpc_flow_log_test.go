@@ -196,6 +196,24 @@ func TestAccVPCFlowLog_transitGatewayAttachmentID(t *testing.T) {
 	})
 }
 
+func TestAccVPCFlowLog_transitGatewayIDInvalidMaxAggregationInterval(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowLogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCFlowLogConfig_transitGatewayIDMaxAggregationInterval(rName, 600),
+				ExpectError: regexp.MustCompile(`max_aggregation_interval must be 60 when transit_gateway_id is set`),
+			},
+		},
+	})
+}
+
 func TestAccVPCFlowLog_LogDestinationType_cloudWatchLogs(t *testing.T) {
 	ctx := acctest.Context(t)
 	var flowLog ec2.FlowLog
@@ -1147,6 +1165,32 @@ resource "aws_flow_log" "test" {
 `, rName))
 }
 
+func testAccVPCFlowLogConfig_transitGatewayIDMaxAggregationInterval(rName string, maxAggregationInterval int) string {
+	return acctest.ConfigCompose(testAccFlowLogConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_flow_log" "test" {
+  log_destination          = aws_s3_bucket.test.arn
+  log_destination_type     = "s3"
+  max_aggregation_interval = %[2]d
+  transit_gateway_id       = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, maxAggregationInterval))
+}
+
 func testAccVPCFlowLogConfig_transitGatewayAttachmentID(rName string) string {
 	return acctest.ConfigCompose(testAccFlowLogConfig_base(rName), fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
@@ -2,6 +2,7 @@ package ec2
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -39,6 +40,11 @@ func DataSourceNetworkInsightsAnalysis() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"explanation_codes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"explanations": networkInsightsAnalysisExplanationsSchema,
 			"filter":       CustomFiltersSchema(),
 			"filter_in_arns": {
@@ -47,6 +53,11 @@ func DataSourceNetworkInsightsAnalysis() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"forward_path_components": networkInsightsAnalysisPathComponentsSchema,
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"network_insights_analysis_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -54,6 +65,7 @@ func DataSourceNetworkInsightsAnalysis() *schema.Resource {
 			},
 			"network_insights_path_id": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
 			},
 			"path_found": {
@@ -92,6 +104,10 @@ func dataSourceNetworkInsightsAnalysisRead(ctx context.Context, d *schema.Resour
 		input.NetworkInsightsAnalysisIds = aws.StringSlice([]string{v.(string)})
 	}
 
+	if v, ok := d.GetOk("network_insights_path_id"); ok {
+		input.NetworkInsightsPathId = aws.String(v.(string))
+	}
+
 	input.Filters = append(input.Filters, BuildCustomFilterList(
 		d.Get("filter").(*schema.Set),
 	)...)
@@ -101,10 +117,31 @@ func dataSourceNetworkInsightsAnalysisRead(ctx context.Context, d *schema.Resour
 		input.Filters = nil
 	}
 
-	output, err := FindNetworkInsightsAnalysis(ctx, conn, input)
+	var output *ec2.NetworkInsightsAnalysis
+
+	if d.Get("most_recent").(bool) {
+		analyses, err := FindNetworkInsightsAnalyses(ctx, conn, input)
+
+		if err == nil && len(analyses) == 0 {
+			err = tfresource.NewEmptyResultError(input)
+		}
 
-	if err != nil {
-		return diag.FromErr(tfresource.SingularDataSourceFindError("EC2 Network Insights Analysis", err))
+		if err != nil {
+			return diag.FromErr(tfresource.SingularDataSourceFindError("EC2 Network Insights Analysis", err))
+		}
+
+		sort.Slice(analyses, func(i, j int) bool {
+			return aws.TimeValue(analyses[i].StartDate).After(aws.TimeValue(analyses[j].StartDate))
+		})
+
+		output = analyses[0]
+	} else {
+		var err error
+		output, err = FindNetworkInsightsAnalysis(ctx, conn, input)
+
+		if err != nil {
+			return diag.FromErr(tfresource.SingularDataSourceFindError("EC2 Network Insights Analysis", err))
+		}
 	}
 
 	networkInsightsAnalysisID := aws.StringValue(output.NetworkInsightsAnalysisId)
@@ -113,6 +150,7 @@ func dataSourceNetworkInsightsAnalysisRead(ctx context.Context, d *schema.Resour
 		return diag.Errorf("setting alternate_path_hints: %s", err)
 	}
 	d.Set("arn", output.NetworkInsightsAnalysisArn)
+	d.Set("explanation_codes", flattenExplanationCodes(output.Explanations))
 	if err := d.Set("explanations", flattenExplanations(output.Explanations)); err != nil {
 		return diag.Errorf("setting explanations: %s", err)
 	}
@@ -137,3 +175,30 @@ func dataSourceNetworkInsightsAnalysisRead(ctx context.Context, d *schema.Resour
 
 	return nil
 }
+
+// flattenExplanationCodes returns the distinct explanation codes, in order of first occurrence.
+func flattenExplanationCodes(apiObjects []*ec2.Explanation) []string {
+	var tfList []string
+	seen := make(map[string]struct{})
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		v := aws.StringValue(apiObject.ExplanationCode)
+
+		if v == "" {
+			continue
+		}
+
+		if _, ok := seen[v]; ok {
+			continue
+		}
+
+		seen[v] = struct{}{}
+		tfList = append(tfList, v)
+	}
+
+	return tfList
+}
@@ -44,6 +44,32 @@ func TestAccVPCNetworkInsightsAnalysisDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccVPCNetworkInsightsAnalysisDataSource_mostRecent(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_analysis.test2"
+	datasourceName := "data.aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAnalysisDataSourceConfig_mostRecent(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(datasourceName, "explanation_codes.#", "0"),
+					resource.TestCheckResourceAttrPair(datasourceName, "network_insights_analysis_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "network_insights_path_id", "aws_ec2_network_insights_path.test", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "path_found", "true"),
+					resource.TestCheckResourceAttr(datasourceName, "status", "succeeded"),
+				),
+			},
+		},
+	})
+}
+
 func testAccVPCNetworkInsightsAnalysisDataSourceConfig_basic(rName string) string {
 	return acctest.ConfigCompose(testAccVPCNetworkInsightsAnalysisConfig_base(rName), fmt.Sprintf(`
 resource "aws_ec2_network_insights_analysis" "test" {
@@ -60,3 +86,39 @@ data "aws_ec2_network_insights_analysis" "test" {
 }
 `, rName))
 }
+
+func testAccVPCNetworkInsightsAnalysisDataSourceConfig_mostRecent(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAnalysisConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test1" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+  wait_for_completion      = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_network_insights_analysis" "test2" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+  wait_for_completion      = true
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_ec2_network_insights_analysis.test1]
+}
+
+data "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+  most_recent              = true
+
+  filter {
+    name   = "status"
+    values = ["succeeded"]
+  }
+
+  depends_on = [aws_ec2_network_insights_analysis.test2]
+}
+`, rName))
+}
@@ -41,14 +41,34 @@ func ResourceNetworkInsightsPath() *schema.Resource {
 				ForceNew: true,
 			},
 			"destination_ip": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"filter_at_destination"},
 			},
 			"destination_port": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				ForceNew: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"filter_at_source"},
+			},
+			"filter_at_destination": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				Elem:          networkInsightsPathFilterSchema(),
+				ConflictsWith: []string{"destination_ip"},
+			},
+			"filter_at_source": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				Elem:          networkInsightsPathFilterSchema(),
+				ConflictsWith: []string{"destination_port", "source_ip"},
 			},
 			"protocol": {
 				Type:         schema.TypeString,
@@ -62,9 +82,10 @@ func ResourceNetworkInsightsPath() *schema.Resource {
 				ForceNew: true,
 			},
 			"source_ip": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"filter_at_source"},
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -74,6 +95,50 @@ func ResourceNetworkInsightsPath() *schema.Resource {
 	}
 }
 
+func networkInsightsPathFilterSchema() *schema.Resource {
+	portRangeSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"from_port": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IsPortNumber,
+				},
+				"to_port": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IsPortNumber,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"destination_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"destination_port_range": portRangeSchema,
+			"source_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"source_port_range": portRangeSchema,
+		},
+	}
+}
+
 func resourceNetworkInsightsPathCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
@@ -92,6 +157,14 @@ func resourceNetworkInsightsPathCreate(ctx context.Context, d *schema.ResourceDa
 		input.DestinationPort = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("filter_at_destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FilterAtDestination = expandPathRequestFilter(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("filter_at_source"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FilterAtSource = expandPathRequestFilter(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("source_ip"); ok {
 		input.SourceIp = aws.String(v.(string))
 	}
@@ -127,6 +200,20 @@ func resourceNetworkInsightsPathRead(ctx context.Context, d *schema.ResourceData
 	d.Set("destination", nip.Destination)
 	d.Set("destination_ip", nip.DestinationIp)
 	d.Set("destination_port", nip.DestinationPort)
+	if nip.FilterAtDestination != nil {
+		if err := d.Set("filter_at_destination", []interface{}{flattenPathFilter(nip.FilterAtDestination)}); err != nil {
+			return diag.Errorf("setting filter_at_destination: %s", err)
+		}
+	} else {
+		d.Set("filter_at_destination", nil)
+	}
+	if nip.FilterAtSource != nil {
+		if err := d.Set("filter_at_source", []interface{}{flattenPathFilter(nip.FilterAtSource)}); err != nil {
+			return diag.Errorf("setting filter_at_source: %s", err)
+		}
+	} else {
+		d.Set("filter_at_source", nil)
+	}
 	d.Set("protocol", nip.Protocol)
 	d.Set("source", nip.Source)
 	d.Set("source_ip", nip.SourceIp)
@@ -159,3 +246,91 @@ func resourceNetworkInsightsPathDelete(ctx context.Context, d *schema.ResourceDa
 
 	return nil
 }
+
+func expandPathRequestFilter(tfMap map[string]interface{}) *ec2.PathRequestFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.PathRequestFilter{}
+
+	if v, ok := tfMap["destination_address"].(string); ok && v != "" {
+		apiObject.DestinationAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination_port_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DestinationPortRange = expandRequestFilterPortRange(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["source_address"].(string); ok && v != "" {
+		apiObject.SourceAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["source_port_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SourcePortRange = expandRequestFilterPortRange(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandRequestFilterPortRange(tfMap map[string]interface{}) *ec2.RequestFilterPortRange {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.RequestFilterPortRange{}
+
+	if v, ok := tfMap["from_port"].(int); ok && v != 0 {
+		apiObject.FromPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["to_port"].(int); ok && v != 0 {
+		apiObject.ToPort = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenPathFilter(apiObject *ec2.PathFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DestinationAddress; v != nil {
+		tfMap["destination_address"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DestinationPortRange; v != nil {
+		tfMap["destination_port_range"] = []interface{}{flattenFilterPortRange(v)}
+	}
+
+	if v := apiObject.SourceAddress; v != nil {
+		tfMap["source_address"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SourcePortRange; v != nil {
+		tfMap["source_port_range"] = []interface{}{flattenFilterPortRange(v)}
+	}
+
+	return tfMap
+}
+
+func flattenFilterPortRange(apiObject *ec2.FilterPortRange) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FromPort; v != nil {
+		tfMap["from_port"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ToPort; v != nil {
+		tfMap["to_port"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
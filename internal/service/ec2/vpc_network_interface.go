@@ -1058,6 +1058,11 @@ func attachNetworkInterface(ctx context.Context, conn *ec2.EC2, networkInterface
 		NetworkInterfaceId: aws.String(networkInterfaceID),
 	}
 
+	return attachNetworkInterfaceWithInput(ctx, conn, input, timeout)
+}
+
+func attachNetworkInterfaceWithInput(ctx context.Context, conn *ec2.EC2, input *ec2.AttachNetworkInterfaceInput, timeout time.Duration) (string, error) {
+	networkInterfaceID, instanceID := aws.StringValue(input.NetworkInterfaceId), aws.StringValue(input.InstanceId)
 	output, err := conn.AttachNetworkInterfaceWithContext(ctx, input)
 
 	if err != nil {
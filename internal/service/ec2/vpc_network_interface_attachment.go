@@ -4,6 +4,8 @@ import (
 	"context"
 	"log"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -16,6 +18,7 @@ func ResourceNetworkInterfaceAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceNetworkInterfaceAttachmentCreate,
 		ReadWithoutTimeout:   resourceNetworkInterfaceAttachmentRead,
+		UpdateWithoutTimeout: resourceNetworkInterfaceAttachmentUpdate,
 		DeleteWithoutTimeout: resourceNetworkInterfaceAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -31,11 +34,47 @@ func ResourceNetworkInterfaceAttachment() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"ena_srd_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ena_srd_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"ena_srd_udp_specification": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ena_srd_udp_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"instance_id": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"network_card_index": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"network_interface_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -53,12 +92,21 @@ func resourceNetworkInterfaceAttachmentCreate(ctx context.Context, d *schema.Res
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
-	attachmentID, err := attachNetworkInterface(ctx, conn,
-		d.Get("network_interface_id").(string),
-		d.Get("instance_id").(string),
-		d.Get("device_index").(int),
-		networkInterfaceAttachedTimeout,
-	)
+	input := &ec2.AttachNetworkInterfaceInput{
+		DeviceIndex:        aws.Int64(int64(d.Get("device_index").(int))),
+		InstanceId:         aws.String(d.Get("instance_id").(string)),
+		NetworkInterfaceId: aws.String(d.Get("network_interface_id").(string)),
+	}
+
+	if v, ok := d.GetOk("ena_srd_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EnaSrdSpecification = expandEnaSrdSpecification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("network_card_index"); ok {
+		input.NetworkCardIndex = aws.Int64(int64(v.(int)))
+	}
+
+	attachmentID, err := attachNetworkInterfaceWithInput(ctx, conn, input, networkInterfaceAttachedTimeout)
 
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
@@ -90,12 +138,46 @@ func resourceNetworkInterfaceAttachmentRead(ctx context.Context, d *schema.Resou
 	d.Set("network_interface_id", network_interface.NetworkInterfaceId)
 	d.Set("attachment_id", network_interface.Attachment.AttachmentId)
 	d.Set("device_index", network_interface.Attachment.DeviceIndex)
+	if v := network_interface.Attachment.EnaSrdSpecification; v != nil {
+		if err := d.Set("ena_srd_specification", []interface{}{flattenAttachmentEnaSrdSpecification(v)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting ena_srd_specification: %s", err)
+		}
+	} else {
+		d.Set("ena_srd_specification", nil)
+	}
 	d.Set("instance_id", network_interface.Attachment.InstanceId)
+	d.Set("network_card_index", network_interface.Attachment.NetworkCardIndex)
 	d.Set("status", network_interface.Attachment.Status)
 
 	return diags
 }
 
+func resourceNetworkInterfaceAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	if d.HasChange("ena_srd_specification") {
+		input := &ec2.ModifyNetworkInterfaceAttributeInput{
+			EnaSrdSpecification: &ec2.EnaSrdSpecification{
+				EnaSrdEnabled: aws.Bool(false),
+			},
+			NetworkInterfaceId: aws.String(d.Get("network_interface_id").(string)),
+		}
+
+		if v, ok := d.GetOk("ena_srd_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.EnaSrdSpecification = expandEnaSrdSpecification(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.ModifyNetworkInterfaceAttributeWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Network Interface Attachment (%s) ENA Express: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceNetworkInterfaceAttachmentRead(ctx, d, meta)...)
+}
+
 func resourceNetworkInterfaceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
@@ -105,3 +187,44 @@ func resourceNetworkInterfaceAttachmentDelete(ctx context.Context, d *schema.Res
 	}
 	return diags
 }
+
+func expandEnaSrdSpecification(tfMap map[string]interface{}) *ec2.EnaSrdSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.EnaSrdSpecification{}
+
+	if v, ok := tfMap["ena_srd_enabled"].(bool); ok {
+		apiObject.EnaSrdEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["ena_srd_udp_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.EnaSrdUdpSpecification = &ec2.EnaSrdUdpSpecification{}
+
+		if v, ok := tfMap["ena_srd_udp_enabled"].(bool); ok {
+			apiObject.EnaSrdUdpSpecification.EnaSrdUdpEnabled = aws.Bool(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAttachmentEnaSrdSpecification(apiObject *ec2.AttachmentEnaSrdSpecification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"ena_srd_enabled": aws.BoolValue(apiObject.EnaSrdEnabled),
+	}
+
+	if v := apiObject.EnaSrdUdpSpecification; v != nil {
+		tfMap["ena_srd_udp_specification"] = []interface{}{map[string]interface{}{
+			"ena_srd_udp_enabled": aws.BoolValue(v.EnaSrdUdpEnabled),
+		}}
+	}
+
+	return tfMap
+}
@@ -29,6 +29,7 @@ func TestAccVPCNetworkInterfaceAttachment_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "attachment_id"),
 					resource.TestCheckResourceAttr(resourceName, "device_index", "1"),
 					resource.TestCheckResourceAttrSet(resourceName, "instance_id"),
+					resource.TestCheckResourceAttr(resourceName, "network_card_index", "0"),
 					resource.TestCheckResourceAttrSet(resourceName, "network_interface_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "status"),
 				),
@@ -42,6 +43,67 @@ func TestAccVPCNetworkInterfaceAttachment_basic(t *testing.T) {
 	})
 }
 
+func TestAccVPCNetworkInterfaceAttachment_enaSrdSpecification(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf ec2.NetworkInterface
+	resourceName := "aws_network_interface_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckENIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInterfaceAttachmentConfig_enaSrdSpecification(rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, "aws_network_interface.test", &conf),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_udp_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_udp_specification.0.ena_srd_udp_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCNetworkInterfaceAttachmentConfig_enaSrdSpecification(rName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, "aws_network_interface.test", &conf),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_udp_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_udp_specification.0.ena_srd_udp_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccVPCNetworkInterfaceAttachmentConfig_enaSrdSpecification(rName, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, "aws_network_interface.test", &conf),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_udp_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_udp_specification.0.ena_srd_udp_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccVPCNetworkInterfaceAttachmentConfig_enaSrdSpecification(rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, "aws_network_interface.test", &conf),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_udp_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_udp_specification.0.ena_srd_udp_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccVPCNetworkInterfaceAttachmentConfig_basic(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
@@ -105,3 +167,61 @@ resource "aws_network_interface_attachment" "test" {
 }
 `, rName))
 }
+
+func testAccVPCNetworkInterfaceAttachmentConfig_enaSrdSpecification(rName string, enaSrdEnabled, enaSrdUDPEnabled bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		acctest.AvailableEC2InstanceTypeForAvailabilityZone("data.aws_availability_zones.available.names[0]", "c6in.32xlarge", "m6i.32xlarge"),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "172.16.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id            = aws_vpc.test.id
+  cidr_block        = "172.16.10.0/24"
+  availability_zone = data.aws_availability_zones.available.names[0]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "test" {
+  subnet_id = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface_attachment" "test" {
+  device_index         = 1
+  instance_id          = aws_instance.test.id
+  network_interface_id = aws_network_interface.test.id
+
+  ena_srd_specification {
+    ena_srd_enabled = %[2]t
+
+    ena_srd_udp_specification {
+      ena_srd_udp_enabled = %[3]t
+    }
+  }
+}
+`, rName, enaSrdEnabled, enaSrdUDPEnabled))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/exp/slices"
 )
 
 // @SDKResource("aws_vpc_network_performance_metric_subscription")
@@ -23,11 +24,14 @@ func ResourceNetworkPerformanceMetricSubscription() *schema.Resource {
 		ReadWithoutTimeout:   resourceNetworkPerformanceMetricSubscriptionRead,
 		DeleteWithoutTimeout: resourceNetworkPerformanceMetricSubscriptionDelete,
 
+		CustomizeDiff: resourceNetworkPerformanceMetricSubscriptionCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"destination": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validNetworkPerformanceMetricSubscriptionLocation,
 			},
 			"metric": {
 				Type:             schema.TypeString,
@@ -41,9 +45,10 @@ func ResourceNetworkPerformanceMetricSubscription() *schema.Resource {
 				Computed: true,
 			},
 			"source": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validNetworkPerformanceMetricSubscriptionLocation,
 			},
 			"statistic": {
 				Type:             schema.TypeString,
@@ -136,6 +141,52 @@ func resourceNetworkPerformanceMetricSubscriptionDelete(ctx context.Context, d *
 	return nil
 }
 
+// networkPerformanceMetricSubscriptionStatistics lists the statistics permitted for each metric.
+var networkPerformanceMetricSubscriptionStatistics = map[types.MetricType][]types.StatisticType{
+	types.MetricTypeAggregateLatency: {types.StatisticTypeP50},
+}
+
+func resourceNetworkPerformanceMetricSubscriptionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	source := diff.Get("source").(string)
+	destination := diff.Get("destination").(string)
+
+	if source != "" && destination != "" {
+		if err := validNetworkPerformanceMetricSubscriptionSourceAndDestination(source, destination); err != nil {
+			return err
+		}
+	}
+
+	metric := types.MetricType(diff.Get("metric").(string))
+	statistic := types.StatisticType(diff.Get("statistic").(string))
+
+	if statistics, ok := networkPerformanceMetricSubscriptionStatistics[metric]; ok && !slices.Contains(statistics, statistic) {
+		return fmt.Errorf("statistic %q is not supported for metric %q", statistic, metric)
+	}
+
+	return nil
+}
+
+// validNetworkPerformanceMetricSubscriptionSourceAndDestination checks that source and destination
+// are either both Regions or both Availability Zone IDs in the same Region.
+func validNetworkPerformanceMetricSubscriptionSourceAndDestination(source, destination string) error {
+	sourceIsAZ, destinationIsAZ := availabilityZoneIDRegexp.MatchString(source), availabilityZoneIDRegexp.MatchString(destination)
+
+	if sourceIsAZ != destinationIsAZ {
+		return fmt.Errorf("source (%s) and destination (%s) must both be Regions or both be Availability Zone IDs", source, destination)
+	}
+
+	if sourceIsAZ {
+		sourceRegion, _, _ := strings.Cut(source, "-")
+		destinationRegion, _, _ := strings.Cut(destination, "-")
+
+		if sourceRegion != destinationRegion {
+			return fmt.Errorf("source (%s) and destination (%s) Availability Zones must be in the same Region", source, destination)
+		}
+	}
+
+	return nil
+}
+
 const networkPerformanceMetricSubscriptionRuleIDSeparator = "/"
 
 func NetworkPerformanceMetricSubscriptionCreateResourceID(source, destination, metric, statistic string) string {
@@ -149,6 +200,11 @@ func NetworkPerformanceMetricSubscriptionResourceID(id string) (string, string,
 	parts := strings.Split(id, networkPerformanceMetricSubscriptionRuleIDSeparator)
 
 	if len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" {
+		// Source and destination are either Region names or Availability Zone IDs.
+		if err := validNetworkPerformanceMetricSubscriptionSourceAndDestination(parts[0], parts[1]); err != nil {
+			return "", "", "", "", fmt.Errorf("unexpected format for ID (%s): %w", id, err)
+		}
+
 		return parts[0], parts[1], parts[2], parts[3], nil
 	}
 
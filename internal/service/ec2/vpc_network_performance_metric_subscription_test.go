@@ -18,8 +18,10 @@ func TestAccVPCNetworkPerformanceMetricSubscription_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]func(t *testing.T){
-		"basic":      testAccNetworkPerformanceMetricSubscription_basic,
-		"disappears": testAccNetworkPerformanceMetricSubscription_disappears,
+		"basic":            testAccNetworkPerformanceMetricSubscription_basic,
+		"disappears":       testAccNetworkPerformanceMetricSubscription_disappears,
+		"availabilityZone": testAccNetworkPerformanceMetricSubscription_availabilityZone,
+		"dataSource":       testAccNetworkPerformanceMetricSubscriptionsDataSource_basic,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -76,6 +78,31 @@ func testAccNetworkPerformanceMetricSubscription_disappears(t *testing.T) {
 	})
 }
 
+func testAccNetworkPerformanceMetricSubscription_availabilityZone(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_network_performance_metric_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkPerformanceMetricSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkPerformanceMetricSubscription_availabilityZone(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkPerformanceMetricSubscriptionExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "destination", "data.aws_availability_zones.available", "zone_ids.1"),
+					resource.TestCheckResourceAttr(resourceName, "metric", "aggregate-latency"),
+					resource.TestCheckResourceAttr(resourceName, "period", "five-minutes"),
+					resource.TestCheckResourceAttrPair(resourceName, "source", "data.aws_availability_zones.available", "zone_ids.0"),
+					resource.TestCheckResourceAttr(resourceName, "statistic", "p50"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkPerformanceMetricSubscriptionExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -140,3 +167,12 @@ resource "aws_vpc_network_performance_metric_subscription" "test" {
 }
 `, src, dst)
 }
+
+func testAccVPCNetworkPerformanceMetricSubscription_availabilityZone() string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), `
+resource "aws_vpc_network_performance_metric_subscription" "test" {
+  source      = data.aws_availability_zones.available.zone_ids[0]
+  destination = data.aws_availability_zones.available.zone_ids[1]
+}
+`)
+}
@@ -712,6 +712,49 @@ func TestAccVPC_defaultAndIgnoreTags(t *testing.T) {
 	})
 }
 
+func TestAccVPC_defaultTagsSuppressComputedDiff(t *testing.T) {
+	ctx := acctest.Context(t)
+	var vpc ec2.Vpc
+	resourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCConfig_defaultTagsSuppressComputedDiff("defaultvalue1"),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckVPCExists(ctx, resourceName, &vpc),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.defaultkey1", "defaultvalue1"),
+				),
+			},
+			{
+				// The default tag value is not known until terraform_data.test is created.
+				Config: testAccVPCConfig_defaultTagsSuppressComputedDiffUnknown("defaultvalue1"),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckVPCExists(ctx, resourceName, &vpc),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.defaultkey1", "defaultvalue1"),
+				),
+			},
+			{
+				Config: testAccVPCConfig_defaultTagsSuppressComputedDiff(""),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckVPCExists(ctx, resourceName, &vpc),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.defaultkey1", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPC_ignoreTags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var vpc ec2.Vpc
@@ -1193,6 +1236,46 @@ resource "aws_vpc" "test" {
 `, tagKey1, tagValue1, tagKey2, tagValue2)
 }
 
+func testAccVPCConfig_defaultTagsSuppressComputedDiff(value string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    suppress_computed_diff = true
+
+    tags = {
+      defaultkey1 = %[1]q
+    }
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+}
+`, value)
+}
+
+func testAccVPCConfig_defaultTagsSuppressComputedDiffUnknown(value string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    suppress_computed_diff = true
+
+    tags = {
+      defaultkey1 = terraform_data.test.output
+    }
+  }
+}
+
+resource "terraform_data" "test" {
+  input = %[1]q
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+}
+`, value)
+}
+
 const testAccVPCConfig_tags_computed = `
 resource "aws_eip" "test" {
   domain = "vpc"
@@ -1780,6 +1780,29 @@ func WaitVolumeModificationComplete(ctx context.Context, conn *ec2.EC2, id strin
 	return nil, err
 }
 
+// WaitVolumeModificationOptimized waits for any volume modification to finish optimizing,
+// at which point the volume is at full performance.
+func WaitVolumeModificationOptimized(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VolumeModification, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{ec2.VolumeModificationStateModifying, ec2.VolumeModificationStateOptimizing},
+		Target:     []string{ec2.VolumeModificationStateCompleted},
+		Refresh:    StatusVolumeModificationState(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.VolumeModification); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	vpcAttributePropagationTimeout = 5 * time.Minute
 	vpcCreatedTimeout              = 10 * time.Minute
@@ -3202,3 +3225,137 @@ func WaitInstanceReadyWithContext(ctx context.Context, conn *ec2.EC2, id string,
 
 	return nil, err
 }
+
+func WaitVerifiedAccessEndpointCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VerifiedAccessEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{ec2.VerifiedAccessEndpointStatusCodePending},
+		Target:                    []string{ec2.VerifiedAccessEndpointStatusCodeActive},
+		Refresh:                   StatusVerifiedAccessEndpoint(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.VerifiedAccessEndpoint); ok {
+		if output.Status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVerifiedAccessEndpointUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VerifiedAccessEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{ec2.VerifiedAccessEndpointStatusCodeUpdating},
+		Target:                    []string{ec2.VerifiedAccessEndpointStatusCodeActive},
+		Refresh:                   StatusVerifiedAccessEndpoint(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.VerifiedAccessEndpoint); ok {
+		if output.Status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVerifiedAccessEndpointDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VerifiedAccessEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.VerifiedAccessEndpointStatusCodeDeleting, ec2.VerifiedAccessEndpointStatusCodeActive},
+		Target:  []string{},
+		Refresh: StatusVerifiedAccessEndpoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.VerifiedAccessEndpoint); ok {
+		if output.Status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitBYOIPCIDRAdvertised(ctx context.Context, conn *ec2.EC2, cidr string, timeout time.Duration) (*ec2.ByoipCidr, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.ByoipCidrStateProvisioned},
+		Target:  []string{ec2.ByoipCidrStateAdvertised},
+		Refresh: StatusBYOIPCIDR(ctx, conn, cidr),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.ByoipCidr); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitBYOIPCIDRWithdrawn(ctx context.Context, conn *ec2.EC2, cidr string, timeout time.Duration) (*ec2.ByoipCidr, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.ByoipCidrStateAdvertised},
+		Target:  []string{ec2.ByoipCidrStateProvisioned},
+		Refresh: StatusBYOIPCIDR(ctx, conn, cidr),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.ByoipCidr); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	// The image block public access setting can take up to 10 minutes to take effect.
+	imageBlockPublicAccessStateTimeout = 10 * time.Minute
+)
+
+func waitImageBlockPublicAccessState(ctx context.Context, conn *ec2.EC2, target string) (string, error) {
+	pending := ec2.ImageBlockPublicAccessDisabledStateUnblocked
+	if target == ec2.ImageBlockPublicAccessDisabledStateUnblocked {
+		pending = ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{pending},
+		Target:  []string{target},
+		Refresh: statusImageBlockPublicAccessState(ctx, conn),
+		Timeout: imageBlockPublicAccessStateTimeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(string); ok {
+		return output, err
+	}
+
+	return "", err
+}
@@ -38,3 +38,32 @@ func FindPullThroughCacheRuleByRepositoryPrefix(ctx context.Context, conn *ecr.E
 
 	return output.PullThroughCacheRules[0], nil
 }
+
+func FindRepositoryCreationTemplateByPrefix(ctx context.Context, conn *ecr.ECR, prefix string) (*ecr.RepositoryCreationTemplate, string, error) {
+	input := &ecr.DescribeRepositoryCreationTemplatesInput{
+		Prefixes: aws.StringSlice([]string{prefix}),
+	}
+
+	output, err := conn.DescribeRepositoryCreationTemplatesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeTemplateNotFoundException) {
+		return nil, "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	if output == nil || len(output.RepositoryCreationTemplates) == 0 || output.RepositoryCreationTemplates[0] == nil {
+		return nil, "", tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.RepositoryCreationTemplates); count > 1 {
+		return nil, "", tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.RepositoryCreationTemplates[0], aws.StringValue(output.RegistryId), nil
+}
@@ -2,7 +2,9 @@ package ecr
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecr"
@@ -25,6 +27,8 @@ func ResourceReplicationConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceReplicationConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Type:     schema.TypeString,
@@ -71,6 +75,10 @@ func ResourceReplicationConfiguration() *schema.Resource {
 												"filter": {
 													Type:     schema.TypeString,
 													Required: true,
+													ValidateFunc: validation.All(
+														validation.StringLenBetween(2, 256),
+														validation.StringMatch(regexp.MustCompile(`^(?:[a-z0-9]+(?:[._-][a-z0-9]*)*/)*[a-z0-9]*(?:[._-][a-z0-9]*)*$`), "must be a valid repository name prefix"),
+													),
 												},
 												"filter_type": {
 													Type:         schema.TypeString,
@@ -127,6 +135,44 @@ func resourceReplicationConfigurationRead(ctx context.Context, d *schema.Resourc
 	return diags
 }
 
+func resourceReplicationConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	accountID, region := meta.(*conns.AWSClient).AccountID, meta.(*conns.AWSClient).Region
+
+	for i, rule := range diff.Get("replication_configuration.0.rule").([]interface{}) {
+		if rule == nil {
+			continue
+		}
+
+		destinations := make(map[string]struct{})
+
+		for _, destination := range rule.(map[string]interface{})["destination"].([]interface{}) {
+			if destination == nil {
+				continue
+			}
+
+			tfMap := destination.(map[string]interface{})
+			destinationRegion, destinationRegistryID := tfMap["region"].(string), tfMap["registry_id"].(string)
+
+			// Values may not be known until apply.
+			if destinationRegion == "" || destinationRegistryID == "" {
+				continue
+			}
+
+			if destinationRegion == region && destinationRegistryID == accountID {
+				return fmt.Errorf("replication_configuration.0.rule.%d: destination (%s/%s) is the source registry", i, destinationRegistryID, destinationRegion)
+			}
+
+			key := destinationRegistryID + "/" + destinationRegion
+			if _, ok := destinations[key]; ok {
+				return fmt.Errorf("replication_configuration.0.rule.%d: duplicate destination (%s)", i, key)
+			}
+			destinations[key] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
 func resourceReplicationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECRConn()
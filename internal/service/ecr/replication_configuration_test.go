@@ -3,6 +3,7 @@ package ecr_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ecr"
@@ -16,8 +17,9 @@ func TestAccECRReplicationConfiguration_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]func(t *testing.T){
-		"basic":            testAccReplicationConfiguration_basic,
-		"repositoryFilter": testAccReplicationConfiguration_repositoryFilter,
+		"basic":              testAccReplicationConfiguration_basic,
+		"repositoryFilter":   testAccReplicationConfiguration_repositoryFilter,
+		"invalidDestination": testAccReplicationConfiguration_invalidDestination,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -142,6 +144,27 @@ func testAccReplicationConfiguration_repositoryFilter(t *testing.T) {
 	})
 }
 
+func testAccReplicationConfiguration_invalidDestination(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccReplicationConfigurationConfig_basic(acctest.Region()),
+				ExpectError: regexp.MustCompile(`is the source registry`),
+			},
+			{
+				Config:      testAccReplicationConfigurationConfig_multipleRegion(acctest.AlternateRegion(), acctest.AlternateRegion()),
+				ExpectError: regexp.MustCompile(`duplicate destination`),
+			},
+		},
+	})
+}
+
 func testAccCheckReplicationConfigurationExists(ctx context.Context, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[name]
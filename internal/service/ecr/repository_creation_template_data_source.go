@@ -0,0 +1,99 @@
+package ecr
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// @SDKDataSource("aws_ecr_repository_creation_template")
+func DataSourceRepositoryCreationTemplate() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRepositoryCreationTemplateRead,
+
+		Schema: map[string]*schema.Schema{
+			"applied_for": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"custom_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encryption_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kms_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"image_tag_mutability": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lifecycle_policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"repository_policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceRepositoryCreationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECRConn()
+
+	prefix := d.Get("prefix").(string)
+
+	template, registryID, err := FindRepositoryCreationTemplateByPrefix(ctx, conn, prefix)
+
+	if err != nil {
+		return diag.Errorf("reading ECR Repository Creation Template (%s): %s", prefix, err)
+	}
+
+	d.SetId(aws.StringValue(template.Prefix))
+	d.Set("applied_for", aws.StringValueSlice(template.AppliedFor))
+	d.Set("custom_role_arn", template.CustomRoleArn)
+	d.Set("description", template.Description)
+	if err := d.Set("encryption_configuration", flattenRepositoryCreationTemplateEncryptionConfiguration(template.EncryptionConfiguration)); err != nil {
+		return diag.Errorf("setting encryption_configuration: %s", err)
+	}
+	d.Set("image_tag_mutability", template.ImageTagMutability)
+	d.Set("lifecycle_policy", template.LifecyclePolicy)
+	d.Set("prefix", template.Prefix)
+	d.Set("registry_id", registryID)
+	d.Set("repository_policy", template.RepositoryPolicy)
+	d.Set("resource_tags", KeyValueTags(ctx, template.ResourceTags).Map())
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECRRepositoryCreationTemplateDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	prefix := "tf-test-" + sdkacctest.RandString(8)
+	dataSource := "data.aws_ecr_repository_creation_template.test"
+	resourceName := "aws_ecr_repository_creation_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryCreationTemplateDataSourceConfig_basic(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSource, "applied_for.#", resourceName, "applied_for.#"),
+					resource.TestCheckResourceAttrPair(dataSource, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSource, "image_tag_mutability", resourceName, "image_tag_mutability"),
+					resource.TestCheckResourceAttr(dataSource, "prefix", prefix),
+					acctest.CheckResourceAttrAccountID(dataSource, "registry_id"),
+					resource.TestCheckResourceAttr(dataSource, "resource_tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSource, "resource_tags.Foo", "Bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoryCreationTemplateDataSourceConfig_basic(prefix string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository_creation_template" "test" {
+  prefix      = %[1]q
+  description = "Test"
+
+  applied_for = [
+    "PULL_THROUGH_CACHE",
+  ]
+
+  resource_tags = {
+    Foo = "Bar"
+  }
+}
+
+data "aws_ecr_repository_creation_template" "test" {
+  prefix = aws_ecr_repository_creation_template.test.prefix
+}
+`, prefix)
+}
@@ -37,6 +37,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceRepository,
 			TypeName: "aws_ecr_repository",
 		},
+		{
+			Factory:  DataSourceRepositoryCreationTemplate,
+			TypeName: "aws_ecr_repository_creation_template",
+		},
 	}
 }
 
@@ -70,6 +74,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceRepositoryCreationTemplate,
+			TypeName: "aws_ecr_repository_creation_template",
+		},
 		{
 			Factory:  ResourceRepositoryPolicy,
 			TypeName: "aws_ecr_repository_policy",
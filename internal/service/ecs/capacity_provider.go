@@ -55,6 +55,12 @@ func ResourceCapacityProvider() *schema.Resource {
 							ForceNew:     true,
 							ValidateFunc: verify.ValidARN,
 						},
+						"managed_draining": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(ecs.ManagedDraining_Values(), false),
+						},
 						"managed_scaling": {
 							Type:     schema.TypeList,
 							MaxItems: 1,
@@ -183,6 +189,12 @@ func resourceCapacityProviderRead(ctx context.Context, d *schema.ResourceData, m
 
 	SetTagsOut(ctx, output.Tags)
 
+	// A failed update leaves the previous configuration in place, e.g. when the Auto Scaling group
+	// does not have scale-in protection enabled for managed termination protection.
+	if aws.StringValue(output.UpdateStatus) == ecs.CapacityProviderUpdateStatusUpdateFailed {
+		diags = sdkdiag.AppendWarningf(diags, "ECS Capacity Provider (%s) last update failed: %s", d.Id(), aws.StringValue(output.UpdateStatusReason))
+	}
+
 	return diags
 }
 
@@ -278,6 +290,10 @@ func expandAutoScalingGroupProviderCreate(configured interface{}) *ecs.AutoScali
 	arn := p["auto_scaling_group_arn"].(string)
 	prov.AutoScalingGroupArn = aws.String(arn)
 
+	if v := p["managed_draining"].(string); len(v) > 0 {
+		prov.ManagedDraining = aws.String(v)
+	}
+
 	if mtp := p["managed_termination_protection"].(string); len(mtp) > 0 {
 		prov.ManagedTerminationProtection = aws.String(mtp)
 	}
@@ -299,6 +315,10 @@ func expandAutoScalingGroupProviderUpdate(configured interface{}) *ecs.AutoScali
 	prov := ecs.AutoScalingGroupProviderUpdate{}
 	p := configured.([]interface{})[0].(map[string]interface{})
 
+	if v := p["managed_draining"].(string); len(v) > 0 {
+		prov.ManagedDraining = aws.String(v)
+	}
+
 	if mtp := p["managed_termination_protection"].(string); len(mtp) > 0 {
 		prov.ManagedTerminationProtection = aws.String(mtp)
 	}
@@ -347,6 +367,7 @@ func flattenAutoScalingGroupProvider(provider *ecs.AutoScalingGroupProvider) []m
 
 	p := map[string]interface{}{
 		"auto_scaling_group_arn":         aws.StringValue(provider.AutoScalingGroupArn),
+		"managed_draining":               aws.StringValue(provider.ManagedDraining),
 		"managed_termination_protection": aws.StringValue(provider.ManagedTerminationProtection),
 		"managed_scaling":                []map[string]interface{}{},
 	}
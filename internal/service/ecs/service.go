@@ -178,6 +178,10 @@ func ResourceService() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"force_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"force_new_deployment": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -411,6 +415,57 @@ func ResourceService() *schema.Resource {
 										Type:     schema.TypeString,
 										Required: true,
 									},
+									"timeout": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"idle_timeout_seconds": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 2147483647),
+												},
+												"per_request_timeout_seconds": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 2147483647),
+												},
+											},
+										},
+									},
+									"tls": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"issuer_cert_authority": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"aws_pca_authority_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: verify.ValidARN,
+															},
+														},
+													},
+												},
+												"kms_key": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -761,9 +816,16 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "setting network_configuration: %s", err)
 	}
 
-	// if err := d.Set("service_connect_configuration", flattenServiceConnectConfiguration(service.ServiceConnectConfiguration)); err != nil {
-	// 	return fmt.Errorf("setting service_connect_configuration for (%s): %w", d.Id(), err)
-	// }
+	// Service Connect configuration is only returned as part of each deployment.
+	for _, deployment := range service.Deployments {
+		if aws.StringValue(deployment.Status) != deploymentStatusPrimary {
+			continue
+		}
+
+		if err := d.Set("service_connect_configuration", flattenServiceConnectConfiguration(deployment.ServiceConnectConfiguration, d.Get("service_connect_configuration.0.namespace").(string))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting service_connect_configuration: %s", err)
+		}
+	}
 
 	if err := d.Set("service_registries", flattenServiceRegistries(service.ServiceRegistries)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting service_registries: %s", err)
@@ -778,7 +840,7 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSConn()
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept("force_delete", "tags", "tags_all") {
 		input := &ecs.UpdateServiceInput{
 			Cluster:            aws.String(d.Get("cluster").(string)),
 			ForceNewDeployment: aws.Bool(d.Get("force_new_deployment").(bool)),
@@ -966,8 +1028,10 @@ func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return diags
 	}
 
+	forceDelete := d.Get("force_delete").(bool)
+
 	// Drain the ECS service
-	if aws.StringValue(service.Status) != serviceStatusDraining && aws.StringValue(service.SchedulingStrategy) != ecs.SchedulingStrategyDaemon {
+	if !forceDelete && aws.StringValue(service.Status) != serviceStatusDraining && aws.StringValue(service.SchedulingStrategy) != ecs.SchedulingStrategyDaemon {
 		_, err := conn.UpdateServiceWithContext(ctx, &ecs.UpdateServiceInput{
 			Service:      aws.String(d.Id()),
 			Cluster:      aws.String(d.Get("cluster").(string)),
@@ -983,6 +1047,11 @@ func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta int
 		Service: aws.String(d.Id()),
 		Cluster: aws.String(d.Get("cluster").(string)),
 	}
+
+	if forceDelete {
+		input.Force = aws.Bool(true)
+	}
+
 	log.Printf("[DEBUG] Deleting ECS Service: %s", d.Id())
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		_, err := conn.DeleteServiceWithContext(ctx, &input)
@@ -1102,7 +1171,7 @@ func expandAlarms(tfMap map[string]interface{}) *ecs.DeploymentAlarms {
 		apiObject.Enable = aws.Bool(v)
 	}
 
-	if v, ok := tfMap["enable"].(bool); ok {
+	if v, ok := tfMap["rollback"].(bool); ok {
 		apiObject.Rollback = aws.Bool(v)
 	}
 
@@ -1437,6 +1506,12 @@ func expandServices(srv []interface{}) []*ecs.ServiceConnectService {
 		if v, ok := raw["port_name"].(string); ok && v != "" {
 			config.PortName = aws.String(v)
 		}
+		if v, ok := raw["timeout"].([]interface{}); ok && len(v) > 0 {
+			config.Timeout = expandTimeoutConfiguration(v)
+		}
+		if v, ok := raw["tls"].([]interface{}); ok && len(v) > 0 {
+			config.Tls = expandServiceConnectTLSConfiguration(v)
+		}
 
 		out = append(out, &config)
 	}
@@ -1444,6 +1519,53 @@ func expandServices(srv []interface{}) []*ecs.ServiceConnectService {
 	return out
 }
 
+func expandTimeoutConfiguration(timeout []interface{}) *ecs.TimeoutConfiguration {
+	if len(timeout) == 0 || timeout[0] == nil {
+		return nil
+	}
+
+	raw, ok := timeout[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	config := &ecs.TimeoutConfiguration{}
+	if v, ok := raw["idle_timeout_seconds"].(int); ok {
+		config.IdleTimeoutSeconds = aws.Int64(int64(v))
+	}
+	if v, ok := raw["per_request_timeout_seconds"].(int); ok {
+		config.PerRequestTimeoutSeconds = aws.Int64(int64(v))
+	}
+
+	return config
+}
+
+func expandServiceConnectTLSConfiguration(tls []interface{}) *ecs.ServiceConnectTlsConfiguration {
+	if len(tls) == 0 || tls[0] == nil {
+		return nil
+	}
+
+	raw, ok := tls[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	config := &ecs.ServiceConnectTlsConfiguration{}
+	if v, ok := raw["issuer_cert_authority"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		config.IssuerCertificateAuthority = &ecs.ServiceConnectTlsCertificateAuthority{
+			AwsPcaAuthorityArn: aws.String(v[0].(map[string]interface{})["aws_pca_authority_arn"].(string)),
+		}
+	}
+	if v, ok := raw["kms_key"].(string); ok && v != "" {
+		config.KmsKey = aws.String(v)
+	}
+	if v, ok := raw["role_arn"].(string); ok && v != "" {
+		config.RoleArn = aws.String(v)
+	}
+
+	return config
+}
+
 func expandClientAliases(srv []interface{}) []*ecs.ServiceConnectClientAlias {
 	if len(srv) == 0 {
 		return nil
@@ -1470,6 +1592,157 @@ func expandClientAliases(srv []interface{}) []*ecs.ServiceConnectClientAlias {
 	return out
 }
 
+func flattenServiceConnectConfiguration(apiObject *ecs.ServiceConnectConfiguration, namespace string) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	// Removing the configuration block disables Service Connect.
+	if !aws.BoolValue(apiObject.Enabled) && apiObject.Namespace == nil && apiObject.LogConfiguration == nil && len(apiObject.Services) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled": aws.BoolValue(apiObject.Enabled),
+	}
+
+	if v := apiObject.LogConfiguration; v != nil {
+		tfMap["log_configuration"] = flattenLogConfiguration(v)
+	}
+
+	if v := aws.StringValue(apiObject.Namespace); v != "" {
+		// The namespace may be configured by name but is returned as an ARN.
+		if arn.IsARN(v) && namespace != "" && !arn.IsARN(namespace) {
+			v = namespace
+		}
+		tfMap["namespace"] = v
+	}
+
+	if v := apiObject.Services; len(v) > 0 {
+		tfMap["service"] = flattenServiceConnectServices(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenLogConfiguration(apiObject *ecs.LogConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"log_driver": aws.StringValue(apiObject.LogDriver),
+		"options":    aws.StringValueMap(apiObject.Options),
+	}
+
+	if v := apiObject.SecretOptions; len(v) > 0 {
+		tfMap["secret_option"] = flattenSecretOptions(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSecretOptions(apiObjects []*ecs.Secret) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":       aws.StringValue(apiObject.Name),
+			"value_from": aws.StringValue(apiObject.ValueFrom),
+		})
+	}
+
+	return tfList
+}
+
+func flattenServiceConnectServices(apiObjects []*ecs.ServiceConnectService) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"discovery_name": aws.StringValue(apiObject.DiscoveryName),
+			"port_name":      aws.StringValue(apiObject.PortName),
+		}
+
+		if v := apiObject.ClientAliases; len(v) > 0 {
+			tfMap["client_alias"] = flattenClientAliases(v)
+		}
+
+		if v := apiObject.IngressPortOverride; v != nil {
+			tfMap["ingress_port_override"] = int(aws.Int64Value(v))
+		}
+
+		if v := apiObject.Timeout; v != nil {
+			tfMap["timeout"] = flattenTimeoutConfiguration(v)
+		}
+
+		if v := apiObject.Tls; v != nil {
+			tfMap["tls"] = flattenServiceConnectTLSConfiguration(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTimeoutConfiguration(apiObject *ecs.TimeoutConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"idle_timeout_seconds":        int(aws.Int64Value(apiObject.IdleTimeoutSeconds)),
+		"per_request_timeout_seconds": int(aws.Int64Value(apiObject.PerRequestTimeoutSeconds)),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenServiceConnectTLSConfiguration(apiObject *ecs.ServiceConnectTlsConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"kms_key":  aws.StringValue(apiObject.KmsKey),
+		"role_arn": aws.StringValue(apiObject.RoleArn),
+	}
+
+	if v := apiObject.IssuerCertificateAuthority; v != nil {
+		tfMap["issuer_cert_authority"] = []interface{}{map[string]interface{}{
+			"aws_pca_authority_arn": aws.StringValue(v.AwsPcaAuthorityArn),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenClientAliases(apiObjects []*ecs.ServiceConnectClientAlias) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"dns_name": aws.StringValue(apiObject.DnsName),
+			"port":     int(aws.Int64Value(apiObject.Port)),
+		})
+	}
+
+	return tfList
+}
+
 func flattenServiceRegistries(srs []*ecs.ServiceRegistry) []map[string]interface{} {
 	if len(srs) == 0 {
 		return nil
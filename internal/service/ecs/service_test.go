@@ -511,6 +511,38 @@ func TestAccECSService_DeploymentControllerType_external(t *testing.T) {
 	})
 }
 
+func TestAccECSService_DeploymentControllerType_externalForceDelete(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service ecs.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_deploymentControllerTypeExternalForceDelete(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "deployment_controller.0.type", ecs.DeploymentControllerTypeExternal),
+					resource.TestCheckResourceAttr(resourceName, "force_delete", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     fmt.Sprintf("%s/%s", rName, rName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				// force_delete and wait_for_steady_state are not read from API
+				ImportStateVerifyIgnore: []string{"force_delete", "wait_for_steady_state"},
+			},
+		},
+	})
+}
+
 func TestAccECSService_alarmsAdd(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service ecs.Service
@@ -531,11 +563,12 @@ func TestAccECSService_alarmsAdd(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccServiceConfig_alarms(rName, true),
+				Config: testAccServiceConfig_alarms(rName, true, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(ctx, resourceName, &service),
 					resource.TestCheckResourceAttr(resourceName, "alarms.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "alarms.0.enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "alarms.0.rollback", "true"),
 				),
 			},
 		},
@@ -555,19 +588,30 @@ func TestAccECSService_alarmsUpdate(t *testing.T) {
 		CheckDestroy:             testAccCheckServiceDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccServiceConfig_alarms(rName, true),
+				Config: testAccServiceConfig_alarms(rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "alarms.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarms.0.enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "alarms.0.rollback", "true"),
+				),
+			},
+			{
+				Config: testAccServiceConfig_alarms(rName, true, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(ctx, resourceName, &service),
 					resource.TestCheckResourceAttr(resourceName, "alarms.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "alarms.0.enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "alarms.0.rollback", "false"),
 				),
 			},
 			{
-				Config: testAccServiceConfig_alarms(rName, false),
+				Config: testAccServiceConfig_alarms(rName, false, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(ctx, resourceName, &service),
 					resource.TestCheckResourceAttr(resourceName, "alarms.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "alarms.0.enable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "alarms.0.rollback", "false"),
 				),
 			},
 		},
@@ -1360,8 +1404,18 @@ func TestAccECSService_ServiceConnect_full(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(ctx, resourceName, &service),
 					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.log_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_connect_configuration.0.namespace", "aws_service_discovery_http_namespace.test", "arn"),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s/%s", rName, rName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_steady_state"},
+			},
 		},
 	})
 }
@@ -1399,6 +1453,34 @@ func TestAccECSService_ServiceConnect_ingressPortOverride(t *testing.T) {
 	})
 }
 
+func TestAccECSService_ServiceConnect_timeout(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service ecs.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_serviceConnectTimeout(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.timeout.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.timeout.0.idle_timeout_seconds", "120"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.timeout.0.per_request_timeout_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.tls.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECSService_ServiceConnect_remove(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service ecs.Service
@@ -2582,7 +2664,7 @@ resource "aws_ecs_service" "test" {
 `, rName))
 }
 
-func testAccServiceConfig_alarms(rName string, enable bool) string {
+func testAccServiceConfig_alarms(rName string, enable, rollback bool) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
   name = %[1]q
@@ -2612,7 +2694,7 @@ resource "aws_ecs_service" "test" {
 
   alarms {
     enable   = %[2]t
-    rollback = %[2]t
+    rollback = %[3]t
     alarm_names = [
       aws_cloudwatch_metric_alarm.test.alarm_name
     ]
@@ -2630,7 +2712,7 @@ resource "aws_cloudwatch_metric_alarm" "test" {
   threshold                 = "80"
   insufficient_data_actions = []
 }
-`, rName, enable)
+`, rName, enable, rollback)
 }
 
 func testAccServiceConfig_noAlarms(rName string) string {
@@ -3857,6 +3939,52 @@ resource "aws_ecs_service" "test" {
 `, rName)
 }
 
+func testAccServiceConfig_deploymentControllerTypeExternalForceDelete(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "name": "mongodb"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  cluster       = aws_ecs_cluster.test.id
+  desired_count = 1
+  force_delete  = true
+  name          = %[1]q
+
+  deployment_controller {
+    type = "EXTERNAL"
+  }
+}
+
+resource "aws_ecs_task_set" "test" {
+  service         = aws_ecs_service.test.id
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+
+  scale {
+    unit  = "PERCENT"
+    value = 100.0
+  }
+}
+`, rName)
+}
+
 func testAccServiceConfig_deploymentPercents(rName string, deploymentMinimumHealthyPercent, deploymentMaximumPercent int) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
@@ -4383,6 +4511,94 @@ resource "aws_ecs_service" "test" {
 `, rName))
 }
 
+func testAccServiceConfig_serviceConnectTimeout(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  ingress {
+    protocol    = "6"
+    from_port   = 80
+    to_port     = 8000
+    cidr_blocks = [aws_vpc.test.cidr_block]
+  }
+
+  egress {
+    from_port = 0
+    to_port   = 0
+    protocol  = "-1"
+
+    cidr_blocks = [
+      "0.0.0.0/0",
+    ]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_service_discovery_http_namespace" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family       = %[1]q
+  network_mode = "awsvpc"
+
+  container_definitions = jsonencode([{
+    name      = "test-nginx"
+    image     = "nginx"
+    cpu       = 10
+    memory    = 512
+    essential = true
+    portMappings = [{
+      name          = "nginx-http"
+      containerPort = 8080
+      protocol      = "tcp"
+      appProtocol   = "http"
+    }]
+  }])
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 1
+
+  network_configuration {
+    subnets          = aws_subnet.test[*].id
+    security_groups  = [aws_security_group.test.id]
+    assign_public_ip = false
+  }
+
+  service_connect_configuration {
+    enabled   = true
+    namespace = aws_service_discovery_http_namespace.test.arn
+
+    service {
+      client_alias {
+        port = 8080
+      }
+
+      port_name = "nginx-http"
+
+      timeout {
+        idle_timeout_seconds        = 120
+        per_request_timeout_seconds = 60
+      }
+    }
+  }
+}
+`, rName))
+}
+
 func testAccServiceConfig_serviceConnectRemoved(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_service_discovery_http_namespace" "test" {
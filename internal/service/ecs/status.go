@@ -17,6 +17,8 @@ const (
 	serviceStatusPending = "tfPENDING"
 	serviceStatusStable  = "tfSTABLE"
 
+	deploymentStatusPrimary = "PRIMARY"
+
 	taskSetStatusActive   = "ACTIVE"
 	taskSetStatusDraining = "DRAINING"
 	taskSetStatusPrimary  = "PRIMARY"
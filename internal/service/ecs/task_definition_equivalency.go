@@ -89,8 +89,8 @@ func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 		for i := 0; i < definition.NumField(); i++ {
 			sf := definition.Field(i)
 
-			// Set all empty slices to nil
-			if sf.Kind() == reflect.Slice {
+			// Set all empty slices and maps to nil
+			if sf.Kind() == reflect.Slice || sf.Kind() == reflect.Map {
 				if sf.IsValid() && !sf.IsNil() && sf.Len() == 0 {
 					sf.Set(reflect.Zero(sf.Type()))
 				}
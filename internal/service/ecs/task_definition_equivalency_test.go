@@ -575,3 +575,54 @@ func TestContainerDefinitionsAreEquivalent_missingEnvironmentName(t *testing.T)
 		t.Fatal("Expected definitions to be equal.")
 	}
 }
+
+func TestContainerDefinitionsAreEquivalent_emptyMaps(t *testing.T) {
+	t.Parallel()
+
+	cfgRepresention := `
+[
+    {
+      "name": "app",
+      "image": "public.ecr.aws/docker/library/nginx:latest",
+      "essential": true,
+      "portMappings": [
+        {
+          "containerPort": 80
+        }
+      ],
+      "memory": 512,
+      "cpu": 256
+    }
+]`
+
+	apiRepresentation := `
+[
+    {
+        "name": "app",
+        "image": "public.ecr.aws/docker/library/nginx:latest",
+        "cpu": 256,
+        "memory": 512,
+        "portMappings": [
+            {
+                "containerPort": 80,
+                "hostPort": 80,
+                "protocol": "tcp"
+            }
+        ],
+        "essential": true,
+        "environment": [],
+        "mountPoints": [],
+        "volumesFrom": [],
+        "systemControls": [],
+        "dockerLabels": {}
+    }
+]`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal {
+		t.Fatal("Expected definitions to be equal.")
+	}
+}
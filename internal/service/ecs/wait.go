@@ -2,11 +2,13 @@ package ecs
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -56,6 +58,10 @@ func waitCapacityProviderUpdated(ctx context.Context, conn *ecs.ECS, arn string)
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if v, ok := outputRaw.(*ecs.CapacityProvider); ok {
+		if aws.StringValue(v.UpdateStatus) == ecs.CapacityProviderUpdateStatusUpdateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.UpdateStatusReason)))
+		}
+
 		return v, err
 	}
 
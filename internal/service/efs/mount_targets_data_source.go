@@ -0,0 +1,144 @@
+package efs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_efs_mount_targets")
+func DataSourceMountTargets() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMountTargetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"file_system_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"mount_targets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mount_target_dns_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMountTargetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EFSConn()
+
+	fileSystemID := d.Get("file_system_id").(string)
+	input := &efs.DescribeMountTargetsInput{
+		FileSystemId: aws.String(fileSystemID),
+	}
+
+	output, err := findMountTargetDescriptions(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EFS Mount Targets: %s", err)
+	}
+
+	var mountTargetIDs []string
+	var mountTargets []interface{}
+
+	for _, v := range output {
+		mountTargetIDs = append(mountTargetIDs, aws.StringValue(v.MountTargetId))
+		mountTargets = append(mountTargets, map[string]interface{}{
+			"availability_zone_id":   aws.StringValue(v.AvailabilityZoneId),
+			"availability_zone_name": aws.StringValue(v.AvailabilityZoneName),
+			"id":                     aws.StringValue(v.MountTargetId),
+			"ip_address":             aws.StringValue(v.IpAddress),
+			"mount_target_dns_name":  meta.(*conns.AWSClient).RegionalHostname(fmt.Sprintf("%s.%s.efs", aws.StringValue(v.AvailabilityZoneName), aws.StringValue(v.FileSystemId))),
+			"network_interface_id":   aws.StringValue(v.NetworkInterfaceId),
+			"owner_id":               aws.StringValue(v.OwnerId),
+			"subnet_id":              aws.StringValue(v.SubnetId),
+			"vpc_id":                 aws.StringValue(v.VpcId),
+		})
+	}
+
+	d.SetId(fileSystemID)
+	d.Set("ids", mountTargetIDs)
+	if err := d.Set("mount_targets", mountTargets); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting mount_targets: %s", err)
+	}
+
+	return diags
+}
+
+func findMountTargetDescriptions(ctx context.Context, conn *efs.EFS, input *efs.DescribeMountTargetsInput) ([]*efs.MountTargetDescription, error) {
+	var output []*efs.MountTargetDescription
+
+	err := describeMountTargetsPages(ctx, conn, input, func(page *efs.DescribeMountTargetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MountTargets {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,78 @@
+package efs_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/efs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEFSMountTargetsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_efs_mount_targets.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, efs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMountTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMountTargetsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "mount_targets.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "mount_targets.*.id", "aws_efs_mount_target.test", "id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "mount_targets.*.ip_address", "aws_efs_mount_target.test", "ip_address"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "mount_targets.*.availability_zone_name", "aws_efs_mount_target.test", "availability_zone_name"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "mount_targets.*.mount_target_dns_name", "aws_efs_mount_target.test", "mount_target_dns_name"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "mount_targets.*.id", "aws_efs_mount_target.test2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEFSMountTargetsDataSource_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_efs_mount_targets.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, efs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMountTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMountTargetsDataSourceConfig_empty(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "mount_targets.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMountTargetsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccMountTargetConfig_modified(rName), `
+data "aws_efs_mount_targets" "test" {
+  file_system_id = aws_efs_file_system.test.id
+
+  depends_on = [aws_efs_mount_target.test, aws_efs_mount_target.test2]
+}
+`)
+}
+
+func testAccMountTargetsDataSourceConfig_empty() string {
+	return `
+resource "aws_efs_file_system" "test" {}
+
+data "aws_efs_mount_targets" "test" {
+  file_system_id = aws_efs_file_system.test.id
+}
+`
+}
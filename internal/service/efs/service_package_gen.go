@@ -37,6 +37,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceMountTarget,
 			TypeName: "aws_efs_mount_target",
 		},
+		{
+			Factory:  DataSourceMountTargets,
+			TypeName: "aws_efs_mount_targets",
+		},
 	}
 }
 
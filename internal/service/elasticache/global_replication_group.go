@@ -169,7 +169,6 @@ func ResourceGlobalReplicationGroup() *schema.Resource {
 			"primary_replication_group_id": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validateReplicationGroupID,
 			},
 			"transit_encryption_enabled": {
@@ -187,6 +186,7 @@ func ResourceGlobalReplicationGroup() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customizeDiffGlobalReplicationGroupEngineVersionErrorOnDowngrade,
 			customizeDiffGlobalReplicationGroupParamGroupNameRequiresMajorVersionUpgrade,
+			customdiff.ForceNewIf("primary_replication_group_id", primaryReplicationGroupIDIsNotMember),
 			customdiff.ComputedIf("global_node_groups", diffHasChange("num_node_groups")),
 		),
 	}
@@ -224,6 +224,25 @@ of the Global Replication Group and all Replication Group members. The AWS provi
 Please use the "-replace" option on the terraform plan and apply commands (see https://www.terraform.io/cli/commands/plan#replace-address).`, diff.Id())
 }
 
+// primaryReplicationGroupIDIsNotMember returns true when primary_replication_group_id is changed to a Replication Group
+// that is not already a member of the Global Replication Group. Promoting an existing secondary member is handled in-place
+// by failing over the Global Replication Group.
+func primaryReplicationGroupIDIsNotMember(ctx context.Context, diff *schema.ResourceDiff, meta any) bool {
+	if diff.Id() == "" || !diff.HasChange("primary_replication_group_id") {
+		return false
+	}
+
+	if !diff.NewValueKnown("primary_replication_group_id") {
+		return true
+	}
+
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
+
+	_, err := FindGlobalReplicationGroupMemberByID(ctx, conn, diff.Id(), diff.Get("primary_replication_group_id").(string))
+
+	return err != nil
+}
+
 type changeDiffer interface {
 	Id() string
 	GetChange(key string) (any, any)
@@ -429,6 +448,12 @@ type globalReplicationGroupUpdater func(input *elasticache.ModifyGlobalReplicati
 func resourceGlobalReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 
+	if d.HasChange("primary_replication_group_id") {
+		if err := failoverGlobalReplicationGroup(ctx, conn, d.Id(), d.Get("primary_replication_group_id").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("updating ElastiCache Global Replication Group (%s) primary replication group: %s", d.Id(), err)
+		}
+	}
+
 	// Only one field can be changed per request
 	if d.HasChange("cache_node_type") {
 		if err := updateGlobalReplicationGroup(ctx, conn, d.Id(), globalReplicationGroupNodeTypeUpdater(d.Get("cache_node_type").(string)), d.Timeout(schema.TimeoutUpdate)); err != nil {
@@ -461,6 +486,11 @@ func resourceGlobalReplicationGroupUpdate(ctx context.Context, d *schema.Resourc
 				return diag.Errorf("updating ElastiCache Global Replication Group (%s): %s", d.Id(), err)
 			}
 		}
+
+		// The engine version upgrade is rolled out to each member Replication Group in turn.
+		if err := waitGlobalReplicationGroupMembersAvailable(ctx, meta.(*conns.AWSClient), d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("updating ElastiCache Global Replication Group (%s) engine version: %s", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("global_replication_group_description") {
@@ -539,10 +569,50 @@ func updateGlobalReplicationGroup(ctx context.Context, conn *elasticache.ElastiC
 	}
 	f(input)
 
-	if _, err := conn.ModifyGlobalReplicationGroupWithContext(ctx, input); err != nil {
+	// The Global Replication Group (or one of its members) may still be settling from a previous modification.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (any, error) {
+		return conn.ModifyGlobalReplicationGroupWithContext(ctx, input)
+	}, elasticache.ErrCodeInvalidGlobalReplicationGroupStateFault, elasticache.ErrCodeInvalidReplicationGroupStateFault)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitGlobalReplicationGroupAvailable(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
+func failoverGlobalReplicationGroup(ctx context.Context, conn *elasticache.ElastiCache, id, primaryReplicationGroupID string, timeout time.Duration) error {
+	member, err := FindGlobalReplicationGroupMemberByID(ctx, conn, id, primaryReplicationGroupID)
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(member.Role) == GlobalReplicationGroupMemberRolePrimary {
+		return nil
+	}
+
+	input := &elasticache.FailoverGlobalReplicationGroupInput{
+		GlobalReplicationGroupId:  aws.String(id),
+		PrimaryRegion:             member.ReplicationGroupRegion,
+		PrimaryReplicationGroupId: aws.String(primaryReplicationGroupID),
+	}
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (any, error) {
+		return conn.FailoverGlobalReplicationGroupWithContext(ctx, input)
+	}, elasticache.ErrCodeInvalidGlobalReplicationGroupStateFault, elasticache.ErrCodeInvalidReplicationGroupStateFault)
+
+	if err != nil {
 		return err
 	}
 
+	if _, err := waitGlobalReplicationGroupMemberPrimary(ctx, conn, id, primaryReplicationGroupID, timeout); err != nil {
+		return fmt.Errorf("waiting for Replication Group (%s) promotion: %w", primaryReplicationGroupID, err)
+	}
+
 	if _, err := waitGlobalReplicationGroupAvailable(ctx, conn, id, timeout); err != nil {
 		return fmt.Errorf("waiting for completion: %w", err)
 	}
@@ -550,6 +620,30 @@ func updateGlobalReplicationGroup(ctx context.Context, conn *elasticache.ElastiC
 	return nil
 }
 
+// waitGlobalReplicationGroupMembersAvailable waits for each member Replication Group of a Global Replication Group
+// to be available in its own Region.
+func waitGlobalReplicationGroupMembersAvailable(ctx context.Context, client *conns.AWSClient, id string, timeout time.Duration) error {
+	globalReplicationGroup, err := FindGlobalReplicationGroupByID(ctx, client.ElastiCacheConn(), id)
+	if err != nil {
+		return err
+	}
+
+	for _, member := range globalReplicationGroup.Members {
+		replicationGroupID := aws.StringValue(member.ReplicationGroupId)
+		conn := client.ElastiCacheConn()
+
+		if region := aws.StringValue(member.ReplicationGroupRegion); region != "" && region != client.Region {
+			conn = elasticache.New(client.Session, aws.NewConfig().WithRegion(region))
+		}
+
+		if _, err := WaitReplicationGroupAvailable(ctx, conn, replicationGroupID, timeout); err != nil {
+			return fmt.Errorf("waiting for member Replication Group (%s) to be available: %w", replicationGroupID, err)
+		}
+	}
+
+	return nil
+}
+
 func resourceGlobalReplicationGroupDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 
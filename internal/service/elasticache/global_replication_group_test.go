@@ -516,6 +516,43 @@ func TestAccElastiCacheGlobalReplicationGroup_ReplaceSecondary_differentRegion(t
 	})
 }
 
+func TestAccElastiCacheGlobalReplicationGroup_failover(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var globalReplcationGroup elasticache.GlobalReplicationGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_global_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		CheckDestroy:             testAccCheckGlobalReplicationGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalReplicationGroupConfig_failover(rName, "p"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalReplicationGroupExists(ctx, resourceName, &globalReplcationGroup),
+					resource.TestCheckResourceAttr(resourceName, "primary_replication_group_id", rName+"-p"),
+				),
+			},
+			{
+				Config: testAccGlobalReplicationGroupConfig_failover(rName, "a"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalReplicationGroupExists(ctx, resourceName, &globalReplcationGroup),
+					resource.TestCheckResourceAttr(resourceName, "primary_replication_group_id", rName+"-a"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccElastiCacheGlobalReplicationGroup_clusterMode_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1793,6 +1830,54 @@ resource "aws_elasticache_replication_group" "third" {
 `, rName))
 }
 
+func testAccGlobalReplicationGroupConfig_failover(rName, primarySuffix string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(2),
+		testAccVPCBaseWithProvider(rName, "primary", acctest.ProviderName, 1),
+		testAccVPCBaseWithProvider(rName, "alternate", acctest.ProviderNameAlternate, 1),
+		fmt.Sprintf(`
+resource "aws_elasticache_global_replication_group" "test" {
+  provider = aws
+
+  global_replication_group_id_suffix = %[1]q
+  primary_replication_group_id       = "%[1]s-%[2]s"
+
+  depends_on = [aws_elasticache_replication_group.primary]
+}
+
+resource "aws_elasticache_replication_group" "primary" {
+  provider = aws
+
+  replication_group_id = "%[1]s-p"
+  description          = "primary"
+
+  subnet_group_name = aws_elasticache_subnet_group.primary.name
+
+  node_type = "cache.m5.large"
+
+  engine             = "redis"
+  engine_version     = "5.0.6"
+  num_cache_clusters = 1
+
+  lifecycle {
+    ignore_changes = [global_replication_group_id]
+  }
+}
+
+resource "aws_elasticache_replication_group" "alternate" {
+  provider = awsalternate
+
+  replication_group_id        = "%[1]s-a"
+  description                 = "alternate"
+  global_replication_group_id = aws_elasticache_global_replication_group.test.global_replication_group_id
+
+  subnet_group_name = aws_elasticache_subnet_group.alternate.name
+
+  num_cache_clusters = 1
+}
+`, rName, primarySuffix))
+}
+
 func testAccGlobalReplicationGroupConfig_replaceSecondaryDifferentRegionSetup(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(3),
@@ -126,3 +126,18 @@ func statusGlobalReplicationGroupMember(ctx context.Context, conn *elasticache.E
 		return member, aws.StringValue(member.Status), nil
 	}
 }
+
+// statusGlobalReplicationGroupMemberRole fetches a Global Replication Group Member and its Role
+func statusGlobalReplicationGroupMemberRole(ctx context.Context, conn *elasticache.ElastiCache, globalReplicationGroupID, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		member, err := FindGlobalReplicationGroupMemberByID(ctx, conn, globalReplicationGroupID, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return member, aws.StringValue(member.Role), nil
+	}
+}
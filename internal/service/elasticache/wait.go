@@ -201,6 +201,24 @@ func waitGlobalReplicationGroupDeleted(ctx context.Context, conn *elasticache.El
 	return nil, err
 }
 
+// waitGlobalReplicationGroupMemberPrimary waits for a Global Replication Group Member to be promoted to primary
+func waitGlobalReplicationGroupMemberPrimary(ctx context.Context, conn *elasticache.ElastiCache, globalReplicationGroupID, id string, timeout time.Duration) (*elasticache.GlobalReplicationGroupMember, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{GlobalReplicationGroupMemberRoleSecondary},
+		Target:     []string{GlobalReplicationGroupMemberRolePrimary},
+		Refresh:    statusGlobalReplicationGroupMemberRole(ctx, conn, globalReplicationGroupID, id),
+		Timeout:    timeout,
+		MinTimeout: globalReplicationGroupAvailableMinTimeout,
+		Delay:      globalReplicationGroupAvailableDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroupMember); ok {
+		return v, err
+	}
+	return nil, err
+}
+
 const (
 	// GlobalReplicationGroupDisassociationReadyTimeout specifies how long to wait for a global replication group
 	// to be in a valid state before disassociating
@@ -11,6 +11,9 @@ import (
 	"strings"
 	"time"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -352,6 +355,29 @@ func ResourceListener() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"mutual_authentication": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ignore_client_certificate_expiry": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mutualAuthenticationMode_Values(), false),
+						},
+						"trust_store_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			"port": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -371,6 +397,12 @@ func ResourceListener() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"tcp_idle_timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(60, 6000),
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -422,6 +454,10 @@ func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v, ok := d.GetOk("mutual_authentication"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MutualAuthentication = expandMutualAuthenticationAttributes(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("port"); ok {
 		input.Port = aws.Int64(int64(v.(int)))
 	}
@@ -474,6 +510,14 @@ func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v, ok := d.GetOk("tcp_idle_timeout_seconds"); ok {
+		if err := modifyListenerAttributes(ctx, meta.(*conns.AWSClient).ELBV2Client(), d.Id(), map[string]string{
+			listenerAttributeTCPIdleTimeoutSeconds: strconv.Itoa(v.(int)),
+		}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting ELBv2 Listener (%s) attributes: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceListenerRead(ctx, d, meta)...)
 }
 
@@ -546,6 +590,37 @@ func resourceListenerRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "setting default_action for ELBv2 listener (%s): %s", d.Id(), err)
 	}
 
+	// Mutual authentication is reported as "off" for listeners that never configured it.
+	// Only keep that in state when it was configured explicitly.
+	if v := listener.MutualAuthentication; v != nil && aws.StringValue(v.Mode) == mutualAuthenticationModeOff && len(d.Get("mutual_authentication").([]interface{})) == 0 {
+		listener.MutualAuthentication = nil
+	}
+	if err := d.Set("mutual_authentication", flattenMutualAuthenticationAttributes(listener.MutualAuthentication)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting mutual_authentication: %s", err)
+	}
+
+	// Listener attributes are only supported on TCP and GENEVE listeners.
+	switch aws.StringValue(listener.Protocol) {
+	case elbv2.ProtocolEnumTcp, elbv2.ProtocolEnumGeneve:
+		attributes, err := findListenerAttributesByARN(ctx, meta.(*conns.AWSClient).ELBV2Client(), d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ELBv2 Listener (%s) attributes: %s", d.Id(), err)
+		}
+
+		if v, ok := attributes[listenerAttributeTCPIdleTimeoutSeconds]; ok {
+			v, err := strconv.Atoi(v)
+
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			d.Set("tcp_idle_timeout_seconds", v)
+		}
+	default:
+		d.Set("tcp_idle_timeout_seconds", nil)
+	}
+
 	return diags
 }
 
@@ -556,7 +631,7 @@ func resourceListenerUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	)
 	conn := meta.(*conns.AWSClient).ELBV2Conn()
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept("tags", "tags_all", "tcp_idle_timeout_seconds") {
 		input := &elbv2.ModifyListenerInput{
 			ListenerArn: aws.String(d.Id()),
 		}
@@ -584,6 +659,17 @@ func resourceListenerUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.AlpnPolicy = aws.StringSlice([]string{v.(string)})
 		}
 
+		if d.HasChange("mutual_authentication") {
+			if v, ok := d.GetOk("mutual_authentication"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.MutualAuthentication = expandMutualAuthenticationAttributes(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				// Removing the configuration block turns mutual authentication off.
+				input.MutualAuthentication = &elbv2.MutualAuthenticationAttributes{
+					Mode: aws.String(mutualAuthenticationModeOff),
+				}
+			}
+		}
+
 		if d.HasChange("default_action") {
 			var err error
 			input.DefaultActions, err = expandLbListenerActions(d.Get("default_action").([]interface{}))
@@ -615,6 +701,16 @@ func resourceListenerUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("tcp_idle_timeout_seconds") {
+		if v, ok := d.GetOk("tcp_idle_timeout_seconds"); ok {
+			if err := modifyListenerAttributes(ctx, meta.(*conns.AWSClient).ELBV2Client(), d.Id(), map[string]string{
+				listenerAttributeTCPIdleTimeoutSeconds: strconv.Itoa(v.(int)),
+			}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "modifying ELBv2 Listener (%s) attributes: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceListenerRead(ctx, d, meta)...)
 }
 
@@ -669,6 +765,115 @@ func retryListenerCreate(ctx context.Context, conn *elbv2.ELBV2, params *elbv2.C
 	return output, nil
 }
 
+const (
+	listenerAttributeTCPIdleTimeoutSeconds = "tcp.idle_timeout.seconds"
+)
+
+func findListenerAttributesByARN(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string) (map[string]string, error) {
+	input := &elasticloadbalancingv2.DescribeListenerAttributesInput{
+		ListenerArn: aws_sdkv2.String(arn),
+	}
+
+	output, err := conn.DescribeListenerAttributes(ctx, input)
+
+	if errs.IsA[*awstypes.ListenerNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	attributes := make(map[string]string, len(output.Attributes))
+
+	for _, v := range output.Attributes {
+		attributes[aws_sdkv2.ToString(v.Key)] = aws_sdkv2.ToString(v.Value)
+	}
+
+	return attributes, nil
+}
+
+func modifyListenerAttributes(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string, attributes map[string]string) error {
+	input := &elasticloadbalancingv2.ModifyListenerAttributesInput{
+		ListenerArn: aws_sdkv2.String(arn),
+	}
+
+	for k, v := range attributes {
+		input.Attributes = append(input.Attributes, awstypes.ListenerAttribute{
+			Key:   aws_sdkv2.String(k),
+			Value: aws_sdkv2.String(v),
+		})
+	}
+
+	_, err := conn.ModifyListenerAttributes(ctx, input)
+
+	return err
+}
+
+// The AWS SDK for Go does not define an enum for the mutual TLS modes.
+const (
+	mutualAuthenticationModeOff         = "off"
+	mutualAuthenticationModePassthrough = "passthrough"
+	mutualAuthenticationModeVerify      = "verify"
+)
+
+// mutualAuthenticationMode_Values returns the valid mutual TLS modes.
+func mutualAuthenticationMode_Values() []string {
+	return []string{
+		mutualAuthenticationModeOff,
+		mutualAuthenticationModePassthrough,
+		mutualAuthenticationModeVerify,
+	}
+}
+
+func expandMutualAuthenticationAttributes(tfMap map[string]interface{}) *elbv2.MutualAuthenticationAttributes {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &elbv2.MutualAuthenticationAttributes{}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		apiObject.Mode = aws.String(v)
+	}
+
+	// The remaining attributes are only accepted in verify mode.
+	if aws.StringValue(apiObject.Mode) != mutualAuthenticationModeVerify {
+		return apiObject
+	}
+
+	if v, ok := tfMap["ignore_client_certificate_expiry"].(bool); ok {
+		apiObject.IgnoreClientCertificateExpiry = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["trust_store_arn"].(string); ok && v != "" {
+		apiObject.TrustStoreArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenMutualAuthenticationAttributes(apiObject *elbv2.MutualAuthenticationAttributes) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"ignore_client_certificate_expiry": aws.BoolValue(apiObject.IgnoreClientCertificateExpiry),
+		"mode":                             aws.StringValue(apiObject.Mode),
+		"trust_store_arn":                  aws.StringValue(apiObject.TrustStoreArn),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandLbListenerActions(l []interface{}) ([]*elbv2.Action, error) {
 	if len(l) == 0 {
 		return nil, nil
@@ -161,6 +161,16 @@ func ResourceTargetGroup() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{
 					"round_robin",
 					"least_outstanding_requests",
+					"weighted_random",
+				}, false),
+			},
+			"load_balancing_anomaly_mitigation": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"on",
+					"off",
 				}, false),
 			},
 			"load_balancing_cross_zone_enabled": {
@@ -312,6 +322,63 @@ func ResourceTargetGroup() *schema.Resource {
 					},
 				},
 			},
+			"target_group_health": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_failover": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"minimum_healthy_targets_count": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "1",
+										ValidateFunc: validation.Any(
+											validation.StringInSlice([]string{"off"}, false),
+											validation.StringMatch(regexp.MustCompile(`^[1-9][0-9]*$`), "must be \"off\" or a positive integer"),
+										),
+									},
+									"minimum_healthy_targets_percentage": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "off",
+										ValidateFunc: validTargetGroupHealthPercentage,
+									},
+								},
+							},
+						},
+						"unhealthy_state_routing": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"minimum_healthy_targets_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"minimum_healthy_targets_percentage": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "off",
+										ValidateFunc: validTargetGroupHealthPercentage,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"target_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -482,6 +549,14 @@ func resourceTargetGroupCreate(ctx context.Context, d *schema.ResourceData, meta
 				Key:   aws.String("load_balancing.algorithm.type"),
 				Value: aws.String(v.(string)),
 			})
+
+			// Anomaly mitigation is only supported by the weighted random algorithm.
+			if v, ok := d.GetOk("load_balancing_anomaly_mitigation"); ok && d.Get("load_balancing_algorithm_type").(string) == "weighted_random" {
+				attrs = append(attrs, &elbv2.TargetGroupAttribute{
+					Key:   aws.String("load_balancing.algorithm.anomaly_mitigation"),
+					Value: aws.String(v.(string)),
+				})
+			}
 		}
 
 		if v, ok := d.GetOk("load_balancing_cross_zone_enabled"); ok {
@@ -519,6 +594,10 @@ func resourceTargetGroupCreate(ctx context.Context, d *schema.ResourceData, meta
 			})
 		}
 
+		if v, ok := d.GetOk("target_group_health"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			attrs = append(attrs, expandTargetGroupHealthAttributes(v.([]interface{})[0].(map[string]interface{}))...)
+		}
+
 		// Only supported for GWLB
 		if v, ok := d.Get("protocol").(string); ok && v == elbv2.ProtocolEnumGeneve {
 			if v, ok := d.GetOk("target_failover"); ok {
@@ -782,6 +861,21 @@ func resourceTargetGroupUpdate(ctx context.Context, d *schema.ResourceData, meta
 			})
 		}
 
+		if d.HasChanges("load_balancing_algorithm_type", "load_balancing_anomaly_mitigation") {
+			if v, ok := d.GetOk("load_balancing_anomaly_mitigation"); ok && d.Get("load_balancing_algorithm_type").(string) == "weighted_random" {
+				attrs = append(attrs, &elbv2.TargetGroupAttribute{
+					Key:   aws.String("load_balancing.algorithm.anomaly_mitigation"),
+					Value: aws.String(v.(string)),
+				})
+			}
+		}
+
+		if d.HasChange("target_group_health") {
+			if v := d.Get("target_group_health").([]interface{}); len(v) > 0 && v[0] != nil {
+				attrs = append(attrs, expandTargetGroupHealthAttributes(v[0].(map[string]interface{}))...)
+			}
+		}
+
 		if d.HasChange("load_balancing_cross_zone_enabled") {
 			attrs = append(attrs, &elbv2.TargetGroupAttribute{
 				Key:   aws.String("load_balancing.cross_zone.enabled"),
@@ -1080,6 +1174,8 @@ func flattenTargetGroupResource(ctx context.Context, d *schema.ResourceData, met
 		case "load_balancing.algorithm.type":
 			loadBalancingAlgorithm := aws.StringValue(attr.Value)
 			d.Set("load_balancing_algorithm_type", loadBalancingAlgorithm)
+		case "load_balancing.algorithm.anomaly_mitigation":
+			d.Set("load_balancing_anomaly_mitigation", attr.Value)
 		case "load_balancing.cross_zone.enabled":
 			loadBalancingCrossZoneEnabled := aws.StringValue(attr.Value)
 			d.Set("load_balancing_cross_zone_enabled", loadBalancingCrossZoneEnabled)
@@ -1111,9 +1207,90 @@ func flattenTargetGroupResource(ctx context.Context, d *schema.ResourceData, met
 		return fmt.Errorf("setting target failover: %w", err)
 	}
 
+	targetGroupHealthAttr, err := flattenTargetGroupHealth(attrResp.Attributes)
+	if err != nil {
+		return fmt.Errorf("flattening target group health: %w", err)
+	}
+
+	if err := d.Set("target_group_health", targetGroupHealthAttr); err != nil {
+		return fmt.Errorf("setting target_group_health: %w", err)
+	}
+
 	return nil
 }
 
+func expandTargetGroupHealthAttributes(tfMap map[string]interface{}) []*elbv2.TargetGroupAttribute {
+	var attrs []*elbv2.TargetGroupAttribute
+
+	if v, ok := tfMap["dns_failover"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		dnsFailover := v[0].(map[string]interface{})
+		attrs = append(attrs,
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String("target_group_health.dns_failover.minimum_healthy_targets.count"),
+				Value: aws.String(dnsFailover["minimum_healthy_targets_count"].(string)),
+			},
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String("target_group_health.dns_failover.minimum_healthy_targets.percentage"),
+				Value: aws.String(dnsFailover["minimum_healthy_targets_percentage"].(string)),
+			},
+		)
+	}
+
+	if v, ok := tfMap["unhealthy_state_routing"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		unhealthyStateRouting := v[0].(map[string]interface{})
+		attrs = append(attrs,
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String("target_group_health.unhealthy_state_routing.minimum_healthy_targets.count"),
+				Value: aws.String(strconv.Itoa(unhealthyStateRouting["minimum_healthy_targets_count"].(int))),
+			},
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String("target_group_health.unhealthy_state_routing.minimum_healthy_targets.percentage"),
+				Value: aws.String(unhealthyStateRouting["minimum_healthy_targets_percentage"].(string)),
+			},
+		)
+	}
+
+	return attrs
+}
+
+func flattenTargetGroupHealth(attributes []*elbv2.TargetGroupAttribute) ([]interface{}, error) {
+	dnsFailover := make(map[string]interface{})
+	unhealthyStateRouting := make(map[string]interface{})
+
+	for _, attr := range attributes {
+		switch aws.StringValue(attr.Key) {
+		case "target_group_health.dns_failover.minimum_healthy_targets.count":
+			dnsFailover["minimum_healthy_targets_count"] = aws.StringValue(attr.Value)
+		case "target_group_health.dns_failover.minimum_healthy_targets.percentage":
+			dnsFailover["minimum_healthy_targets_percentage"] = aws.StringValue(attr.Value)
+		case "target_group_health.unhealthy_state_routing.minimum_healthy_targets.count":
+			count, err := strconv.Atoi(aws.StringValue(attr.Value))
+			if err != nil {
+				return nil, fmt.Errorf("converting target_group_health.unhealthy_state_routing.minimum_healthy_targets.count to int: %s", aws.StringValue(attr.Value))
+			}
+			unhealthyStateRouting["minimum_healthy_targets_count"] = count
+		case "target_group_health.unhealthy_state_routing.minimum_healthy_targets.percentage":
+			unhealthyStateRouting["minimum_healthy_targets_percentage"] = aws.StringValue(attr.Value)
+		}
+	}
+
+	if len(dnsFailover) == 0 && len(unhealthyStateRouting) == 0 {
+		return []interface{}{}, nil
+	}
+
+	m := make(map[string]interface{})
+
+	if len(dnsFailover) > 0 {
+		m["dns_failover"] = []interface{}{dnsFailover}
+	}
+
+	if len(unhealthyStateRouting) > 0 {
+		m["unhealthy_state_routing"] = []interface{}{unhealthyStateRouting}
+	}
+
+	return []interface{}{m}, nil
+}
+
 func flattenTargetGroupFailover(attributes []*elbv2.TargetGroupAttribute) []interface{} {
 	if len(attributes) == 0 {
 		return []interface{}{}
@@ -1205,6 +1382,13 @@ func resourceTargetGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDi
 		}
 	}
 
+	// Anomaly mitigation can only be turned on for the weighted random algorithm.
+	if v := diff.GetRawConfig().GetAttr("load_balancing_anomaly_mitigation"); v.IsKnown() && !v.IsNull() && v.AsString() == "on" {
+		if v := diff.Get("load_balancing_algorithm_type").(string); v != "weighted_random" {
+			return fmt.Errorf(`load_balancing_anomaly_mitigation "on" requires load_balancing_algorithm_type "weighted_random", got %q`, v)
+		}
+	}
+
 	if diff.Id() == "" {
 		return nil
 	}
@@ -97,6 +97,10 @@ func DataSourceTargetGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"load_balancing_anomaly_mitigation": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"load_balancing_cross_zone_enabled": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -155,6 +159,46 @@ func DataSourceTargetGroup() *schema.Resource {
 				},
 			},
 			"tags": tftags.TagsSchemaComputed(),
+			"target_group_health": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_failover": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"minimum_healthy_targets_count": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"minimum_healthy_targets_percentage": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"unhealthy_state_routing": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"minimum_healthy_targets_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"minimum_healthy_targets_percentage": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"target_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -281,6 +325,8 @@ func dataSourceTargetGroupRead(ctx context.Context, d *schema.ResourceData, meta
 		case "load_balancing.algorithm.type":
 			loadBalancingAlgorithm := aws.StringValue(attr.Value)
 			d.Set("load_balancing_algorithm_type", loadBalancingAlgorithm)
+		case "load_balancing.algorithm.anomaly_mitigation":
+			d.Set("load_balancing_anomaly_mitigation", attr.Value)
 		case "load_balancing.cross_zone.enabled":
 			loadBalancingCrossZoneEnabled := aws.StringValue(attr.Value)
 			d.Set("load_balancing_cross_zone_enabled", loadBalancingCrossZoneEnabled)
@@ -302,6 +348,15 @@ func dataSourceTargetGroupRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "setting stickiness: %s", err)
 	}
 
+	targetGroupHealthAttr, err := flattenTargetGroupHealth(attrResp.Attributes)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "flattening target group health: %s", err)
+	}
+
+	if err := d.Set("target_group_health", targetGroupHealthAttr); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting target_group_health: %s", err)
+	}
+
 	tags, err := ListTags(ctx, conn, d.Id())
 
 	if verify.ErrorISOUnsupported(conn.PartitionID, err) {
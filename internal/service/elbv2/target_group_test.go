@@ -2165,6 +2165,82 @@ func TestAccELBV2TargetGroup_ALBAlias_updateLoadBalancingCrossZoneEnabled(t *tes
 	})
 }
 
+func TestAccELBV2TargetGroup_ALBAlias_updateLoadBalancingAnomalyMitigation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf elbv2.TargetGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_alb_target_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTargetGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetGroupConfig_albLoadBalancingAnomalyMitigation(rName, "weighted_random", "on"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetGroupExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "load_balancing_algorithm_type", "weighted_random"),
+					resource.TestCheckResourceAttr(resourceName, "load_balancing_anomaly_mitigation", "on"),
+				),
+			},
+			{
+				Config: testAccTargetGroupConfig_albLoadBalancingAnomalyMitigation(rName, "weighted_random", "off"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetGroupExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "load_balancing_algorithm_type", "weighted_random"),
+					resource.TestCheckResourceAttr(resourceName, "load_balancing_anomaly_mitigation", "off"),
+				),
+			},
+			{
+				Config:      testAccTargetGroupConfig_albLoadBalancingAnomalyMitigation(rName, "round_robin", "on"),
+				ExpectError: regexp.MustCompile(`requires load_balancing_algorithm_type "weighted_random"`),
+			},
+		},
+	})
+}
+
+func TestAccELBV2TargetGroup_ALBAlias_updateTargetGroupHealth(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf elbv2.TargetGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_alb_target_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTargetGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetGroupConfig_albTargetGroupHealth(rName, "off", "50", 1, "off"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetGroupExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.dns_failover.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.dns_failover.0.minimum_healthy_targets_count", "off"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.dns_failover.0.minimum_healthy_targets_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.unhealthy_state_routing.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.unhealthy_state_routing.0.minimum_healthy_targets_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.unhealthy_state_routing.0.minimum_healthy_targets_percentage", "off"),
+				),
+			},
+			{
+				Config: testAccTargetGroupConfig_albTargetGroupHealth(rName, "2", "off", 3, "25"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetGroupExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.dns_failover.0.minimum_healthy_targets_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.dns_failover.0.minimum_healthy_targets_percentage", "off"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.unhealthy_state_routing.0.minimum_healthy_targets_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.unhealthy_state_routing.0.minimum_healthy_targets_percentage", "25"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccELBV2TargetGroup_ALBAlias_updateStickinessEnabled(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf elbv2.TargetGroup
@@ -3581,6 +3657,57 @@ resource "aws_vpc" "test" {
 }`, rName, crossZoneParam)
 }
 
+func testAccTargetGroupConfig_albLoadBalancingAnomalyMitigation(rName, algoType, anomalyMitigation string) string {
+	return fmt.Sprintf(`
+resource "aws_alb_target_group" "test" {
+  name     = %[1]q
+  port     = 443
+  protocol = "HTTPS"
+  vpc_id   = aws_vpc.test.id
+
+  load_balancing_algorithm_type     = %[2]q
+  load_balancing_anomaly_mitigation = %[3]q
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}`, rName, algoType, anomalyMitigation)
+}
+
+func testAccTargetGroupConfig_albTargetGroupHealth(rName, dnsFailoverCount, dnsFailoverPercentage string, unhealthyStateRoutingCount int, unhealthyStateRoutingPercentage string) string {
+	return fmt.Sprintf(`
+resource "aws_alb_target_group" "test" {
+  name     = %[1]q
+  port     = 443
+  protocol = "HTTPS"
+  vpc_id   = aws_vpc.test.id
+
+  target_group_health {
+    dns_failover {
+      minimum_healthy_targets_count      = %[2]q
+      minimum_healthy_targets_percentage = %[3]q
+    }
+
+    unhealthy_state_routing {
+      minimum_healthy_targets_count      = %[4]d
+      minimum_healthy_targets_percentage = %[5]q
+    }
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}`, rName, dnsFailoverCount, dnsFailoverPercentage, unhealthyStateRoutingCount, unhealthyStateRoutingPercentage)
+}
+
 func testAccTargetGroupConfig_albMissingPort(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_alb_target_group" "test" {
@@ -0,0 +1,317 @@
+package elbv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_lb_trust_store", name="Trust Store")
+// @Tags(identifierAttribute="id")
+func ResourceTrustStore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTrustStoreCreate,
+		ReadWithoutTimeout:   resourceTrustStoreRead,
+		UpdateWithoutTimeout: resourceTrustStoreUpdate,
+		DeleteWithoutTimeout: resourceTrustStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn_suffix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ca_certificates_bundle_s3_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ca_certificates_bundle_s3_key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ca_certificates_bundle_s3_object_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validTargetGroupName,
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validTargetGroupNamePrefix,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceTrustStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		name = id.PrefixedUniqueId(v.(string))
+	} else {
+		name = id.PrefixedUniqueId("tf-")
+	}
+
+	input := &elbv2.CreateTrustStoreInput{
+		CaCertificatesBundleS3Bucket: aws.String(d.Get("ca_certificates_bundle_s3_bucket").(string)),
+		CaCertificatesBundleS3Key:    aws.String(d.Get("ca_certificates_bundle_s3_key").(string)),
+		Name:                         aws.String(name),
+		Tags:                         GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("ca_certificates_bundle_s3_object_version"); ok {
+		input.CaCertificatesBundleS3ObjectVersion = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateTrustStoreWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ELBv2 Trust Store (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.TrustStores[0].TrustStoreArn))
+
+	if _, err := waitTrustStoreActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ELBv2 Trust Store (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTrustStoreRead(ctx, d, meta)...)
+}
+
+func resourceTrustStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
+
+	trustStore, err := FindTrustStoreByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ELBv2 Trust Store %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELBv2 Trust Store (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", trustStore.TrustStoreArn)
+	d.Set("arn_suffix", TrustStoreSuffixFromARN(trustStore.TrustStoreArn))
+	d.Set("name", trustStore.Name)
+
+	return diags
+}
+
+func resourceTrustStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
+
+	if d.HasChanges("ca_certificates_bundle_s3_bucket", "ca_certificates_bundle_s3_key", "ca_certificates_bundle_s3_object_version") {
+		input := &elbv2.ModifyTrustStoreInput{
+			CaCertificatesBundleS3Bucket: aws.String(d.Get("ca_certificates_bundle_s3_bucket").(string)),
+			CaCertificatesBundleS3Key:    aws.String(d.Get("ca_certificates_bundle_s3_key").(string)),
+			TrustStoreArn:                aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("ca_certificates_bundle_s3_object_version"); ok {
+			input.CaCertificatesBundleS3ObjectVersion = aws.String(v.(string))
+		}
+
+		_, err := conn.ModifyTrustStoreWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying ELBv2 Trust Store (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTrustStoreRead(ctx, d, meta)...)
+}
+
+func resourceTrustStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
+
+	log.Printf("[DEBUG] Deleting ELBv2 Trust Store: %s", d.Id())
+	// A trust store cannot be deleted while a listener that was just destroyed still references it.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteTrustStoreWithContext(ctx, &elbv2.DeleteTrustStoreInput{
+			TrustStoreArn: aws.String(d.Id()),
+		})
+	}, elbv2.ErrCodeTrustStoreInUseException)
+
+	if tfawserr.ErrCodeEquals(err, elbv2.ErrCodeTrustStoreNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ELBv2 Trust Store (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindTrustStoreByARN(ctx context.Context, conn *elbv2.ELBV2, arn string) (*elbv2.TrustStore, error) {
+	input := &elbv2.DescribeTrustStoresInput{
+		TrustStoreArns: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := findTrustStore(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.TrustStoreArn) != arn {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findTrustStores(ctx context.Context, conn *elbv2.ELBV2, input *elbv2.DescribeTrustStoresInput) ([]*elbv2.TrustStore, error) {
+	var output []*elbv2.TrustStore
+
+	err := conn.DescribeTrustStoresPagesWithContext(ctx, input, func(page *elbv2.DescribeTrustStoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TrustStores {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, elbv2.ErrCodeTrustStoreNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findTrustStore(ctx context.Context, conn *elbv2.ELBV2, input *elbv2.DescribeTrustStoresInput) (*elbv2.TrustStore, error) {
+	output, err := findTrustStores(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func statusTrustStore(ctx context.Context, conn *elbv2.ELBV2, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTrustStoreByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitTrustStoreActive(ctx context.Context, conn *elbv2.ELBV2, arn string, timeout time.Duration) (*elbv2.TrustStore, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{elbv2.TrustStoreStatusCreating},
+		Target:     []string{elbv2.TrustStoreStatusActive},
+		Refresh:    statusTrustStore(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*elbv2.TrustStore); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func TrustStoreSuffixFromARN(arn *string) string {
+	if arn == nil {
+		return ""
+	}
+
+	if arnComponents := regexp.MustCompile(`arn:.*:truststore/(.*)`).FindAllStringSubmatch(*arn, -1); len(arnComponents) == 1 {
+		if len(arnComponents[0]) == 2 {
+			return fmt.Sprintf("truststore/%s", arnComponents[0][1])
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,215 @@
+package elbv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_lb_trust_store_revocation", name="Trust Store Revocation")
+func ResourceTrustStoreRevocation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTrustStoreRevocationCreate,
+		ReadWithoutTimeout:   resourceTrustStoreRevocationRead,
+		DeleteWithoutTimeout: resourceTrustStoreRevocationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revocation_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"revocations_s3_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"revocations_s3_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"revocations_s3_object_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"trust_store_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceTrustStoreRevocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
+
+	trustStoreARN := d.Get("trust_store_arn").(string)
+	revocationContent := &elbv2.RevocationContent{
+		S3Bucket: aws.String(d.Get("revocations_s3_bucket").(string)),
+		S3Key:    aws.String(d.Get("revocations_s3_key").(string)),
+	}
+
+	if v, ok := d.GetOk("revocations_s3_object_version"); ok {
+		revocationContent.S3ObjectVersion = aws.String(v.(string))
+	}
+
+	input := &elbv2.AddTrustStoreRevocationsInput{
+		RevocationContents: []*elbv2.RevocationContent{revocationContent},
+		TrustStoreArn:      aws.String(trustStoreARN),
+	}
+
+	output, err := conn.AddTrustStoreRevocationsWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "adding ELBv2 Trust Store (%s) revocation: %s", trustStoreARN, err)
+	}
+
+	if output == nil || len(output.TrustStoreRevocations) == 0 || output.TrustStoreRevocations[0] == nil {
+		return sdkdiag.AppendErrorf(diags, "adding ELBv2 Trust Store (%s) revocation: empty response", trustStoreARN)
+	}
+
+	d.SetId(TrustStoreRevocationCreateResourceID(trustStoreARN, aws.Int64Value(output.TrustStoreRevocations[0].RevocationId)))
+
+	return append(diags, resourceTrustStoreRevocationRead(ctx, d, meta)...)
+}
+
+func resourceTrustStoreRevocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
+
+	trustStoreARN, revocationID, err := TrustStoreRevocationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	revocation, err := FindTrustStoreRevocationByTwoPartKey(ctx, conn, trustStoreARN, revocationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ELBv2 Trust Store Revocation %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELBv2 Trust Store Revocation (%s): %s", d.Id(), err)
+	}
+
+	d.Set("revocation_id", revocation.RevocationId)
+	d.Set("trust_store_arn", revocation.TrustStoreArn)
+
+	return diags
+}
+
+func resourceTrustStoreRevocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
+
+	trustStoreARN, revocationID, err := TrustStoreRevocationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting ELBv2 Trust Store Revocation: %s", d.Id())
+	_, err = conn.RemoveTrustStoreRevocationsWithContext(ctx, &elbv2.RemoveTrustStoreRevocationsInput{
+		RevocationIds: aws.Int64Slice([]int64{revocationID}),
+		TrustStoreArn: aws.String(trustStoreARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, elbv2.ErrCodeRevocationIdNotFoundException, elbv2.ErrCodeTrustStoreNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ELBv2 Trust Store Revocation (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const trustStoreRevocationIDSeparator = ","
+
+func TrustStoreRevocationCreateResourceID(trustStoreARN string, revocationID int64) string {
+	return strings.Join([]string{trustStoreARN, strconv.FormatInt(revocationID, 10)}, trustStoreRevocationIDSeparator)
+}
+
+func TrustStoreRevocationParseResourceID(id string) (string, int64, error) {
+	parts := strings.Split(id, trustStoreRevocationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		revocationID, err := strconv.ParseInt(parts[1], 10, 64)
+
+		if err != nil {
+			return "", 0, fmt.Errorf("parsing revocation ID in ID (%s): %w", id, err)
+		}
+
+		return parts[0], revocationID, nil
+	}
+
+	return "", 0, fmt.Errorf("unexpected format for ID (%[1]s), expected trust-store-arn%[2]srevocation-id", id, trustStoreRevocationIDSeparator)
+}
+
+func FindTrustStoreRevocationByTwoPartKey(ctx context.Context, conn *elbv2.ELBV2, trustStoreARN string, revocationID int64) (*elbv2.DescribeTrustStoreRevocation, error) {
+	input := &elbv2.DescribeTrustStoreRevocationsInput{
+		RevocationIds: aws.Int64Slice([]int64{revocationID}),
+		TrustStoreArn: aws.String(trustStoreARN),
+	}
+	var output []*elbv2.DescribeTrustStoreRevocation
+
+	err := conn.DescribeTrustStoreRevocationsPagesWithContext(ctx, input, func(page *elbv2.DescribeTrustStoreRevocationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TrustStoreRevocations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, elbv2.ErrCodeRevocationIdNotFoundException, elbv2.ErrCodeTrustStoreNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
@@ -96,3 +96,15 @@ func validTargetGroupNamePrefix(v interface{}, k string) (ws []string, errors []
 	}
 	return
 }
+
+func validTargetGroupHealthPercentage(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "off" {
+		return
+	}
+	if !regexp.MustCompile(`^([1-9][0-9]?|100)$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be \"off\" or an integer between 1 and 100: %q", k, value))
+	}
+	return
+}
@@ -124,3 +124,43 @@ func TestValidTargetGroupNamePrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidTargetGroupHealthPercentage(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "off",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "100",
+			ErrCount: 0,
+		},
+		{
+			Value:    "0",
+			ErrCount: 1,
+		},
+		{
+			Value:    "101",
+			ErrCount: 1,
+		},
+		{
+			Value:    "on",
+			ErrCount: 1,
+		},
+	}
+	for _, tc := range cases {
+		_, errors := validTargetGroupHealthPercentage(tc.Value, "minimum_healthy_targets_percentage")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
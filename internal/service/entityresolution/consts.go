@@ -0,0 +1,9 @@
+package entityresolution
+
+import (
+	"time"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
@@ -0,0 +1,473 @@
+package entityresolution
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_entityresolution_id_mapping_workflow", name="ID Mapping Workflow")
+// @Tags(identifierAttribute="arn")
+func ResourceIDMappingWorkflow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIDMappingWorkflowCreate,
+		ReadWithoutTimeout:   resourceIDMappingWorkflowRead,
+		UpdateWithoutTimeout: resourceIDMappingWorkflowUpdate,
+		DeleteWithoutTimeout: resourceIDMappingWorkflowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"id_mapping_techniques": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id_mapping_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.IdMappingType_Values(), false),
+						},
+						"provider_properties": providerPropertiesSchema(),
+						"rule_based_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_matching_model": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(entityresolution.AttributeMatchingModel_Values(), false),
+									},
+									"record_matching_model": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(entityresolution.RecordMatchingModel_Values(), false),
+									},
+									"rule_definition_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(entityresolution.IdMappingWorkflowRuleDefinitionType_Values(), false),
+									},
+									"rules": rulesSchema(false),
+								},
+							},
+						},
+					},
+				},
+			},
+			"input_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_source_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.IdNamespaceType_Values(), false),
+						},
+					},
+				},
+			},
+			"output_source_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"output_s3_path": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 1024),
+								validation.StringMatch(regexp.MustCompile(`^s3://`), "must be an S3 URI"),
+							),
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"workflow_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_0-9-]*$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceIDMappingWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	name := d.Get("workflow_name").(string)
+	input := &entityresolution.CreateIdMappingWorkflowInput{
+		IdMappingTechniques: expandIDMappingTechniques(d.Get("id_mapping_techniques").([]interface{})),
+		InputSourceConfig:   expandIDMappingWorkflowInputSources(d.Get("input_source_config").([]interface{})),
+		Tags:                GetTagsIn(ctx),
+		WorkflowName:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("output_source_config"); ok {
+		input.OutputSourceConfig = expandIDMappingWorkflowOutputSources(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	// The IAM role may not yet be assumable by Entity Resolution.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateIdMappingWorkflowWithContext(ctx, input)
+	}, entityresolution.ErrCodeAccessDeniedException)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Entity Resolution ID Mapping Workflow (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceIDMappingWorkflowRead(ctx, d, meta)...)
+}
+
+func resourceIDMappingWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	output, err := FindIDMappingWorkflowByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution ID Mapping Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Entity Resolution ID Mapping Workflow (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.WorkflowArn)
+	d.Set("description", output.Description)
+	if err := d.Set("id_mapping_techniques", flattenIDMappingTechniques(output.IdMappingTechniques)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting id_mapping_techniques: %s", err)
+	}
+	if err := d.Set("input_source_config", flattenIDMappingWorkflowInputSources(output.InputSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting input_source_config: %s", err)
+	}
+	if err := d.Set("output_source_config", flattenIDMappingWorkflowOutputSources(output.OutputSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_source_config: %s", err)
+	}
+	d.Set("role_arn", output.RoleArn)
+	d.Set("workflow_name", output.WorkflowName)
+
+	return diags
+}
+
+func resourceIDMappingWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &entityresolution.UpdateIdMappingWorkflowInput{
+			IdMappingTechniques: expandIDMappingTechniques(d.Get("id_mapping_techniques").([]interface{})),
+			InputSourceConfig:   expandIDMappingWorkflowInputSources(d.Get("input_source_config").([]interface{})),
+			WorkflowName:        aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("output_source_config"); ok {
+			input.OutputSourceConfig = expandIDMappingWorkflowOutputSources(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("role_arn"); ok {
+			input.RoleArn = aws.String(v.(string))
+		}
+
+		// A workflow cannot be updated while one of its jobs is running.
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			return conn.UpdateIdMappingWorkflowWithContext(ctx, input)
+		}, entityresolution.ErrCodeConflictException)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Entity Resolution ID Mapping Workflow (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceIDMappingWorkflowRead(ctx, d, meta)...)
+}
+
+func resourceIDMappingWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	log.Printf("[INFO] Deleting Entity Resolution ID Mapping Workflow: %s", d.Id())
+	// A workflow cannot be deleted while one of its jobs is running.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteIdMappingWorkflowWithContext(ctx, &entityresolution.DeleteIdMappingWorkflowInput{
+			WorkflowName: aws.String(d.Id()),
+		})
+	}, entityresolution.ErrCodeConflictException)
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Entity Resolution ID Mapping Workflow (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindIDMappingWorkflowByName(ctx context.Context, conn *entityresolution.EntityResolution, name string) (*entityresolution.GetIdMappingWorkflowOutput, error) {
+	input := &entityresolution.GetIdMappingWorkflowInput{
+		WorkflowName: aws.String(name),
+	}
+
+	output, err := conn.GetIdMappingWorkflowWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandIDMappingTechniques(tfList []interface{}) *entityresolution.IdMappingTechniques {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &entityresolution.IdMappingTechniques{}
+
+	if v, ok := tfMap["id_mapping_type"].(string); ok && v != "" {
+		apiObject.IdMappingType = aws.String(v)
+	}
+
+	if v, ok := tfMap["provider_properties"].([]interface{}); ok {
+		apiObject.ProviderProperties = expandProviderProperties(v)
+	}
+
+	if v, ok := tfMap["rule_based_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		ruleBasedProperties := &entityresolution.IdMappingRuleBasedProperties{}
+
+		if v, ok := tfMap["attribute_matching_model"].(string); ok && v != "" {
+			ruleBasedProperties.AttributeMatchingModel = aws.String(v)
+		}
+
+		if v, ok := tfMap["record_matching_model"].(string); ok && v != "" {
+			ruleBasedProperties.RecordMatchingModel = aws.String(v)
+		}
+
+		if v, ok := tfMap["rule_definition_type"].(string); ok && v != "" {
+			ruleBasedProperties.RuleDefinitionType = aws.String(v)
+		}
+
+		if v, ok := tfMap["rules"].([]interface{}); ok && len(v) > 0 {
+			ruleBasedProperties.Rules = expandRules(v)
+		}
+
+		apiObject.RuleBasedProperties = ruleBasedProperties
+	}
+
+	return apiObject
+}
+
+func expandIDMappingWorkflowInputSources(tfList []interface{}) []*entityresolution.IdMappingWorkflowInputSource {
+	var apiObjects []*entityresolution.IdMappingWorkflowInputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.IdMappingWorkflowInputSource{}
+
+		if v, ok := tfMap["input_source_arn"].(string); ok && v != "" {
+			apiObject.InputSourceARN = aws.String(v)
+		}
+
+		if v, ok := tfMap["schema_name"].(string); ok && v != "" {
+			apiObject.SchemaName = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIDMappingWorkflowOutputSources(tfList []interface{}) []*entityresolution.IdMappingWorkflowOutputSource {
+	var apiObjects []*entityresolution.IdMappingWorkflowOutputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.IdMappingWorkflowOutputSource{}
+
+		if v, ok := tfMap["kms_arn"].(string); ok && v != "" {
+			apiObject.KMSArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["output_s3_path"].(string); ok && v != "" {
+			apiObject.OutputS3Path = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIDMappingTechniques(apiObject *entityresolution.IdMappingTechniques) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"id_mapping_type":     aws.StringValue(apiObject.IdMappingType),
+		"provider_properties": flattenProviderProperties(apiObject.ProviderProperties),
+	}
+
+	if v := apiObject.RuleBasedProperties; v != nil {
+		tfMap["rule_based_properties"] = []interface{}{map[string]interface{}{
+			"attribute_matching_model": aws.StringValue(v.AttributeMatchingModel),
+			"record_matching_model":    aws.StringValue(v.RecordMatchingModel),
+			"rule_definition_type":     aws.StringValue(v.RuleDefinitionType),
+			"rules":                    flattenRules(v.Rules),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIDMappingWorkflowInputSources(apiObjects []*entityresolution.IdMappingWorkflowInputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"input_source_arn": aws.StringValue(apiObject.InputSourceARN),
+			"schema_name":      aws.StringValue(apiObject.SchemaName),
+			"type":             aws.StringValue(apiObject.Type),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenIDMappingWorkflowOutputSources(apiObjects []*entityresolution.IdMappingWorkflowOutputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"kms_arn":        aws.StringValue(apiObject.KMSArn),
+			"output_s3_path": aws.StringValue(apiObject.OutputS3Path),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,241 @@
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEntityResolutionIDMappingWorkflow_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetIdMappingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_id_mapping_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, entityresolution.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDMappingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDMappingWorkflowConfig_basic(rName, "rule1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIDMappingWorkflowExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "entityresolution", regexp.MustCompile(`idmappingworkflow/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.id_mapping_type", entityresolution.IdMappingTypeRuleBased),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.rule_based_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.rule_based_properties.0.attribute_matching_model", entityresolution.AttributeMatchingModelOneToOne),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.rule_based_properties.0.record_matching_model", entityresolution.RecordMatchingModelOneSourceToOneTarget),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.rule_based_properties.0.rule_definition_type", entityresolution.IdMappingWorkflowRuleDefinitionTypeSource),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.rule_based_properties.0.rules.0.rule_name", "rule1"),
+					resource.TestCheckResourceAttr(resourceName, "input_source_config.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "input_source_config.0.type", entityresolution.IdNamespaceTypeSource),
+					resource.TestCheckResourceAttr(resourceName, "input_source_config.1.type", entityresolution.IdNamespaceTypeTarget),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "workflow_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionIDMappingWorkflow_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetIdMappingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_id_mapping_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, entityresolution.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDMappingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDMappingWorkflowConfig_basic(rName, "rule1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingWorkflowExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfentityresolution.ResourceIDMappingWorkflow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionIDMappingWorkflow_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetIdMappingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_id_mapping_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, entityresolution.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDMappingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDMappingWorkflowConfig_basic(rName, "rule1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingWorkflowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.rule_based_properties.0.rules.0.rule_name", "rule1"),
+				),
+			},
+			{
+				Config: testAccIDMappingWorkflowConfig_basic(rName, "rule2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingWorkflowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.rule_based_properties.0.rules.0.rule_name", "rule2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIDMappingWorkflowDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_entityresolution_id_mapping_workflow" {
+				continue
+			}
+
+			_, err := tfentityresolution.FindIDMappingWorkflowByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Entity Resolution ID Mapping Workflow %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIDMappingWorkflowExists(ctx context.Context, n string, v *entityresolution.GetIdMappingWorkflowOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Entity Resolution ID Mapping Workflow ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionConn()
+
+		output, err := tfentityresolution.FindIDMappingWorkflowByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccIDMappingWorkflowConfig_basic(rName, ruleName string) string {
+	return acctest.ConfigCompose(testAccWorkflowConfigBase(rName), fmt.Sprintf(`
+resource "aws_glue_catalog_table" "target" {
+  name          = replace("%[1]s-target", "-", "_")
+  database_name = aws_glue_catalog_database.test.name
+  table_type    = "EXTERNAL_TABLE"
+
+  storage_descriptor {
+    location      = "s3://${aws_s3_bucket.test.bucket}/target/"
+    input_format  = "org.apache.hadoop.mapred.TextInputFormat"
+    output_format = "org.apache.hadoop.hive.ql.io.HiveIgnoreKeyTextOutputFormat"
+
+    ser_de_info {
+      serialization_library = "org.apache.hadoop.hive.serde2.lazy.LazySimpleSerDe"
+
+      parameters = {
+        "field.delim" = ","
+      }
+    }
+
+    columns {
+      name = "id"
+      type = "string"
+    }
+
+    columns {
+      name = "email"
+      type = "string"
+    }
+
+    columns {
+      name = "name"
+      type = "string"
+    }
+  }
+}
+
+resource "aws_entityresolution_id_mapping_workflow" "test" {
+  workflow_name = %[1]q
+  role_arn      = aws_iam_role.test.arn
+
+  id_mapping_techniques {
+    id_mapping_type = "RULE_BASED"
+
+    rule_based_properties {
+      attribute_matching_model = "ONE_TO_ONE"
+      record_matching_model    = "ONE_SOURCE_TO_ONE_TARGET"
+      rule_definition_type     = "SOURCE"
+
+      rules {
+        rule_name     = %[2]q
+        matching_keys = ["email"]
+      }
+    }
+  }
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.schema_name
+    type             = "SOURCE"
+  }
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.target.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.schema_name
+    type             = "TARGET"
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, ruleName))
+}
@@ -0,0 +1,722 @@
+package entityresolution
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_entityresolution_matching_workflow", name="Matching Workflow")
+// @Tags(identifierAttribute="arn")
+func ResourceMatchingWorkflow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMatchingWorkflowCreate,
+		ReadWithoutTimeout:   resourceMatchingWorkflowRead,
+		UpdateWithoutTimeout: resourceMatchingWorkflowUpdate,
+		DeleteWithoutTimeout: resourceMatchingWorkflowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"incremental_run_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"incremental_run_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.IncrementalRunType_Values(), false),
+						},
+					},
+				},
+			},
+			"input_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_normalization": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"input_source_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"output_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_normalization": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"kms_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"output": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 750,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hashed": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"output_s3_path": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 1024),
+								validation.StringMatch(regexp.MustCompile(`^s3://`), "must be an S3 URI"),
+							),
+						},
+					},
+				},
+			},
+			"resolution_techniques": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider_properties": providerPropertiesSchema(),
+						"resolution_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.ResolutionType_Values(), false),
+						},
+						"rule_based_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_matching_model": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(entityresolution.AttributeMatchingModel_Values(), false),
+									},
+									"match_purpose": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(entityresolution.MatchPurpose_Values(), false),
+									},
+									"rules": rulesSchema(true),
+								},
+							},
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"workflow_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_0-9-]*$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func providerPropertiesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"intermediate_source_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"intermediate_s3_path": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+				"provider_service_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func rulesSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		MinItems: 1,
+		MaxItems: 25,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"matching_keys": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					MaxItems: 15,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"rule_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+			},
+		},
+	}
+}
+
+func resourceMatchingWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	name := d.Get("workflow_name").(string)
+	input := &entityresolution.CreateMatchingWorkflowInput{
+		InputSourceConfig:    expandInputSources(d.Get("input_source_config").([]interface{})),
+		OutputSourceConfig:   expandOutputSources(d.Get("output_source_config").([]interface{})),
+		ResolutionTechniques: expandResolutionTechniques(d.Get("resolution_techniques").([]interface{})),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+		Tags:                 GetTagsIn(ctx),
+		WorkflowName:         aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("incremental_run_config"); ok {
+		input.IncrementalRunConfig = expandIncrementalRunConfig(v.([]interface{}))
+	}
+
+	// The IAM role may not yet be assumable by Entity Resolution.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateMatchingWorkflowWithContext(ctx, input)
+	}, entityresolution.ErrCodeAccessDeniedException)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Entity Resolution Matching Workflow (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceMatchingWorkflowRead(ctx, d, meta)...)
+}
+
+func resourceMatchingWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	output, err := FindMatchingWorkflowByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution Matching Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.WorkflowArn)
+	d.Set("description", output.Description)
+	if err := d.Set("incremental_run_config", flattenIncrementalRunConfig(output.IncrementalRunConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting incremental_run_config: %s", err)
+	}
+	if err := d.Set("input_source_config", flattenInputSources(output.InputSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting input_source_config: %s", err)
+	}
+	if err := d.Set("output_source_config", flattenOutputSources(output.OutputSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_source_config: %s", err)
+	}
+	if err := d.Set("resolution_techniques", flattenResolutionTechniques(output.ResolutionTechniques)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resolution_techniques: %s", err)
+	}
+	d.Set("role_arn", output.RoleArn)
+	d.Set("workflow_name", output.WorkflowName)
+
+	return diags
+}
+
+func resourceMatchingWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &entityresolution.UpdateMatchingWorkflowInput{
+			InputSourceConfig:    expandInputSources(d.Get("input_source_config").([]interface{})),
+			OutputSourceConfig:   expandOutputSources(d.Get("output_source_config").([]interface{})),
+			ResolutionTechniques: expandResolutionTechniques(d.Get("resolution_techniques").([]interface{})),
+			RoleArn:              aws.String(d.Get("role_arn").(string)),
+			WorkflowName:         aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("incremental_run_config"); ok {
+			input.IncrementalRunConfig = expandIncrementalRunConfig(v.([]interface{}))
+		}
+
+		// A workflow cannot be updated while one of its jobs is running.
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			return conn.UpdateMatchingWorkflowWithContext(ctx, input)
+		}, entityresolution.ErrCodeConflictException)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMatchingWorkflowRead(ctx, d, meta)...)
+}
+
+func resourceMatchingWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	log.Printf("[INFO] Deleting Entity Resolution Matching Workflow: %s", d.Id())
+	// A workflow cannot be deleted while one of its jobs is running.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteMatchingWorkflowWithContext(ctx, &entityresolution.DeleteMatchingWorkflowInput{
+			WorkflowName: aws.String(d.Id()),
+		})
+	}, entityresolution.ErrCodeConflictException)
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindMatchingWorkflowByName(ctx context.Context, conn *entityresolution.EntityResolution, name string) (*entityresolution.GetMatchingWorkflowOutput, error) {
+	input := &entityresolution.GetMatchingWorkflowInput{
+		WorkflowName: aws.String(name),
+	}
+
+	output, err := conn.GetMatchingWorkflowWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandIncrementalRunConfig(tfList []interface{}) *entityresolution.IncrementalRunConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &entityresolution.IncrementalRunConfig{}
+
+	if v, ok := tfMap["incremental_run_type"].(string); ok && v != "" {
+		apiObject.IncrementalRunType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandInputSources(tfList []interface{}) []*entityresolution.InputSource {
+	var apiObjects []*entityresolution.InputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.InputSource{}
+
+		if v, ok := tfMap["apply_normalization"].(bool); ok && v {
+			apiObject.ApplyNormalization = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["input_source_arn"].(string); ok && v != "" {
+			apiObject.InputSourceARN = aws.String(v)
+		}
+
+		if v, ok := tfMap["schema_name"].(string); ok && v != "" {
+			apiObject.SchemaName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputSources(tfList []interface{}) []*entityresolution.OutputSource {
+	var apiObjects []*entityresolution.OutputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.OutputSource{}
+
+		if v, ok := tfMap["apply_normalization"].(bool); ok && v {
+			apiObject.ApplyNormalization = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["kms_arn"].(string); ok && v != "" {
+			apiObject.KMSArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["output"].([]interface{}); ok {
+			apiObject.Output = expandOutputAttributes(v)
+		}
+
+		if v, ok := tfMap["output_s3_path"].(string); ok && v != "" {
+			apiObject.OutputS3Path = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputAttributes(tfList []interface{}) []*entityresolution.OutputAttribute {
+	apiObjects := []*entityresolution.OutputAttribute{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.OutputAttribute{}
+
+		if v, ok := tfMap["hashed"].(bool); ok && v {
+			apiObject.Hashed = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandResolutionTechniques(tfList []interface{}) *entityresolution.ResolutionTechniques {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &entityresolution.ResolutionTechniques{}
+
+	if v, ok := tfMap["provider_properties"].([]interface{}); ok {
+		apiObject.ProviderProperties = expandProviderProperties(v)
+	}
+
+	if v, ok := tfMap["resolution_type"].(string); ok && v != "" {
+		apiObject.ResolutionType = aws.String(v)
+	}
+
+	if v, ok := tfMap["rule_based_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		ruleBasedProperties := &entityresolution.RuleBasedProperties{}
+
+		if v, ok := tfMap["attribute_matching_model"].(string); ok && v != "" {
+			ruleBasedProperties.AttributeMatchingModel = aws.String(v)
+		}
+
+		if v, ok := tfMap["match_purpose"].(string); ok && v != "" {
+			ruleBasedProperties.MatchPurpose = aws.String(v)
+		}
+
+		if v, ok := tfMap["rules"].([]interface{}); ok {
+			ruleBasedProperties.Rules = expandRules(v)
+		}
+
+		apiObject.RuleBasedProperties = ruleBasedProperties
+	}
+
+	return apiObject
+}
+
+func expandProviderProperties(tfList []interface{}) *entityresolution.ProviderProperties {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &entityresolution.ProviderProperties{}
+
+	if v, ok := tfMap["intermediate_source_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.IntermediateSourceConfiguration = &entityresolution.IntermediateSourceConfiguration{
+			IntermediateS3Path: aws.String(tfMap["intermediate_s3_path"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["provider_service_arn"].(string); ok && v != "" {
+		apiObject.ProviderServiceArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRules(tfList []interface{}) []*entityresolution.Rule {
+	var apiObjects []*entityresolution.Rule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.Rule{}
+
+		if v, ok := tfMap["matching_keys"].([]interface{}); ok && len(v) > 0 {
+			apiObject.MatchingKeys = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["rule_name"].(string); ok && v != "" {
+			apiObject.RuleName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIncrementalRunConfig(apiObject *entityresolution.IncrementalRunConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"incremental_run_type": aws.StringValue(apiObject.IncrementalRunType),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInputSources(apiObjects []*entityresolution.InputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"apply_normalization": aws.BoolValue(apiObject.ApplyNormalization),
+			"input_source_arn":    aws.StringValue(apiObject.InputSourceARN),
+			"schema_name":         aws.StringValue(apiObject.SchemaName),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenOutputSources(apiObjects []*entityresolution.OutputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"apply_normalization": aws.BoolValue(apiObject.ApplyNormalization),
+			"kms_arn":             aws.StringValue(apiObject.KMSArn),
+			"output":              flattenOutputAttributes(apiObject.Output),
+			"output_s3_path":      aws.StringValue(apiObject.OutputS3Path),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenOutputAttributes(apiObjects []*entityresolution.OutputAttribute) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"hashed": aws.BoolValue(apiObject.Hashed),
+			"name":   aws.StringValue(apiObject.Name),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenResolutionTechniques(apiObject *entityresolution.ResolutionTechniques) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"provider_properties": flattenProviderProperties(apiObject.ProviderProperties),
+		"resolution_type":     aws.StringValue(apiObject.ResolutionType),
+	}
+
+	if v := apiObject.RuleBasedProperties; v != nil {
+		tfMap["rule_based_properties"] = []interface{}{map[string]interface{}{
+			"attribute_matching_model": aws.StringValue(v.AttributeMatchingModel),
+			"match_purpose":            aws.StringValue(v.MatchPurpose),
+			"rules":                    flattenRules(v.Rules),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenProviderProperties(apiObject *entityresolution.ProviderProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"provider_service_arn": aws.StringValue(apiObject.ProviderServiceArn),
+	}
+
+	if v := apiObject.IntermediateSourceConfiguration; v != nil {
+		tfMap["intermediate_source_configuration"] = []interface{}{map[string]interface{}{
+			"intermediate_s3_path": aws.StringValue(v.IntermediateS3Path),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRules(apiObjects []*entityresolution.Rule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"matching_keys": aws.StringValueSlice(apiObject.MatchingKeys),
+			"rule_name":     aws.StringValue(apiObject.RuleName),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
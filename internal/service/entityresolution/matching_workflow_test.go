@@ -0,0 +1,516 @@
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEntityResolutionMatchingWorkflow_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetMatchingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, entityresolution.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "entityresolution", regexp.MustCompile(`matchingworkflow/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "incremental_run_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "input_source_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_source_config.0.input_source_arn", "aws_glue_catalog_table.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_source_config.0.schema_name", "aws_entityresolution_schema_mapping.test", "schema_name"),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.0.output.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.resolution_type", entityresolution.ResolutionTypeRuleMatching),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.attribute_matching_model", entityresolution.AttributeMatchingModelOneToOne),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rules.0.matching_keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rules.0.rule_name", "rule1"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "workflow_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionMatchingWorkflow_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetMatchingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, entityresolution.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfentityresolution.ResourceMatchingWorkflow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionMatchingWorkflow_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetMatchingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, entityresolution.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMatchingWorkflowConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMatchingWorkflowConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionMatchingWorkflow_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetMatchingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, entityresolution.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.0.kms_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rules.#", "1"),
+				),
+			},
+			{
+				Config: testAccMatchingWorkflowConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttrPair(resourceName, "output_source_config.0.kms_arn", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rules.1.matching_keys.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rules.1.rule_name", "rule2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMatchingWorkflowDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_entityresolution_matching_workflow" {
+				continue
+			}
+
+			_, err := tfentityresolution.FindMatchingWorkflowByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Entity Resolution Matching Workflow %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMatchingWorkflowExists(ctx context.Context, n string, v *entityresolution.GetMatchingWorkflowOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Entity Resolution Matching Workflow ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionConn()
+
+		output, err := tfentityresolution.FindMatchingWorkflowByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccWorkflowConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = replace(%[1]q, "-", "_")
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = replace(%[1]q, "-", "_")
+  database_name = aws_glue_catalog_database.test.name
+  table_type    = "EXTERNAL_TABLE"
+
+  storage_descriptor {
+    location      = "s3://${aws_s3_bucket.test.bucket}/input/"
+    input_format  = "org.apache.hadoop.mapred.TextInputFormat"
+    output_format = "org.apache.hadoop.hive.ql.io.HiveIgnoreKeyTextOutputFormat"
+
+    ser_de_info {
+      serialization_library = "org.apache.hadoop.hive.serde2.lazy.LazySimpleSerDe"
+
+      parameters = {
+        "field.delim" = ","
+      }
+    }
+
+    columns {
+      name = "id"
+      type = "string"
+    }
+
+    columns {
+      name = "email"
+      type = "string"
+    }
+
+    columns {
+      name = "name"
+      type = "string"
+    }
+  }
+}
+
+resource "aws_entityresolution_schema_mapping" "test" {
+  schema_name = %[1]q
+
+  mapped_input_fields {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_fields {
+    field_name = "email"
+    match_key  = "email"
+    type       = "EMAIL_ADDRESS"
+  }
+
+  mapped_input_fields {
+    field_name = "name"
+    match_key  = "name"
+    type       = "NAME"
+  }
+}
+
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["entityresolution.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = [
+      "glue:GetDatabase",
+      "glue:GetTable",
+      "glue:GetPartition",
+      "glue:GetPartitions",
+      "glue:GetSchema",
+      "glue:GetSchemaVersion",
+      "glue:BatchGetPartition",
+    ]
+    resources = ["*"]
+  }
+
+  statement {
+    actions = [
+      "s3:GetObject",
+      "s3:ListBucket",
+      "s3:GetBucketLocation",
+      "s3:PutObject",
+    ]
+    resources = [
+      aws_s3_bucket.test.arn,
+      "${aws_s3_bucket.test.arn}/*",
+    ]
+  }
+}
+
+resource "aws_iam_role_policy" "test" {
+  role   = aws_iam_role.test.name
+  policy = data.aws_iam_policy_document.test.json
+}
+`, rName)
+}
+
+func testAccMatchingWorkflowConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkflowConfigBase(rName), fmt.Sprintf(`
+resource "aws_entityresolution_matching_workflow" "test" {
+  workflow_name = %[1]q
+  role_arn      = aws_iam_role.test.arn
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.schema_name
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output"
+
+    output {
+      name = "id"
+    }
+
+    output {
+      name = "email"
+    }
+  }
+
+  resolution_techniques {
+    resolution_type = "RULE_MATCHING"
+
+    rule_based_properties {
+      attribute_matching_model = "ONE_TO_ONE"
+
+      rules {
+        rule_name     = "rule1"
+        matching_keys = ["email"]
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccMatchingWorkflowConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccWorkflowConfigBase(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_entityresolution_matching_workflow" "test" {
+  workflow_name = %[1]q
+  description   = "updated"
+  role_arn      = aws_iam_role.test.arn
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.schema_name
+  }
+
+  output_source_config {
+    kms_arn        = aws_kms_key.test.arn
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output"
+
+    output {
+      name = "id"
+    }
+
+    output {
+      name = "email"
+    }
+  }
+
+  resolution_techniques {
+    resolution_type = "RULE_MATCHING"
+
+    rule_based_properties {
+      attribute_matching_model = "ONE_TO_ONE"
+
+      rules {
+        rule_name     = "rule1"
+        matching_keys = ["email"]
+      }
+
+      rules {
+        rule_name     = "rule2"
+        matching_keys = ["email", "name"]
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccMatchingWorkflowConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccWorkflowConfigBase(rName), fmt.Sprintf(`
+resource "aws_entityresolution_matching_workflow" "test" {
+  workflow_name = %[1]q
+  role_arn      = aws_iam_role.test.arn
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.schema_name
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output"
+
+    output {
+      name = "id"
+    }
+  }
+
+  resolution_techniques {
+    resolution_type = "RULE_MATCHING"
+
+    rule_based_properties {
+      attribute_matching_model = "ONE_TO_ONE"
+
+      rules {
+        rule_name     = "rule1"
+        matching_keys = ["email"]
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccMatchingWorkflowConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccWorkflowConfigBase(rName), fmt.Sprintf(`
+resource "aws_entityresolution_matching_workflow" "test" {
+  workflow_name = %[1]q
+  role_arn      = aws_iam_role.test.arn
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.schema_name
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output"
+
+    output {
+      name = "id"
+    }
+  }
+
+  resolution_techniques {
+    resolution_type = "RULE_MATCHING"
+
+    rule_based_properties {
+      attribute_matching_model = "ONE_TO_ONE"
+
+      rules {
+        rule_name     = "rule1"
+        matching_keys = ["email"]
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,307 @@
+package entityresolution
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_entityresolution_schema_mapping", name="Schema Mapping")
+// @Tags(identifierAttribute="arn")
+func ResourceSchemaMapping() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSchemaMappingCreate,
+		ReadWithoutTimeout:   resourceSchemaMappingRead,
+		UpdateWithoutTimeout: resourceSchemaMappingUpdate,
+		DeleteWithoutTimeout: resourceSchemaMappingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"has_workflows": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"mapped_input_fields": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"group_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"hashed": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"match_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"sub_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.SchemaAttributeType_Values(), false),
+						},
+					},
+				},
+			},
+			"schema_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_0-9-]*$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSchemaMappingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	name := d.Get("schema_name").(string)
+	input := &entityresolution.CreateSchemaMappingInput{
+		MappedInputFields: expandSchemaInputAttributes(d.Get("mapped_input_fields").([]interface{})),
+		SchemaName:        aws.String(name),
+		Tags:              GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateSchemaMappingWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Entity Resolution Schema Mapping (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceSchemaMappingRead(ctx, d, meta)...)
+}
+
+func resourceSchemaMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	output, err := FindSchemaMappingByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution Schema Mapping (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Entity Resolution Schema Mapping (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.SchemaArn)
+	d.Set("description", output.Description)
+	d.Set("has_workflows", output.HasWorkflows)
+	if err := d.Set("mapped_input_fields", flattenSchemaInputAttributes(output.MappedInputFields)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting mapped_input_fields: %s", err)
+	}
+	d.Set("schema_name", output.SchemaName)
+
+	return diags
+}
+
+func resourceSchemaMappingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &entityresolution.UpdateSchemaMappingInput{
+			MappedInputFields: expandSchemaInputAttributes(d.Get("mapped_input_fields").([]interface{})),
+			SchemaName:        aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateSchemaMappingWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Entity Resolution Schema Mapping (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSchemaMappingRead(ctx, d, meta)...)
+}
+
+func resourceSchemaMappingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	log.Printf("[INFO] Deleting Entity Resolution Schema Mapping: %s", d.Id())
+	// A schema mapping cannot be deleted while a workflow that uses it still exists.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteSchemaMappingWithContext(ctx, &entityresolution.DeleteSchemaMappingInput{
+			SchemaName: aws.String(d.Id()),
+		})
+	}, entityresolution.ErrCodeConflictException)
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Entity Resolution Schema Mapping (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindSchemaMappingByName(ctx context.Context, conn *entityresolution.EntityResolution, name string) (*entityresolution.GetSchemaMappingOutput, error) {
+	input := &entityresolution.GetSchemaMappingInput{
+		SchemaName: aws.String(name),
+	}
+
+	output, err := conn.GetSchemaMappingWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandSchemaInputAttributes(tfList []interface{}) []*entityresolution.SchemaInputAttribute {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*entityresolution.SchemaInputAttribute
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.SchemaInputAttribute{}
+
+		if v, ok := tfMap["field_name"].(string); ok && v != "" {
+			apiObject.FieldName = aws.String(v)
+		}
+
+		if v, ok := tfMap["group_name"].(string); ok && v != "" {
+			apiObject.GroupName = aws.String(v)
+		}
+
+		if v, ok := tfMap["hashed"].(bool); ok && v {
+			apiObject.Hashed = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["match_key"].(string); ok && v != "" {
+			apiObject.MatchKey = aws.String(v)
+		}
+
+		if v, ok := tfMap["sub_type"].(string); ok && v != "" {
+			apiObject.SubType = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenSchemaInputAttributes(apiObjects []*entityresolution.SchemaInputAttribute) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"field_name": aws.StringValue(apiObject.FieldName),
+			"group_name": aws.StringValue(apiObject.GroupName),
+			"hashed":     aws.BoolValue(apiObject.Hashed),
+			"match_key":  aws.StringValue(apiObject.MatchKey),
+			"sub_type":   aws.StringValue(apiObject.SubType),
+			"type":       aws.StringValue(apiObject.Type),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
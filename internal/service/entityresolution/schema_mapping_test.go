@@ -0,0 +1,316 @@
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEntityResolutionSchemaMapping_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetSchemaMappingOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_schema_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, entityresolution.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaMappingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaMappingConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "entityresolution", regexp.MustCompile(`schemamapping/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "has_workflows", "false"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.0.field_name", "id"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.0.type", entityresolution.SchemaAttributeTypeUniqueId),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.1.field_name", "email"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.1.match_key", "email"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.1.type", entityresolution.SchemaAttributeTypeEmailAddress),
+					resource.TestCheckResourceAttr(resourceName, "schema_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionSchemaMapping_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetSchemaMappingOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_schema_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, entityresolution.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaMappingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaMappingConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfentityresolution.ResourceSchemaMapping(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionSchemaMapping_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetSchemaMappingOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_schema_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, entityresolution.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaMappingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaMappingConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSchemaMappingConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSchemaMappingConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionSchemaMapping_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetSchemaMappingOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_schema_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, entityresolution.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaMappingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaMappingConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.#", "3"),
+				),
+			},
+			{
+				Config: testAccSchemaMappingConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.3.field_name", "phone"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.3.hashed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.3.type", entityresolution.SchemaAttributeTypePhoneNumber),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSchemaMappingDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_entityresolution_schema_mapping" {
+				continue
+			}
+
+			_, err := tfentityresolution.FindSchemaMappingByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Entity Resolution Schema Mapping %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSchemaMappingExists(ctx context.Context, n string, v *entityresolution.GetSchemaMappingOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Entity Resolution Schema Mapping ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionConn()
+
+		output, err := tfentityresolution.FindSchemaMappingByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSchemaMappingConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_entityresolution_schema_mapping" "test" {
+  schema_name = %[1]q
+
+  mapped_input_fields {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_fields {
+    field_name = "email"
+    match_key  = "email"
+    type       = "EMAIL_ADDRESS"
+  }
+
+  mapped_input_fields {
+    field_name = "name"
+    match_key  = "name"
+    type       = "NAME"
+  }
+}
+`, rName)
+}
+
+func testAccSchemaMappingConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_entityresolution_schema_mapping" "test" {
+  schema_name = %[1]q
+  description = "updated"
+
+  mapped_input_fields {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_fields {
+    field_name = "email"
+    match_key  = "email"
+    type       = "EMAIL_ADDRESS"
+  }
+
+  mapped_input_fields {
+    field_name = "name"
+    match_key  = "name"
+    type       = "NAME"
+  }
+
+  mapped_input_fields {
+    field_name = "phone"
+    hashed     = true
+    match_key  = "phone"
+    type       = "PHONE_NUMBER"
+  }
+}
+`, rName)
+}
+
+func testAccSchemaMappingConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_entityresolution_schema_mapping" "test" {
+  schema_name = %[1]q
+
+  mapped_input_fields {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_fields {
+    field_name = "email"
+    match_key  = "email"
+    type       = "EMAIL_ADDRESS"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccSchemaMappingConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_entityresolution_schema_mapping" "test" {
+  schema_name = %[1]q
+
+  mapped_input_fields {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_fields {
+    field_name = "email"
+    match_key  = "email"
+    type       = "EMAIL_ADDRESS"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,59 @@
+// Code generated by internal/generate/servicepackages/main.go; DO NOT EDIT.
+
+package entityresolution
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type servicePackage struct{}
+
+func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
+	return []*types.ServicePackageFrameworkDataSource{}
+}
+
+func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
+	return []*types.ServicePackageFrameworkResource{}
+}
+
+func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
+	return []*types.ServicePackageSDKDataSource{}
+}
+
+func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceIDMappingWorkflow,
+			TypeName: "aws_entityresolution_id_mapping_workflow",
+			Name:     "ID Mapping Workflow",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceMatchingWorkflow,
+			TypeName: "aws_entityresolution_matching_workflow",
+			Name:     "Matching Workflow",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceSchemaMapping,
+			TypeName: "aws_entityresolution_schema_mapping",
+			Name:     "Schema Mapping",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+	}
+}
+
+func (p *servicePackage) ServicePackageName() string {
+	return names.EntityResolution
+}
+
+var ServicePackage = &servicePackage{}
@@ -82,7 +82,7 @@ func ResourceWindowsFileSystem() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Computed:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: validWindowsAuditLogDestination,
 							StateFunc:    windowsAuditLogStateFunc,
 							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 								return strings.HasPrefix(old, fmt.Sprintf("%s:", new))
@@ -645,6 +645,18 @@ func expandSelfManagedActiveDirectoryConfigurationUpdate(l []interface{}) *fsx.S
 		req.DnsIps = flex.ExpandStringSet(v)
 	}
 
+	if v, ok := data["domain_name"].(string); ok && v != "" {
+		req.DomainName = aws.String(v)
+	}
+
+	if v, ok := data["file_system_administrators_group"].(string); ok && v != "" {
+		req.FileSystemAdministratorsGroup = aws.String(v)
+	}
+
+	if v, ok := data["organizational_unit_distinguished_name"].(string); ok && v != "" {
+		req.OrganizationalUnitDistinguishedName = aws.String(v)
+	}
+
 	if v, ok := data["password"].(string); ok && v != "" {
 		req.Password = aws.String(v)
 	}
@@ -714,6 +726,35 @@ func flattenWindowsAuditLogConfiguration(adopts *fsx.WindowsAuditLogConfiguratio
 	return []map[string]interface{}{m}
 }
 
+// validWindowsAuditLogDestination validates that the audit log destination is a
+// CloudWatch Logs log group whose name begins with "/aws/fsx" or a Kinesis Data
+// Firehose delivery stream whose name begins with "aws-fsx".
+func validWindowsAuditLogDestination(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = verify.ValidARN(v, k)
+
+	if len(errors) > 0 {
+		return ws, errors
+	}
+
+	value := v.(string)
+	destinationARN, _ := arn.Parse(value)
+
+	switch destinationARN.Service {
+	case "logs":
+		if !strings.HasPrefix(destinationARN.Resource, "log-group:/aws/fsx") {
+			errors = append(errors, fmt.Errorf("%q (%s) must be a CloudWatch Logs log group whose name begins with /aws/fsx", k, value))
+		}
+	case "firehose":
+		if !strings.HasPrefix(destinationARN.Resource, "deliverystream/aws-fsx") {
+			errors = append(errors, fmt.Errorf("%q (%s) must be a Kinesis Data Firehose delivery stream whose name begins with aws-fsx", k, value))
+		}
+	default:
+		errors = append(errors, fmt.Errorf("%q (%s) must be a CloudWatch Logs log group or Kinesis Data Firehose delivery stream ARN", k, value))
+	}
+
+	return ws, errors
+}
+
 func windowsAuditLogStateFunc(v interface{}) string {
 	value := v.(string)
 	// API returns the specific log stream arn instead of provided log group
@@ -542,7 +542,7 @@ func TestAccFSxWindowsFileSystem_selfManagedActiveDirectory(t *testing.T) {
 
 func TestAccFSxWindowsFileSystem_SelfManagedActiveDirectory_username(t *testing.T) {
 	ctx := acctest.Context(t)
-	var filesystem fsx.FileSystem
+	var filesystem1, filesystem2 fsx.FileSystem
 	resourceName := "aws_fsx_windows_file_system.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	domainName := acctest.RandomDomainName()
@@ -556,8 +556,9 @@ func TestAccFSxWindowsFileSystem_SelfManagedActiveDirectory_username(t *testing.
 			{
 				Config: testAccWindowsFileSystemConfig_selfManagedActiveDirectoryUsername(rName, domainName, "Admin"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckWindowsFileSystemExists(ctx, resourceName, &filesystem),
+					testAccCheckWindowsFileSystemExists(ctx, resourceName, &filesystem1),
 					resource.TestCheckResourceAttr(resourceName, "self_managed_active_directory.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_active_directory.0.username", "Admin"),
 				),
 			},
 			{
@@ -573,8 +574,54 @@ func TestAccFSxWindowsFileSystem_SelfManagedActiveDirectory_username(t *testing.
 			{
 				Config: testAccWindowsFileSystemConfig_selfManagedActiveDirectoryUsername(rName, domainName, "Administrator"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckWindowsFileSystemExists(ctx, resourceName, &filesystem),
+					testAccCheckWindowsFileSystemExists(ctx, resourceName, &filesystem2),
+					testAccCheckWindowsFileSystemNotRecreated(&filesystem1, &filesystem2),
 					resource.TestCheckResourceAttr(resourceName, "self_managed_active_directory.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_active_directory.0.username", "Administrator"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFSxWindowsFileSystem_SelfManagedActiveDirectory_fileSystemAdministratorsGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_windows_file_system.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, fsx.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, fsx.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWindowsFileSystemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWindowsFileSystemConfig_selfManagedActiveDirectory(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWindowsFileSystemExists(ctx, resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_active_directory.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_active_directory.0.file_system_administrators_group", "Domain Admins"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"security_group_ids",
+					"self_managed_active_directory",
+					"skip_final_backup",
+				},
+			},
+			{
+				Config: testAccWindowsFileSystemConfig_selfManagedActiveDirectoryFileSystemAdministratorsGroup(rName, domainName, "AWS Delegated FSx Administrators"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWindowsFileSystemExists(ctx, resourceName, &filesystem2),
+					testAccCheckWindowsFileSystemNotRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_active_directory.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_active_directory.0.file_system_administrators_group", "AWS Delegated FSx Administrators"),
 				),
 			},
 		},
@@ -1155,6 +1202,25 @@ resource "aws_fsx_windows_file_system" "test" {
 `, username))
 }
 
+func testAccWindowsFileSystemConfig_selfManagedActiveDirectoryFileSystemAdministratorsGroup(rName, domain, group string) string {
+	return acctest.ConfigCompose(testAccWindowsFileSystemConfig_base(rName, domain), fmt.Sprintf(`
+resource "aws_fsx_windows_file_system" "test" {
+  skip_final_backup   = true
+  storage_capacity    = 32
+  subnet_ids          = [aws_subnet.test[0].id]
+  throughput_capacity = 8
+
+  self_managed_active_directory {
+    dns_ips                          = aws_directory_service_directory.test.dns_ip_addresses
+    domain_name                      = aws_directory_service_directory.test.name
+    file_system_administrators_group = %[1]q
+    password                         = aws_directory_service_directory.test.password
+    username                         = "Admin"
+  }
+}
+`, group))
+}
+
 func testAccWindowsFileSystemConfig_storageCapacity(rName, domain string, storageCapacity, throughputCapacity int) string {
 	return acctest.ConfigCompose(testAccWindowsFileSystemConfig_base(rName, domain), fmt.Sprintf(`
 resource "aws_fsx_windows_file_system" "test" {
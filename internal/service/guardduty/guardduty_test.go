@@ -46,6 +46,7 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"s3Logs":                        testAccOrganizationConfiguration_s3logs,
 			"kubernetes":                    testAccOrganizationConfiguration_kubernetes,
 			"malwareProtection":             testAccOrganizationConfiguration_malwareprotection,
+			"features":                      testAccOrganizationConfiguration_features,
 		},
 		"ThreatIntelSet": {
 			"basic": testAccThreatIntelSet_basic,
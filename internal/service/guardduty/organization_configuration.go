@@ -2,6 +2,7 @@ package guardduty
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"golang.org/x/exp/slices"
 )
 
 // @SDKResource("aws_guardduty_organization_configuration")
@@ -45,10 +47,12 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 			},
 
 			"datasources": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"feature"},
+				Deprecated:    "Use feature instead",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"s3_logs": {
@@ -130,6 +134,46 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+
+			"feature": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"datasources"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"additional_configuration": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"auto_enable": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(orgFeatureStatusValues(), false),
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(guardduty.OrgFeatureAdditionalConfiguration_Values(), false),
+									},
+								},
+							},
+						},
+						"auto_enable": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(orgFeatureStatusValues(), false),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.OrgFeature_Values(), false),
+						},
+					},
+				},
+			},
 		},
 
 		CustomizeDiff: customdiff.Sequence(
@@ -159,10 +203,66 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 
 				return nil
 			},
+			resourceOrganizationConfigurationFeatureCustomizeDiff,
 		),
 	}
 }
 
+// orgFeatureStatusAll is not yet modeled in the AWS SDK's OrgFeatureStatus enum.
+const orgFeatureStatusAll = "ALL"
+
+func orgFeatureStatusValues() []string {
+	return append(guardduty.OrgFeatureStatus_Values(), orgFeatureStatusAll)
+}
+
+// orgFeatureAdditionalConfigurations lists the additional configurations supported by each feature.
+var orgFeatureAdditionalConfigurations = map[string][]string{
+	guardduty.OrgFeatureEksRuntimeMonitoring: {guardduty.OrgFeatureAdditionalConfigurationEksAddonManagement},
+}
+
+func resourceOrganizationConfigurationFeatureCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	features := make(map[string]struct{})
+
+	for _, tfMapRaw := range d.Get("feature").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name, _ := tfMap["name"].(string)
+
+		if name == "" {
+			continue
+		}
+
+		if _, ok := features[name]; ok {
+			return fmt.Errorf("feature %s is configured more than once", name)
+		}
+		features[name] = struct{}{}
+
+		v, ok := tfMap["additional_configuration"].(*schema.Set)
+
+		if !ok {
+			continue
+		}
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			if additionalConfigurationName, _ := tfMap["name"].(string); additionalConfigurationName != "" && !slices.Contains(orgFeatureAdditionalConfigurations[name], additionalConfigurationName) {
+				return fmt.Errorf("additional configuration %s is not supported for feature %s", additionalConfigurationName, name)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).GuardDutyConn()
@@ -178,6 +278,10 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		input.DataSources = expandOrganizationDataSourceConfigurations(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("feature"); ok && v.(*schema.Set).Len() > 0 {
+		input.Features = expandOrganizationFeatureConfigurations(v.(*schema.Set).List())
+	}
+
 	_, err := conn.UpdateOrganizationConfigurationWithContext(ctx, input)
 
 	if err != nil {
@@ -226,6 +330,16 @@ func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.Resour
 
 	d.Set("detector_id", d.Id())
 
+	// Only track the features that are configured, if any.
+	features := output.Features
+	if v, ok := d.GetOk("feature"); ok && v.(*schema.Set).Len() > 0 {
+		features = filterOrganizationFeatureConfigurationResults(features, v.(*schema.Set).List())
+	}
+
+	if err := d.Set("feature", flattenOrganizationFeatureConfigurationResults(features)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting feature: %s", err)
+	}
+
 	return diags
 }
 
@@ -251,6 +365,70 @@ func expandOrganizationDataSourceConfigurations(tfMap map[string]interface{}) *g
 	return apiObject
 }
 
+func expandOrganizationFeatureConfigurations(tfList []interface{}) []*guardduty.OrganizationFeatureConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*guardduty.OrganizationFeatureConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &guardduty.OrganizationFeatureConfiguration{}
+
+		if v, ok := tfMap["additional_configuration"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AdditionalConfiguration = expandOrganizationAdditionalConfigurations(v.List())
+		}
+
+		if v, ok := tfMap["auto_enable"].(string); ok && v != "" {
+			apiObject.AutoEnable = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOrganizationAdditionalConfigurations(tfList []interface{}) []*guardduty.OrganizationAdditionalConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*guardduty.OrganizationAdditionalConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &guardduty.OrganizationAdditionalConfiguration{}
+
+		if v, ok := tfMap["auto_enable"].(string); ok && v != "" {
+			apiObject.AutoEnable = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandOrganizationS3LogsConfiguration(tfMap map[string]interface{}) *guardduty.OrganizationS3LogsConfiguration {
 	if tfMap == nil {
 		return nil
@@ -455,3 +633,74 @@ func flattenOrganizationMalwareProtectionEBSVolumesResult(apiObject *guardduty.O
 
 	return tfMap
 }
+
+func filterOrganizationFeatureConfigurationResults(apiObjects []*guardduty.OrganizationFeatureConfigurationResult, tfList []interface{}) []*guardduty.OrganizationFeatureConfigurationResult {
+	names := make(map[string]struct{})
+
+	for _, tfMapRaw := range tfList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			if v, ok := tfMap["name"].(string); ok && v != "" {
+				names[v] = struct{}{}
+			}
+		}
+	}
+
+	var filtered []*guardduty.OrganizationFeatureConfigurationResult
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		if _, ok := names[aws.StringValue(apiObject.Name)]; ok {
+			filtered = append(filtered, apiObject)
+		}
+	}
+
+	return filtered
+}
+
+func flattenOrganizationFeatureConfigurationResults(apiObjects []*guardduty.OrganizationFeatureConfigurationResult) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"additional_configuration": flattenOrganizationAdditionalConfigurationResults(apiObject.AdditionalConfiguration),
+			"auto_enable":              aws.StringValue(apiObject.AutoEnable),
+			"name":                     aws.StringValue(apiObject.Name),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenOrganizationAdditionalConfigurationResults(apiObjects []*guardduty.OrganizationAdditionalConfigurationResult) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"auto_enable": aws.StringValue(apiObject.AutoEnable),
+			"name":        aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
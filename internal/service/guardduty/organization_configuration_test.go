@@ -2,6 +2,7 @@ package guardduty_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/guardduty"
@@ -283,6 +284,62 @@ func testAccOrganizationConfiguration_malwareprotection(t *testing.T) {
 	})
 }
 
+func testAccOrganizationConfiguration_features(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_guardduty_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOrganizationConfigurationConfig_features("S3_DATA_EVENTS", "NEW", "NEW"),
+				ExpectError: regexp.MustCompile(`additional configuration EKS_ADDON_MANAGEMENT is not supported for feature S3_DATA_EVENTS`),
+			},
+			{
+				Config: testAccOrganizationConfigurationConfig_features("EKS_RUNTIME_MONITORING", "NEW", "NEW"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "feature.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"name":                       "EKS_RUNTIME_MONITORING",
+						"auto_enable":                "NEW",
+						"additional_configuration.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*.additional_configuration.*", map[string]string{
+						"name":        "EKS_ADDON_MANAGEMENT",
+						"auto_enable": "NEW",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"feature"},
+			},
+			{
+				Config: testAccOrganizationConfigurationConfig_features("EKS_RUNTIME_MONITORING", "NONE", "NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "feature.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"name":        "EKS_RUNTIME_MONITORING",
+						"auto_enable": "NONE",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*.additional_configuration.*", map[string]string{
+						"name":        "EKS_ADDON_MANAGEMENT",
+						"auto_enable": "NONE",
+					}),
+				),
+			},
+		},
+	})
+}
+
 const testAccOrganizationConfigurationConfigBase = `
 data "aws_caller_identity" "current" {}
 
@@ -394,3 +451,26 @@ resource "aws_guardduty_organization_configuration" "test" {
 }
 `, autoEnable))
 }
+
+func testAccOrganizationConfigurationConfig_features(name, autoEnable, additionalConfigurationAutoEnable string) string {
+	return acctest.ConfigCompose(
+		testAccOrganizationConfigurationConfigBase,
+		fmt.Sprintf(`
+resource "aws_guardduty_organization_configuration" "test" {
+  depends_on = [aws_guardduty_organization_admin_account.test]
+
+  auto_enable_organization_members = "NEW"
+  detector_id                      = aws_guardduty_detector.test.id
+
+  feature {
+    name        = %[1]q
+    auto_enable = %[2]q
+
+    additional_configuration {
+      name        = "EKS_ADDON_MANAGEMENT"
+      auto_enable = %[3]q
+    }
+  }
+}
+`, name, autoEnable, additionalConfigurationAutoEnable))
+}
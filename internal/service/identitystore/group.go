@@ -43,7 +43,6 @@ func ResourceGroup() *schema.Resource {
 			"display_name": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 1024),
 			},
 			"external_ids": {
@@ -153,6 +152,20 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		Operations:      nil,
 	}
 
+	if d.HasChange("description") {
+		// The API doesn't allow empty attribute values. To unset an
+		// attribute, set it to null.
+		var value interface{}
+		if v := d.Get("description").(string); v != "" {
+			value = v
+		}
+
+		in.Operations = append(in.Operations, types.AttributeOperation{
+			AttributePath:  aws.String("description"),
+			AttributeValue: document.NewLazyDocument(value),
+		})
+	}
+
 	if d.HasChange("display_name") {
 		in.Operations = append(in.Operations, types.AttributeOperation{
 			AttributePath:  aws.String("displayName"),
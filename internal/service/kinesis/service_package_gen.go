@@ -34,6 +34,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceResourcePolicy,
+			TypeName: "aws_kinesis_resource_policy",
+		},
 		{
 			Factory:  ResourceStream,
 			TypeName: "aws_kinesis_stream",
@@ -1202,6 +1202,16 @@ func TestAccLambdaEventSourceMapping_documentDB(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"last_modified"},
 			},
+			{
+				Config: testAccEventSourceMappingConfig_documentDBUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventSourceMappingExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "document_db_event_source_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "document_db_event_source_config.0.collection_name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "document_db_event_source_config.0.database_name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "document_db_event_source_config.0.full_document", "UpdateLookup"),
+				),
+			},
 		},
 	})
 }
@@ -2460,6 +2470,29 @@ resource "aws_lambda_event_source_mapping" "test" {
 `)
 }
 
+func testAccEventSourceMappingConfig_documentDBUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccEventSourceMappingConfig_documentDBBase(rName), `
+resource "aws_lambda_event_source_mapping" "test" {
+  enabled          = true
+  event_source_arn = aws_docdb_cluster.test.arn
+  function_name    = aws_lambda_function.test.arn
+
+  document_db_event_source_config {
+    collection_name = "test"
+    database_name   = "test"
+    full_document   = "UpdateLookup"
+  }
+
+  source_access_configuration {
+    type = "BASIC_AUTH"
+    uri  = aws_secretsmanager_secret_version.test.arn
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`)
+}
+
 func testAccEventSourceMappingConfig_sqsFilterCriteria1(rName string, pattern1 string) string {
 	return acctest.ConfigCompose(testAccEventSourceMappingConfig_sqsBase(rName), fmt.Sprintf(`
 resource "aws_lambda_event_source_mapping" "test" {
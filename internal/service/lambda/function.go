@@ -231,6 +231,42 @@ func ResourceFunction() *schema.Resource {
 					ValidateFunc: verify.ValidARN,
 				},
 			},
+			"logging_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_log_level": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.ApplicationLogLevel](),
+						},
+						"log_format": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.LogFormat](),
+						},
+						"log_group": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 512),
+								validation.StringMatch(regexp.MustCompile(`^[\.\-_/#A-Za-z0-9]+$`), "must contain only alphanumeric characters, underscores, hyphens, slashes, hash signs and dots"),
+							),
+						},
+						"system_log_level": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.SystemLogLevel](),
+						},
+					},
+				},
+			},
 			"memory_size": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -267,6 +303,12 @@ func ResourceFunction() *schema.Resource {
 				Elem:         &schema.Schema{Type: schema.TypeString},
 				RequiredWith: []string{"replace_security_groups_on_destroy"},
 			},
+			"recursive_loop": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.RecursiveLoopTerminate,
+				ValidateDiagFunc: enum.Validate[types.RecursiveLoop](),
+			},
 			"reserved_concurrent_executions": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -283,6 +325,25 @@ func ResourceFunction() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: enum.Validate[types.Runtime](),
 			},
+			"runtime_management_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"runtime_version_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"update_runtime_on": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.UpdateRuntimeOn](),
+						},
+					},
+				},
+			},
 			"s3_bucket": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -515,6 +576,10 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Layers = flex.ExpandStringValueList(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("logging_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LoggingConfig = expandLoggingConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("snap_start"); ok {
 		input.SnapStart = expandSnapStart(v.([]interface{}))
 	}
@@ -555,6 +620,17 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): waiting for completion: %s", d.Id(), err)
 	}
 
+	if v := types.RecursiveLoop(d.Get("recursive_loop").(string)); v != types.RecursiveLoopTerminate {
+		_, err := conn.PutFunctionRecursionConfig(ctx, &lambda.PutFunctionRecursionConfigInput{
+			FunctionName:  aws.String(d.Id()),
+			RecursiveLoop: v,
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) recursion configuration: %s", d.Id(), err)
+		}
+	}
+
 	if v, ok := d.Get("reserved_concurrent_executions").(int); ok && v >= 0 {
 		_, err := conn.PutFunctionConcurrency(ctx, &lambda.PutFunctionConcurrencyInput{
 			FunctionName:                 aws.String(d.Id()),
@@ -566,6 +642,12 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v, ok := d.GetOk("runtime_management_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := putRuntimeManagementConfig(ctx, conn, d.Id(), v.([]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) runtime management configuration: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceFunctionRead(ctx, d, meta)...)
 }
 
@@ -633,8 +715,20 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err := d.Set("layers", flattenLayers(function.Layers)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting layers: %s", err)
 	}
+	if err := d.Set("logging_config", flattenLoggingConfig(function.LoggingConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting logging_config: %s", err)
+	}
 	d.Set("memory_size", function.MemorySize)
 	d.Set("package_type", function.PackageType)
+	recursionConfig, err := conn.GetFunctionRecursionConfig(ctx, &lambda.GetFunctionRecursionConfigInput{
+		FunctionName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) recursion configuration: %s", d.Id(), err)
+	}
+
+	d.Set("recursive_loop", recursionConfig.RecursiveLoop)
 	if output.Concurrency != nil {
 		d.Set("reserved_concurrent_executions", output.Concurrency.ReservedConcurrentExecutions)
 	} else {
@@ -642,6 +736,27 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	d.Set("role", function.Role)
 	d.Set("runtime", function.Runtime)
+	// Runtime management is only supported on zip packaged lambda functions.
+	if function.PackageType == types.PackageTypeZip {
+		input := &lambda.GetRuntimeManagementConfigInput{
+			FunctionName: aws.String(d.Id()),
+		}
+		if hasQualifier {
+			input.Qualifier = aws.String(d.Get("qualifier").(string))
+		}
+
+		output, err := conn.GetRuntimeManagementConfig(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) runtime management configuration: %s", d.Id(), err)
+		}
+
+		if err := d.Set("runtime_management_config", flattenRuntimeManagementConfig(output)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting runtime_management_config: %s", err)
+		}
+	} else {
+		d.Set("runtime_management_config", nil)
+	}
 	d.Set("signing_job_arn", function.SigningJobArn)
 	d.Set("signing_profile_version_arn", function.SigningProfileVersionArn)
 	// Support in-place update of non-refreshable attribute.
@@ -816,6 +931,12 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.Layers = flex.ExpandStringValueList(d.Get("layers").([]interface{}))
 		}
 
+		if d.HasChange("logging_config") {
+			if v, ok := d.GetOk("logging_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.LoggingConfig = expandLoggingConfig(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if d.HasChange("memory_size") {
 			input.MemorySize = aws.Int32(int32(d.Get("memory_size").(int)))
 		}
@@ -931,6 +1052,23 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("runtime_management_config") {
+		if err := putRuntimeManagementConfig(ctx, conn, d.Id(), d.Get("runtime_management_config").([]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) runtime management configuration: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("recursive_loop") {
+		_, err := conn.PutFunctionRecursionConfig(ctx, &lambda.PutFunctionRecursionConfigInput{
+			FunctionName:  aws.String(d.Id()),
+			RecursiveLoop: types.RecursiveLoop(d.Get("recursive_loop").(string)),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) recursion configuration: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("reserved_concurrent_executions") {
 		if v, ok := d.Get("reserved_concurrent_executions").(int); ok && v >= 0 {
 			_, err := conn.PutFunctionConcurrency(ctx, &lambda.PutFunctionConcurrencyInput{
@@ -1298,6 +1436,7 @@ func needsFunctionConfigUpdate(d verify.ResourceDiffer) bool {
 		d.HasChange("timeout") ||
 		d.HasChange("kms_key_arn") ||
 		d.HasChange("layers") ||
+		d.HasChange("logging_config") ||
 		d.HasChange("dead_letter_config") ||
 		d.HasChange("snap_start") ||
 		d.HasChange("tracing_config") ||
@@ -1463,6 +1602,90 @@ func flattenSnapStart(apiObject *types.SnapStartResponse) []interface{} {
 	return []interface{}{m}
 }
 
+func putRuntimeManagementConfig(ctx context.Context, conn *lambda.Client, functionName string, tfList []interface{}) error {
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    aws.String(functionName),
+		UpdateRuntimeOn: types.UpdateRuntimeOnAuto,
+	}
+
+	if len(tfList) == 1 && tfList[0] != nil {
+		tfMap := tfList[0].(map[string]interface{})
+
+		if v, ok := tfMap["runtime_version_arn"].(string); ok && v != "" {
+			input.RuntimeVersionArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["update_runtime_on"].(string); ok && v != "" {
+			input.UpdateRuntimeOn = types.UpdateRuntimeOn(v)
+		}
+	}
+
+	_, err := retryFunctionOp(ctx, func() (interface{}, error) {
+		return conn.PutRuntimeManagementConfig(ctx, input)
+	})
+
+	return err
+}
+
+func flattenRuntimeManagementConfig(apiObject *lambda.GetRuntimeManagementConfigOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+	if apiObject.UpdateRuntimeOn == types.UpdateRuntimeOnAuto {
+		return nil
+	}
+	m := map[string]interface{}{
+		"runtime_version_arn": aws.ToString(apiObject.RuntimeVersionArn),
+		"update_runtime_on":   string(apiObject.UpdateRuntimeOn),
+	}
+
+	return []interface{}{m}
+}
+
+func expandLoggingConfig(tfMap map[string]interface{}) *types.LoggingConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.LoggingConfig{}
+
+	if v, ok := tfMap["log_format"].(string); ok && v != "" {
+		apiObject.LogFormat = types.LogFormat(v)
+	}
+
+	if v, ok := tfMap["log_group"].(string); ok && v != "" {
+		apiObject.LogGroup = aws.String(v)
+	}
+
+	// Log levels can only be set for JSON format logs.
+	if apiObject.LogFormat == types.LogFormatJson {
+		if v, ok := tfMap["application_log_level"].(string); ok && v != "" {
+			apiObject.ApplicationLogLevel = types.ApplicationLogLevel(v)
+		}
+
+		if v, ok := tfMap["system_log_level"].(string); ok && v != "" {
+			apiObject.SystemLogLevel = types.SystemLogLevel(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenLoggingConfig(apiObject *types.LoggingConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"application_log_level": string(apiObject.ApplicationLogLevel),
+		"log_format":            string(apiObject.LogFormat),
+		"log_group":             aws.ToString(apiObject.LogGroup),
+		"system_log_level":      string(apiObject.SystemLogLevel),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandArchitectures(tfList []interface{}) []types.Architecture {
 	vs := make([]types.Architecture, 0, len(tfList))
 	for _, v := range tfList {
@@ -0,0 +1,101 @@
+package lambda
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+)
+
+// See https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html#runtime-support-policy.
+
+var runtimeDeprecationDates = map[types.Runtime]string{
+	types.RuntimeDotnet6:      "2024-12-20",
+	types.RuntimeDotnetcore10: "2019-07-30",
+	types.RuntimeDotnetcore20: "2019-05-30",
+	types.RuntimeDotnetcore21: "2022-01-05",
+	types.RuntimeDotnetcore31: "2023-04-03",
+	types.RuntimeGo1x:         "2024-01-08",
+	types.RuntimeJava8:        "2024-01-08",
+	types.RuntimeJava8al2:     "2026-06-30",
+	types.RuntimeJava11:       "2026-06-30",
+	types.RuntimeJava17:       "2026-06-30",
+	types.RuntimeNodejs:       "2016-10-31",
+	types.RuntimeNodejs43:     "2020-03-05",
+	types.RuntimeNodejs43edge: "2020-04-30",
+	types.RuntimeNodejs610:    "2019-08-12",
+	types.RuntimeNodejs810:    "2020-03-06",
+	types.RuntimeNodejs10x:    "2021-07-30",
+	types.RuntimeNodejs12x:    "2023-03-31",
+	types.RuntimeNodejs14x:    "2023-12-04",
+	types.RuntimeNodejs16x:    "2024-06-12",
+	types.RuntimeNodejs18x:    "2025-09-01",
+	types.RuntimeProvided:     "2024-01-08",
+	types.RuntimeProvidedal2:  "2026-06-30",
+	types.RuntimePython27:     "2021-07-15",
+	types.RuntimePython36:     "2022-07-18",
+	types.RuntimePython37:     "2023-12-04",
+	types.RuntimePython38:     "2024-10-14",
+	types.RuntimePython39:     "2025-12-15",
+	types.RuntimePython310:    "2026-06-30",
+	types.RuntimeRuby25:       "2021-07-30",
+	types.RuntimeRuby27:       "2023-12-07",
+	types.RuntimeRuby32:       "2026-03-31",
+}
+
+const runtimeDeprecationDateLayout = "2006-01-02"
+
+// @SDKDataSource("aws_lambda_runtime")
+func DataSourceRuntime() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRuntimeRead,
+
+		Schema: map[string]*schema.Schema{
+			"deprecated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"deprecation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.Runtime](),
+			},
+		},
+	}
+}
+
+func dataSourceRuntimeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+	deprecationDate := runtimeDeprecationDates[types.Runtime(name)]
+
+	d.SetId(name)
+	d.Set("deprecated", runtimeDeprecated(deprecationDate, time.Now()))
+	d.Set("deprecation_date", deprecationDate)
+
+	return diags
+}
+
+// runtimeDeprecated returns whether a runtime with the specified deprecation date is deprecated at the specified time.
+// An empty deprecation date means that no deprecation has been scheduled.
+func runtimeDeprecated(deprecationDate string, now time.Time) bool {
+	if deprecationDate == "" {
+		return false
+	}
+
+	t, err := time.Parse(runtimeDeprecationDateLayout, deprecationDate)
+
+	if err != nil {
+		return false
+	}
+
+	return !now.UTC().Before(t)
+}
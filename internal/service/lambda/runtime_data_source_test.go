@@ -0,0 +1,40 @@
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaRuntimeDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_lambda_runtime.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeDataSourceConfig_basic("python2.7"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "deprecated", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "deprecation_date", "2021-07-15"),
+					resource.TestCheckResourceAttr(dataSourceName, "id", "python2.7"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "python2.7"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRuntimeDataSourceConfig_basic(name string) string {
+	return fmt.Sprintf(`
+data "aws_lambda_runtime" "test" {
+  name = %[1]q
+}
+`, name)
+}
@@ -49,6 +49,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceLayerVersion,
 			TypeName: "aws_lambda_layer_version",
 		},
+		{
+			Factory:  DataSourceRuntime,
+			TypeName: "aws_lambda_runtime",
+		},
 	}
 }
 
@@ -0,0 +1,205 @@
+package logs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudwatch_log_delivery_destination", name="Delivery Destination")
+// @Tags(identifierAttribute="arn")
+func resourceDeliveryDestination() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeliveryDestinationPut,
+		ReadWithoutTimeout:   resourceDeliveryDestinationRead,
+		UpdateWithoutTimeout: resourceDeliveryDestinationPut,
+		DeleteWithoutTimeout: resourceDeliveryDestinationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_destination_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_resource_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"delivery_destination_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"output_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cloudwatchlogs.OutputFormat_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDeliveryDestinationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn()
+
+	if d.IsNewResource() || d.HasChangesExcept("tags", "tags_all") {
+		name := d.Get("name").(string)
+		input := &cloudwatchlogs.PutDeliveryDestinationInput{
+			DeliveryDestinationConfiguration: expandDeliveryDestinationConfiguration(d.Get("delivery_destination_configuration").([]interface{})),
+			Name:                             aws.String(name),
+		}
+
+		if v, ok := d.GetOk("output_format"); ok {
+			input.OutputFormat = aws.String(v.(string))
+		}
+
+		if d.IsNewResource() {
+			input.Tags = GetTagsIn(ctx)
+		}
+
+		_, err := conn.PutDeliveryDestinationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("putting CloudWatch Logs Delivery Destination (%s): %s", name, err)
+		}
+
+		if d.IsNewResource() {
+			d.SetId(name)
+		}
+	}
+
+	return resourceDeliveryDestinationRead(ctx, d, meta)
+}
+
+func resourceDeliveryDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn()
+
+	destination, err := FindDeliveryDestinationByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Delivery Destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch Logs Delivery Destination (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", destination.Arn)
+	if err := d.Set("delivery_destination_configuration", flattenDeliveryDestinationConfiguration(destination.DeliveryDestinationConfiguration)); err != nil {
+		return diag.Errorf("setting delivery_destination_configuration: %s", err)
+	}
+	d.Set("delivery_destination_type", destination.DeliveryDestinationType)
+	d.Set("name", destination.Name)
+	d.Set("output_format", destination.OutputFormat)
+
+	return nil
+}
+
+func resourceDeliveryDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn()
+
+	log.Printf("[INFO] Deleting CloudWatch Logs Delivery Destination: %s", d.Id())
+	_, err := conn.DeleteDeliveryDestinationWithContext(ctx, &cloudwatchlogs.DeleteDeliveryDestinationInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting CloudWatch Logs Delivery Destination (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindDeliveryDestinationByName(ctx context.Context, conn *cloudwatchlogs.CloudWatchLogs, name string) (*cloudwatchlogs.DeliveryDestination, error) {
+	input := &cloudwatchlogs.GetDeliveryDestinationInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetDeliveryDestinationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DeliveryDestination == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DeliveryDestination, nil
+}
+
+func expandDeliveryDestinationConfiguration(tfList []interface{}) *cloudwatchlogs.DeliveryDestinationConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &cloudwatchlogs.DeliveryDestinationConfiguration{}
+
+	if v, ok := tfMap["destination_resource_arn"].(string); ok && v != "" {
+		apiObject.DestinationResourceArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDeliveryDestinationConfiguration(apiObject *cloudwatchlogs.DeliveryDestinationConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"destination_resource_arn": aws.StringValue(apiObject.DestinationResourceArn),
+	}
+
+	return []interface{}{tfMap}
+}
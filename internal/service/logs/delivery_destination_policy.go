@@ -0,0 +1,157 @@
+package logs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_cloudwatch_log_delivery_destination_policy")
+func resourceDeliveryDestinationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeliveryDestinationPolicyPut,
+		ReadWithoutTimeout:   resourceDeliveryDestinationPolicyRead,
+		UpdateWithoutTimeout: resourceDeliveryDestinationPolicyPut,
+		DeleteWithoutTimeout: resourceDeliveryDestinationPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"delivery_destination_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"delivery_destination_policy": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceDeliveryDestinationPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn()
+
+	policy, err := structure.NormalizeJsonString(d.Get("delivery_destination_policy").(string))
+
+	if err != nil {
+		return diag.Errorf("policy (%s) is invalid JSON: %s", policy, err)
+	}
+
+	name := d.Get("delivery_destination_name").(string)
+	input := &cloudwatchlogs.PutDeliveryDestinationPolicyInput{
+		DeliveryDestinationName:   aws.String(name),
+		DeliveryDestinationPolicy: aws.String(policy),
+	}
+
+	_, err = conn.PutDeliveryDestinationPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("putting CloudWatch Logs Delivery Destination Policy (%s): %s", name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(name)
+	}
+
+	return resourceDeliveryDestinationPolicyRead(ctx, d, meta)
+}
+
+func resourceDeliveryDestinationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn()
+
+	policy, err := FindDeliveryDestinationPolicyByDeliveryDestinationName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Delivery Destination Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch Logs Delivery Destination Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("delivery_destination_name", d.Id())
+
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("delivery_destination_policy").(string), aws.StringValue(policy.DeliveryDestinationPolicy))
+
+	if err != nil {
+		return diag.Errorf("while setting policy (%s), encountered: %s", policyToSet, err)
+	}
+
+	policyToSet, err = structure.NormalizeJsonString(policyToSet)
+
+	if err != nil {
+		return diag.Errorf("policy (%s) is invalid JSON: %s", policyToSet, err)
+	}
+
+	d.Set("delivery_destination_policy", policyToSet)
+
+	return nil
+}
+
+func resourceDeliveryDestinationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn()
+
+	log.Printf("[INFO] Deleting CloudWatch Logs Delivery Destination Policy: %s", d.Id())
+	_, err := conn.DeleteDeliveryDestinationPolicyWithContext(ctx, &cloudwatchlogs.DeleteDeliveryDestinationPolicyInput{
+		DeliveryDestinationName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting CloudWatch Logs Delivery Destination Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindDeliveryDestinationPolicyByDeliveryDestinationName(ctx context.Context, conn *cloudwatchlogs.CloudWatchLogs, name string) (*cloudwatchlogs.Policy, error) {
+	input := &cloudwatchlogs.GetDeliveryDestinationPolicyInput{
+		DeliveryDestinationName: aws.String(name),
+	}
+
+	output, err := conn.GetDeliveryDestinationPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil || aws.StringValue(output.Policy.DeliveryDestinationPolicy) == "" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Policy, nil
+}
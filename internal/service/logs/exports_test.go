@@ -2,13 +2,17 @@ package logs
 
 // Exports for use in tests only.
 var (
-	ResourceDataProtectionPolicy = resourceDataProtectionPolicy
-	ResourceDestination          = resourceDestination
-	ResourceDestinationPolicy    = resourceDestinationPolicy
-	ResourceGroup                = resourceGroup
-	ResourceMetricFilter         = resourceMetricFilter
-	ResourceQueryDefinition      = resourceQueryDefinition
-	ResourceResourcePolicy       = resourceResourcePolicy
-	ResourceStream               = resourceStream
-	ResourceSubscriptionFilter   = resourceSubscriptionFilter
+	ResourceDataProtectionPolicy      = resourceDataProtectionPolicy
+	ResourceDelivery                  = resourceDelivery
+	ResourceDeliveryDestination       = resourceDeliveryDestination
+	ResourceDeliveryDestinationPolicy = resourceDeliveryDestinationPolicy
+	ResourceDeliverySource            = resourceDeliverySource
+	ResourceDestination               = resourceDestination
+	ResourceDestinationPolicy         = resourceDestinationPolicy
+	ResourceGroup                     = resourceGroup
+	ResourceMetricFilter              = resourceMetricFilter
+	ResourceQueryDefinition           = resourceQueryDefinition
+	ResourceResourcePolicy            = resourceResourcePolicy
+	ResourceStream                    = resourceStream
+	ResourceSubscriptionFilter        = resourceSubscriptionFilter
 )
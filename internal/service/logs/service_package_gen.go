@@ -42,6 +42,34 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceDataProtectionPolicy,
 			TypeName: "aws_cloudwatch_log_data_protection_policy",
 		},
+		{
+			Factory:  resourceDelivery,
+			TypeName: "aws_cloudwatch_log_delivery",
+			Name:     "Delivery",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  resourceDeliveryDestination,
+			TypeName: "aws_cloudwatch_log_delivery_destination",
+			Name:     "Delivery Destination",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  resourceDeliveryDestinationPolicy,
+			TypeName: "aws_cloudwatch_log_delivery_destination_policy",
+		},
+		{
+			Factory:  resourceDeliverySource,
+			TypeName: "aws_cloudwatch_log_delivery_source",
+			Name:     "Delivery Source",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  resourceDestination,
 			TypeName: "aws_cloudwatch_log_destination",
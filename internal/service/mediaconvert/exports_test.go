@@ -0,0 +1,6 @@
+package mediaconvert
+
+// Exports for use in tests only.
+var (
+	FindJobByID = findJobByID
+)
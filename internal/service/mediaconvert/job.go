@@ -0,0 +1,293 @@
+package mediaconvert
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_media_convert_job", name="Job")
+func ResourceJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobCreate,
+		ReadWithoutTimeout:   resourceJobRead,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_template": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(-50, 50),
+			},
+			"queue": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				// The queue can be configured by name or ARN but is always read back as an ARN.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.HasSuffix(old, "queues/"+new)
+				},
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"settings": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, err := GetAccountClient(ctx, meta.(*conns.AWSClient))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Media Convert Account Client: %s", err)
+	}
+
+	settings, err := expandJobSettings(d.Get("settings").(string))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Media Convert Job: %s", err)
+	}
+
+	input := &mediaconvert.CreateJobInput{
+		Priority: aws.Int64(int64(d.Get("priority").(int))),
+		Role:     aws.String(d.Get("role").(string)),
+		Settings: settings,
+	}
+
+	if v, ok := d.GetOk("job_template"); ok {
+		input.JobTemplate = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("queue"); ok {
+		input.Queue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_metadata"); ok && len(v.(map[string]interface{})) > 0 {
+		input.UserMetadata = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	output, err := conn.CreateJobWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Media Convert Job: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Job.Id))
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := waitJobComplete(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Media Convert Job (%s) complete: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceJobRead(ctx, d, meta)...)
+}
+
+func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, err := GetAccountClient(ctx, meta.(*conns.AWSClient))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Media Convert Account Client: %s", err)
+	}
+
+	job, err := findJobByID(ctx, conn, d.Id())
+
+	// MediaConvert only keeps job history for 90 days. Keep the last known
+	// state rather than submitting the job again once its record expires.
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Media Convert Job (%s) not found, keeping last known state", d.Id())
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Media Convert Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", job.Arn)
+	if job.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(job.CreatedAt).Format(time.RFC3339))
+	}
+	d.Set("job_template", job.JobTemplate)
+	d.Set("priority", job.Priority)
+	d.Set("queue", job.Queue)
+	d.Set("role", job.Role)
+	d.Set("status", job.Status)
+	d.Set("user_metadata", aws.StringValueMap(job.UserMetadata))
+
+	return diags
+}
+
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, err := GetAccountClient(ctx, meta.(*conns.AWSClient))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Media Convert Account Client: %s", err)
+	}
+
+	// Only jobs that have not finished can be canceled.
+	// Finished jobs are removed from state and age out of the job history.
+	switch d.Get("status").(string) {
+	case mediaconvert.JobStatusComplete, mediaconvert.JobStatusCanceled, mediaconvert.JobStatusError:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Canceling Media Convert Job: %s", d.Id())
+	_, err = conn.CancelJobWithContext(ctx, &mediaconvert.CancelJobInput{
+		Id: aws.String(d.Id()),
+	})
+
+	// A ConflictException is returned if the job finished in the meantime.
+	if tfawserr.ErrCodeEquals(err, mediaconvert.ErrCodeNotFoundException, mediaconvert.ErrCodeConflictException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "canceling Media Convert Job (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findJobByID(ctx context.Context, conn *mediaconvert.MediaConvert, id string) (*mediaconvert.Job, error) {
+	input := &mediaconvert.GetJobInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetJobWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mediaconvert.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Job == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Job, nil
+}
+
+func statusJob(ctx context.Context, conn *mediaconvert.MediaConvert, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitJobComplete(ctx context.Context, conn *mediaconvert.MediaConvert, id string, timeout time.Duration) (*mediaconvert.Job, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{mediaconvert.JobStatusSubmitted, mediaconvert.JobStatusProgressing},
+		Target:     []string{mediaconvert.JobStatusComplete},
+		Refresh:    statusJob(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconvert.Job); ok {
+		if status := aws.StringValue(output.Status); status == mediaconvert.JobStatusError {
+			tfresource.SetLastError(err, fmt.Errorf("%d: %s", aws.Int64Value(output.ErrorCode), aws.StringValue(output.ErrorMessage)))
+		} else if status == mediaconvert.JobStatusCanceled {
+			tfresource.SetLastError(err, errors.New("job canceled"))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// Field names are matched case-insensitively, so both the API's camelCase and the
+// console's PascalCase job settings JSON can be used.
+func expandJobSettings(rawSettings string) (*mediaconvert.JobSettings, error) {
+	var settings *mediaconvert.JobSettings
+
+	if err := json.Unmarshal([]byte(rawSettings), &settings); err != nil {
+		return nil, fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,236 @@
+package mediaconvert_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaConvertJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var job mediaconvert.Job
+	resourceName := "aws_media_convert_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconvert.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &job),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mediaconvert", regexp.MustCompile(`jobs/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "queue"),
+					resource.TestCheckResourceAttrPair(resourceName, "role", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "user_metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "user_metadata.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertJob_queue(t *testing.T) {
+	ctx := acctest.Context(t)
+	var job mediaconvert.Job
+	resourceName := "aws_media_convert_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconvert.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_queue(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttrPair(resourceName, "queue", "aws_media_convert_queue.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJobDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := tfmediaconvert.GetAccountClient(ctx, acctest.Provider.Meta().(*conns.AWSClient))
+		if err != nil {
+			return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_convert_job" {
+				continue
+			}
+
+			// Jobs remain in the job history after they are canceled or finish.
+			output, err := tfmediaconvert.FindJobByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			switch status := aws.StringValue(output.Status); status {
+			case mediaconvert.JobStatusSubmitted, mediaconvert.JobStatusProgressing:
+				return fmt.Errorf("Media Convert Job %s still %s", rs.Primary.ID, status)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJobExists(ctx context.Context, n string, v *mediaconvert.Job) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Media Convert Job ID is set")
+		}
+
+		conn, err := tfmediaconvert.GetAccountClient(ctx, acctest.Provider.Meta().(*conns.AWSClient))
+		if err != nil {
+			return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
+		}
+
+		output, err := tfmediaconvert.FindJobByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccJobConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "mediaconvert.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:GetObject", "s3:PutObject"]
+      Effect   = "Allow"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+}
+
+locals {
+  settings = jsonencode({
+    Inputs = [{
+      FileInput = "s3://${aws_s3_bucket.test.bucket}/input/test.mp4"
+    }]
+    OutputGroups = [{
+      OutputGroupSettings = {
+        Type = "FILE_GROUP_SETTINGS"
+        FileGroupSettings = {
+          Destination = "s3://${aws_s3_bucket.test.bucket}/output/"
+        }
+      }
+      Outputs = [{
+        ContainerSettings = {
+          Container = "MP4"
+        }
+        VideoDescription = {
+          CodecSettings = {
+            Codec = "H_264"
+            H264Settings = {
+              RateControlMode = "QVBR"
+              MaxBitrate      = 1000000
+            }
+          }
+        }
+      }]
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccJobConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_media_convert_job" "test" {
+  role     = aws_iam_role.test.arn
+  settings = local.settings
+
+  user_metadata = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccJobConfig_queue(rName string, priority int) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_media_convert_job" "test" {
+  role     = aws_iam_role.test.arn
+  settings = local.settings
+  queue    = aws_media_convert_queue.test.arn
+  priority = %[2]d
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, priority))
+}
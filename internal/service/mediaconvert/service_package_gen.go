@@ -25,6 +25,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceJob,
+			TypeName: "aws_media_convert_job",
+			Name:     "Job",
+		},
 		{
 			Factory:  ResourceQueue,
 			TypeName: "aws_media_convert_queue",
@@ -40,6 +40,9 @@ func ResourceFirewall() *schema.Resource {
 			customdiff.ComputedIf("firewall_status", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("subnet_mapping")
 			}),
+			customdiff.ComputedIf("endpoint_ids", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("subnet_mapping")
+			}),
 			verify.SetTagsDiff,
 		),
 
@@ -57,6 +60,11 @@ func ResourceFirewall() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"endpoint_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"encryption_configuration": encryptionConfigurationSchema(),
 			"firewall_policy_arn": {
 				Type:         schema.TypeString,
@@ -211,6 +219,9 @@ func resourceFirewallRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("arn", firewall.FirewallArn)
 	d.Set("delete_protection", firewall.DeleteProtection)
 	d.Set("description", firewall.Description)
+	if err := d.Set("endpoint_ids", flattenEndpointIDs(output.FirewallStatus)); err != nil {
+		return diag.Errorf("setting endpoint_ids: %s", err)
+	}
 	if err := d.Set("encryption_configuration", flattenEncryptionConfiguration(firewall.EncryptionConfiguration)); err != nil {
 		return diag.Errorf("setting encryption_configuration: %s", err)
 	}
@@ -552,6 +563,22 @@ func flattenFirewallStatus(status *networkfirewall.FirewallStatus) []interface{}
 	return []interface{}{m}
 }
 
+func flattenEndpointIDs(status *networkfirewall.FirewallStatus) map[string]interface{} {
+	if status == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{}, len(status.SyncStates))
+	for az, v := range status.SyncStates {
+		if v == nil || v.Attachment == nil || v.Attachment.EndpointId == nil {
+			continue
+		}
+		m[az] = aws.StringValue(v.Attachment.EndpointId)
+	}
+
+	return m
+}
+
 func flattenSyncStates(s map[string]*networkfirewall.SyncState) []interface{} {
 	if s == nil {
 		return nil
@@ -149,10 +149,11 @@ func ResourceConnectPeer() *schema.Resource {
 				Computed: true,
 			},
 			"inside_cidr_blocks": {
-				Type:     schema.TypeList,
-				Required: true,
-				ForceNew: true,
-				MaxItems: 2,
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     2,
+				ExactlyOneOf: []string{"inside_cidr_blocks", "subnet_arn"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validation.IsCIDR,
@@ -171,6 +172,13 @@ func ResourceConnectPeer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"subnet_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"inside_cidr_blocks", "subnet_arn"},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -181,11 +189,9 @@ func resourceConnectPeerCreate(ctx context.Context, d *schema.ResourceData, meta
 	conn := meta.(*conns.AWSClient).NetworkManagerConn()
 
 	connectAttachmentID := d.Get("connect_attachment_id").(string)
-	insideCIDRBlocks := flex.ExpandStringList(d.Get("inside_cidr_blocks").([]interface{}))
 	peerAddress := d.Get("peer_address").(string)
 	input := &networkmanager.CreateConnectPeerInput{
 		ConnectAttachmentId: aws.String(connectAttachmentID),
-		InsideCidrBlocks:    insideCIDRBlocks,
 		PeerAddress:         aws.String(peerAddress),
 		Tags:                GetTagsIn(ctx),
 	}
@@ -198,6 +204,14 @@ func resourceConnectPeerCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.CoreNetworkAddress = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("inside_cidr_blocks"); ok && len(v.([]interface{})) > 0 {
+		input.InsideCidrBlocks = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("subnet_arn"); ok {
+		input.SubnetArn = aws.String(v.(string))
+	}
+
 	outputRaw, err := tfresource.RetryWhen(ctx, d.Timeout(schema.TimeoutCreate),
 		func() (interface{}, error) {
 			return conn.CreateConnectPeerWithContext(ctx, input)
@@ -275,6 +289,7 @@ func resourceConnectPeerRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("inside_cidr_blocks", connectPeer.Configuration.InsideCidrBlocks)
 	d.Set("peer_address", connectPeer.Configuration.PeerAddress)
 	d.Set("state", connectPeer.State)
+	d.Set("subnet_arn", connectPeer.SubnetArn)
 
 	SetTagsOut(ctx, connectPeer.Tags)
 
@@ -356,21 +371,7 @@ func flattenPeerConfiguration(apiObject *networkmanager.ConnectPeerConfiguration
 	confMap := map[string]interface{}{}
 
 	if v := apiObject.BgpConfigurations; v != nil {
-		bgpConfMap := map[string]interface{}{}
-
-		if a := v[0].CoreNetworkAddress; a != nil {
-			bgpConfMap["core_network_address"] = aws.StringValue(a)
-		}
-		if a := v[0].CoreNetworkAsn; a != nil {
-			bgpConfMap["core_network_asn"] = aws.Int64Value(a)
-		}
-		if a := v[0].PeerAddress; a != nil {
-			bgpConfMap["peer_address"] = aws.StringValue(a)
-		}
-		if a := v[0].PeerAsn; a != nil {
-			bgpConfMap["peer_asn"] = aws.Int64Value(a)
-		}
-		confMap["bgp_configurations"] = []interface{}{bgpConfMap}
+		confMap["bgp_configurations"] = flattenPeerBGPConfigurations(v)
 	}
 	if v := apiObject.CoreNetworkAddress; v != nil {
 		confMap["core_network_address"] = aws.StringValue(v)
@@ -388,6 +389,35 @@ func flattenPeerConfiguration(apiObject *networkmanager.ConnectPeerConfiguration
 	return confMap
 }
 
+func flattenPeerBGPConfigurations(apiObjects []*networkmanager.ConnectPeerBgpConfiguration) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.CoreNetworkAddress; v != nil {
+			tfMap["core_network_address"] = aws.StringValue(v)
+		}
+		if v := apiObject.CoreNetworkAsn; v != nil {
+			tfMap["core_network_asn"] = aws.Int64Value(v)
+		}
+		if v := apiObject.PeerAddress; v != nil {
+			tfMap["peer_address"] = aws.StringValue(v)
+		}
+		if v := apiObject.PeerAsn; v != nil {
+			tfMap["peer_asn"] = aws.Int64Value(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func statusConnectPeerState(ctx context.Context, conn *networkmanager.NetworkManager, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindConnectPeerByID(ctx, conn, id)
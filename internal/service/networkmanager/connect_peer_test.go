@@ -41,7 +41,7 @@ func TestAccNetworkManagerConnectPeer_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "configuration.0.inside_cidr_blocks.0", insideCidrBlocksv4),
 					resource.TestCheckResourceAttr(resourceName, "configuration.0.peer_address", peerAddress),
 					resource.TestCheckResourceAttr(resourceName, "configuration.0.protocol", "GRE"),
-					resource.TestCheckResourceAttr(resourceName, "configuration.0.bgp_configurations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.bgp_configurations.#", "2"),
 					resource.TestCheckResourceAttrSet(resourceName, "connect_attachment_id"),
 					resource.TestCheckResourceAttr(resourceName, "inside_cidr_blocks.0", insideCidrBlocksv4),
 					resource.TestCheckResourceAttr(resourceName, "peer_address", peerAddress),
@@ -85,7 +85,7 @@ func TestAccNetworkManagerConnectPeer_noDependsOn(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "configuration.0.inside_cidr_blocks.0", insideCidrBlocksv4),
 					resource.TestCheckResourceAttr(resourceName, "configuration.0.peer_address", peerAddress),
 					resource.TestCheckResourceAttr(resourceName, "configuration.0.protocol", "GRE"),
-					resource.TestCheckResourceAttr(resourceName, "configuration.0.bgp_configurations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.bgp_configurations.#", "2"),
 					resource.TestCheckResourceAttrSet(resourceName, "connect_attachment_id"),
 					resource.TestCheckResourceAttr(resourceName, "inside_cidr_blocks.0", insideCidrBlocksv4),
 					resource.TestCheckResourceAttr(resourceName, "peer_address", peerAddress),
@@ -104,6 +104,39 @@ func TestAccNetworkManagerConnectPeer_noDependsOn(t *testing.T) {
 	})
 }
 
+func TestAccNetworkManagerConnectPeer_subnetARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v networkmanager.ConnectPeer
+	resourceName := "aws_networkmanager_connect_peer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	asn := "65501"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectPeerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectPeerConfig_subnetARN(rName, asn),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectPeerExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.inside_cidr_blocks.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.protocol", "NO_ENCAP"),
+					resource.TestCheckResourceAttr(resourceName, "inside_cidr_blocks.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_arn", "aws_subnet.test.0", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccNetworkManagerConnectPeer_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v networkmanager.ConnectPeer
@@ -204,6 +237,10 @@ func testAccCheckConnectPeerDestroy(ctx context.Context) resource.TestCheckFunc
 }
 
 func testAccConnectPeerConfig_base(rName string) string {
+	return testAccConnectPeerConfig_baseProtocol(rName, "GRE")
+}
+
+func testAccConnectPeerConfig_baseProtocol(rName, protocol string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 data "aws_region" "current" {}
 
@@ -298,7 +335,7 @@ resource "aws_networkmanager_connect_attachment" "test" {
   transport_attachment_id = aws_networkmanager_vpc_attachment.test.id
   edge_location           = aws_networkmanager_vpc_attachment.test.edge_location
   options {
-    protocol = "GRE"
+    protocol = %[2]q
   }
   tags = {
     segment = "shared"
@@ -312,7 +349,7 @@ resource "aws_networkmanager_attachment_accepter" "test2" {
   attachment_id   = aws_networkmanager_connect_attachment.test.id
   attachment_type = aws_networkmanager_connect_attachment.test.attachment_type
 }
-`, rName))
+`, rName, protocol))
 }
 
 func testAccConnectPeerConfig_basic(rName string, insideCidrBlocks string, peerAddress string, asn string) string {
@@ -336,6 +373,28 @@ resource "aws_networkmanager_connect_peer" "test" {
 `, rName, insideCidrBlocks, peerAddress, asn))
 }
 
+func testAccConnectPeerConfig_subnetARN(rName string, asn string) string {
+	return acctest.ConfigCompose(testAccConnectPeerConfig_baseProtocol(rName, "NO_ENCAP"), fmt.Sprintf(`
+resource "aws_networkmanager_connect_peer" "test" {
+  connect_attachment_id = aws_networkmanager_connect_attachment.test.id
+  peer_address          = cidrhost(aws_subnet.test[0].cidr_block, 10)
+  subnet_arn            = aws_subnet.test[0].arn
+
+  bgp_options {
+    peer_asn = %[2]q
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [
+    "aws_networkmanager_attachment_accepter.test"
+  ]
+}
+`, rName, asn))
+}
+
 func testAccConnectPeerConfig_noDependsOn(rName string, insideCidrBlocks string, peerAddress string, asn string) string {
 	return acctest.ConfigCompose(testAccConnectPeerConfig_base(rName), fmt.Sprintf(`
 resource "aws_networkmanager_connect_peer" "test" {
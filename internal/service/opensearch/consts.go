@@ -2,6 +2,8 @@ package opensearch
 
 import (
 	"time"
+
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
 )
 
 const (
@@ -9,3 +11,12 @@ const (
 	// especially with acceptance tests
 	propagationTimeout = 10 * time.Minute
 )
+
+const (
+	// Custom plugin packages are not yet modeled in the AWS SDK's PackageType enum.
+	packageTypeZipPlugin = "ZIP-PLUGIN"
+)
+
+func packageType_Values() []string {
+	return append(opensearchservice.PackageType_Values(), packageTypeZipPlugin)
+}
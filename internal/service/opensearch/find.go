@@ -33,3 +33,101 @@ func FindDomainByName(ctx context.Context, conn *opensearchservice.OpenSearchSer
 
 	return output.DomainStatus, nil
 }
+
+func FindPackageByID(ctx context.Context, conn *opensearchservice.OpenSearchService, id string) (*opensearchservice.PackageDetails, error) {
+	input := &opensearchservice.DescribePackagesInput{
+		Filters: []*opensearchservice.DescribePackagesFilter{
+			{
+				Name:  aws.String(opensearchservice.DescribePackagesFilterNamePackageId),
+				Value: aws.StringSlice([]string{id}),
+			},
+		},
+	}
+
+	var output []*opensearchservice.PackageDetails
+
+	err := conn.DescribePackagesPagesWithContext(ctx, input, func(page *opensearchservice.DescribePackagesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PackageDetailsList {
+			if v != nil && aws.StringValue(v.PackageID) == id {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	if status := aws.StringValue(output[0].PackageStatus); status == opensearchservice.PackageStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output[0], nil
+}
+
+func FindPackageAssociationByTwoPartKey(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string) (*opensearchservice.DomainPackageDetails, error) {
+	input := &opensearchservice.ListPackagesForDomainInput{
+		DomainName: aws.String(domainName),
+	}
+
+	var output []*opensearchservice.DomainPackageDetails
+
+	err := conn.ListPackagesForDomainPagesWithContext(ctx, input, func(page *opensearchservice.ListPackagesForDomainOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DomainPackageDetailsList {
+			if v != nil && aws.StringValue(v.PackageID) == packageID {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
@@ -0,0 +1,209 @@
+package opensearch
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_opensearch_package")
+func ResourcePackage() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageCreate,
+		ReadWithoutTimeout:   resourcePackageRead,
+		UpdateWithoutTimeout: resourcePackageUpdate,
+		DeleteWithoutTimeout: resourcePackageDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"available_package_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"package_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 28),
+					validation.StringMatch(regexp.MustCompile(`^[a-z][0-9a-z\-]+$`), "must start with a lowercase letter and contain only lowercase letters, numbers and hyphens"),
+				),
+			},
+			"package_source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_bucket_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+						"s3_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+			"package_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(packageType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourcePackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn()
+
+	name := d.Get("package_name").(string)
+	input := &opensearchservice.CreatePackageInput{
+		PackageName:   aws.String(name),
+		PackageSource: expandPackageSource(d.Get("package_source").([]interface{})),
+		PackageType:   aws.String(d.Get("package_type").(string)),
+	}
+
+	if v, ok := d.GetOk("package_description"); ok {
+		input.PackageDescription = aws.String(v.(string))
+	}
+
+	output, err := conn.CreatePackageWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating OpenSearch Package (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.PackageDetails.PackageID))
+
+	if _, err := waitPackageAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Package (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePackageRead(ctx, d, meta)...)
+}
+
+func resourcePackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn()
+
+	pkg, err := FindPackageByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Package (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Package (%s): %s", d.Id(), err)
+	}
+
+	d.Set("available_package_version", pkg.AvailablePackageVersion)
+	d.Set("package_description", pkg.PackageDescription)
+	d.Set("package_id", pkg.PackageID)
+	d.Set("package_name", pkg.PackageName)
+	d.Set("package_type", pkg.PackageType)
+	// The package source is not returned by the API.
+
+	return diags
+}
+
+func resourcePackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn()
+
+	input := &opensearchservice.UpdatePackageInput{
+		PackageDescription: aws.String(d.Get("package_description").(string)),
+		PackageID:          aws.String(d.Id()),
+		PackageSource:      expandPackageSource(d.Get("package_source").([]interface{})),
+	}
+
+	_, err := conn.UpdatePackageWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating OpenSearch Package (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPackageAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Package (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePackageRead(ctx, d, meta)...)
+}
+
+func resourcePackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn()
+
+	log.Printf("[INFO] Deleting OpenSearch Package: %s", d.Id())
+	_, err := conn.DeletePackageWithContext(ctx, &opensearchservice.DeletePackageInput{
+		PackageID: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Package (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPackageDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Package (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandPackageSource(tfList []interface{}) *opensearchservice.PackageSource {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &opensearchservice.PackageSource{}
+
+	if v, ok := tfMap["s3_bucket_name"].(string); ok && v != "" {
+		apiObject.S3BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_key"].(string); ok && v != "" {
+		apiObject.S3Key = aws.String(v)
+	}
+
+	return apiObject
+}
@@ -0,0 +1,188 @@
+package opensearch
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	packageAssociationIDPartCount = 2
+)
+
+// @SDKResource("aws_opensearch_package_association")
+func ResourcePackageAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageAssociationCreate,
+		ReadWithoutTimeout:   resourcePackageAssociationRead,
+		UpdateWithoutTimeout: resourcePackageAssociationUpdate,
+		DeleteWithoutTimeout: resourcePackageAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"package_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"package_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"reference_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePackageAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn()
+
+	domainName := d.Get("domain_name").(string)
+	packageID := d.Get("package_id").(string)
+	id, err := flex.FlattenResourceId([]string{domainName, packageID}, packageAssociationIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := associatePackage(ctx, conn, domainName, packageID); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating OpenSearch Package Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitPackageAssociationCreated(ctx, conn, domainName, packageID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Package Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePackageAssociationRead(ctx, d, meta)...)
+}
+
+func resourcePackageAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn()
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageAssociationIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domainName, packageID := parts[0], parts[1]
+	association, err := FindPackageAssociationByTwoPartKey(ctx, conn, domainName, packageID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Package Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Package Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("domain_name", association.DomainName)
+	d.Set("package_id", association.PackageID)
+	d.Set("package_version", association.PackageVersion)
+	d.Set("reference_path", association.ReferencePath)
+
+	return diags
+}
+
+func resourcePackageAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn()
+
+	domainName := d.Get("domain_name").(string)
+	packageID := d.Get("package_id").(string)
+
+	if d.HasChange("package_version") {
+		// Associating an already associated package updates the domain to the package's latest available version.
+		if err := associatePackage(ctx, conn, domainName, packageID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Package Association (%s): %s", d.Id(), err)
+		}
+
+		association, err := waitPackageAssociationCreated(ctx, conn, domainName, packageID, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Package Association (%s) update: %s", d.Id(), err)
+		}
+
+		if want, got := d.Get("package_version").(string), aws.StringValue(association.PackageVersion); want != "" && want != got {
+			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Package Association (%s): package version %s is associated, expected %s", d.Id(), got, want)
+		}
+	}
+
+	return append(diags, resourcePackageAssociationRead(ctx, d, meta)...)
+}
+
+func resourcePackageAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn()
+
+	domainName := d.Get("domain_name").(string)
+	packageID := d.Get("package_id").(string)
+
+	log.Printf("[INFO] Deleting OpenSearch Package Association: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.DissociatePackageWithContext(ctx, &opensearchservice.DissociatePackageInput{
+			DomainName: aws.String(domainName),
+			PackageID:  aws.String(packageID),
+		})
+	}, opensearchservice.ErrCodeConflictException)
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Package Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPackageAssociationDeleted(ctx, conn, domainName, packageID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Package Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// associatePackage associates a package with a domain, retrying while another package operation is in progress on the domain.
+func associatePackage(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string) error {
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.AssociatePackageWithContext(ctx, &opensearchservice.AssociatePackageInput{
+			DomainName: aws.String(domainName),
+			PackageID:  aws.String(packageID),
+		})
+	}, opensearchservice.ErrCodeConflictException)
+
+	return err
+}
@@ -0,0 +1,157 @@
+package opensearch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearch "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOpenSearchPackageAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v opensearchservice.DomainPackageDetails
+	rName := testAccRandomDomainName()
+	resourceName := "aws_opensearch_package_association.test"
+	packageResourceName := "aws_opensearch_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageAssociationConfig_basic(rName, "synonyms"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackageAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_name", "aws_opensearch_domain.test", "domain_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "package_id", packageResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "package_version", packageResourceName, "available_package_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "reference_path"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackageAssociationConfig_basic(rName, "synonyms updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackageAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "package_version", packageResourceName, "available_package_version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchPackageAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v opensearchservice.DomainPackageDetails
+	rName := testAccRandomDomainName()
+	resourceName := "aws_opensearch_package_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageAssociationConfig_basic(rName, "synonyms"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageAssociationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfopensearch.ResourcePackageAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPackageAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_opensearch_package_association" {
+				continue
+			}
+
+			_, err := tfopensearch.FindPackageAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_name"], rs.Primary.Attributes["package_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("OpenSearch Package Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPackageAssociationExists(ctx context.Context, n string, v *opensearchservice.DomainPackageDetails) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Package Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn()
+
+		output, err := tfopensearch.FindPackageAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_name"], rs.Primary.Attributes["package_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPackageAssociationConfig_basic(rName, content string) string {
+	return acctest.ConfigCompose(testAccPackageConfig_basic(rName, content), fmt.Sprintf(`
+resource "aws_opensearch_domain" "test" {
+  domain_name    = %[1]q
+  engine_version = "OpenSearch_2.5"
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+
+resource "aws_opensearch_package_association" "test" {
+  domain_name     = aws_opensearch_domain.test.domain_name
+  package_id      = aws_opensearch_package.test.id
+  package_version = aws_opensearch_package.test.available_package_version
+}
+`, rName))
+}
@@ -0,0 +1,209 @@
+package opensearch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearch "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOpenSearchPackage_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v opensearchservice.PackageDetails
+	rName := testAccRandomDomainName()
+	resourceName := "aws_opensearch_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageConfig_basic(rName, "synonyms"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackageExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "available_package_version"),
+					resource.TestCheckResourceAttr(resourceName, "package_description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "package_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "package_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "package_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "package_type", opensearchservice.PackageTypeTxtDictionary),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"package_source"},
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchPackage_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v opensearchservice.PackageDetails
+	rName := testAccRandomDomainName()
+	resourceName := "aws_opensearch_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageConfig_basic(rName, "synonyms"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfopensearch.ResourcePackage(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchPackage_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 opensearchservice.PackageDetails
+	rName := testAccRandomDomainName()
+	resourceName := "aws_opensearch_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageConfig_basic(rName, "synonyms"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageExists(ctx, resourceName, &v1),
+				),
+			},
+			{
+				Config: testAccPackageConfig_description(rName, "synonyms updated", "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageExists(ctx, resourceName, &v2),
+					testAccCheckPackageVersionChanged(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "package_description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPackageDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_opensearch_package" {
+				continue
+			}
+
+			_, err := tfopensearch.FindPackageByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("OpenSearch Package %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPackageExists(ctx context.Context, n string, v *opensearchservice.PackageDetails) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Package ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn()
+
+		output, err := tfopensearch.FindPackageByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPackageVersionChanged(before, after *opensearchservice.PackageDetails) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.AvailablePackageVersion), aws.StringValue(after.AvailablePackageVersion); before == after {
+			return fmt.Errorf("OpenSearch Package version not changed: %s", after)
+		}
+
+		return nil
+	}
+}
+
+func testAccPackageConfig_base(rName, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "synonyms-${md5(%[2]q)}.txt"
+  content = %[2]q
+}
+`, rName, content)
+}
+
+func testAccPackageConfig_basic(rName, content string) string {
+	return acctest.ConfigCompose(testAccPackageConfig_base(rName, content), fmt.Sprintf(`
+resource "aws_opensearch_package" "test" {
+  package_name = %[1]q
+  package_type = "TXT-DICTIONARY"
+
+  package_source {
+    s3_bucket_name = aws_s3_bucket.test.bucket
+    s3_key         = aws_s3_object.test.key
+  }
+}
+`, rName))
+}
+
+func testAccPackageConfig_description(rName, content, description string) string {
+	return acctest.ConfigCompose(testAccPackageConfig_base(rName, content), fmt.Sprintf(`
+resource "aws_opensearch_package" "test" {
+  package_name        = %[1]q
+  package_type        = "TXT-DICTIONARY"
+  package_description = %[2]q
+
+  package_source {
+    s3_bucket_name = aws_s3_bucket.test.bucket
+    s3_key         = aws_s3_object.test.key
+  }
+}
+`, rName, description))
+}
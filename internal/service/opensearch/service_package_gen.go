@@ -54,6 +54,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceOutboundConnection,
 			TypeName: "aws_opensearch_outbound_connection",
 		},
+		{
+			Factory:  ResourcePackage,
+			TypeName: "aws_opensearch_package",
+		},
+		{
+			Factory:  ResourcePackageAssociation,
+			TypeName: "aws_opensearch_package_association",
+		},
 	}
 }
 
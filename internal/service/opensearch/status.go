@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -54,3 +55,35 @@ func domainConfigStatus(ctx context.Context, conn *opensearchservice.OpenSearchS
 		return out, ConfigStatusExists, nil
 	}
 }
+
+func statusPackage(ctx context.Context, conn *opensearchservice.OpenSearchService, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPackageByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.PackageStatus), nil
+	}
+}
+
+func statusPackageAssociation(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPackageAssociationByTwoPartKey(ctx, conn, domainName, packageID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DomainPackageStatus), nil
+	}
+}
@@ -153,3 +153,87 @@ func waitForDomainDelete(ctx context.Context, conn *opensearchservice.OpenSearch
 
 	return err
 }
+
+func waitPackageAvailable(ctx context.Context, conn *opensearchservice.OpenSearchService, id string, timeout time.Duration) (*opensearchservice.PackageDetails, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{opensearchservice.PackageStatusCopying, opensearchservice.PackageStatusValidating},
+		Target:  []string{opensearchservice.PackageStatusAvailable},
+		Refresh: statusPackage(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.PackageDetails); ok {
+		if errorDetails := output.ErrorDetails; errorDetails != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(errorDetails.ErrorType), aws.StringValue(errorDetails.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageDeleted(ctx context.Context, conn *opensearchservice.OpenSearchService, id string, timeout time.Duration) (*opensearchservice.PackageDetails, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{opensearchservice.PackageStatusAvailable, opensearchservice.PackageStatusDeleting},
+		Target:  []string{},
+		Refresh: statusPackage(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.PackageDetails); ok {
+		if errorDetails := output.ErrorDetails; errorDetails != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(errorDetails.ErrorType), aws.StringValue(errorDetails.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageAssociationCreated(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string, timeout time.Duration) (*opensearchservice.DomainPackageDetails, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{opensearchservice.DomainPackageStatusAssociating},
+		Target:  []string{opensearchservice.DomainPackageStatusActive},
+		Refresh: statusPackageAssociation(ctx, conn, domainName, packageID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.DomainPackageDetails); ok {
+		if errorDetails := output.ErrorDetails; errorDetails != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(errorDetails.ErrorType), aws.StringValue(errorDetails.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageAssociationDeleted(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string, timeout time.Duration) (*opensearchservice.DomainPackageDetails, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{opensearchservice.DomainPackageStatusDissociating},
+		Target:  []string{},
+		Refresh: statusPackageAssociation(ctx, conn, domainName, packageID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.DomainPackageDetails); ok {
+		if errorDetails := output.ErrorDetails; errorDetails != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(errorDetails.ErrorType), aws.StringValue(errorDetails.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,546 @@
+package osis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/osis"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_osis_pipeline", name="Pipeline")
+// @Tags(identifierAttribute="arn")
+func ResourcePipeline() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePipelineCreate,
+		ReadWithoutTimeout:   resourcePipelineRead,
+		UpdateWithoutTimeout: resourcePipelineUpdate,
+		DeleteWithoutTimeout: resourcePipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ingest_endpoint_urls": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"log_publishing_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_log_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 512),
+											validation.StringMatch(regexp.MustCompile(`^\/aws\/vendedlogs\/[\.\-_/#A-Za-z0-9]+`), "must start with /aws/vendedlogs/"),
+										),
+									},
+								},
+							},
+						},
+						"is_logging_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"max_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"min_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"pipeline_configuration_body": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 24000),
+					verify.ValidStringIsJSONOrYAML,
+				),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONOrYAMLDiffs,
+			},
+			"pipeline_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 28),
+					validation.StringMatch(regexp.MustCompile(`^[a-z][a-z0-9\-]+$`), "must start with a lowercase letter and contain only lowercase letters, numbers and hyphens"),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vpc_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 12,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 12,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			resourcePipelineCustomizeDiffValidateConfiguration,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchIngestionConn()
+
+	name := d.Get("pipeline_name").(string)
+	input := &osis.CreatePipelineInput{
+		MaxUnits:                  aws.Int64(int64(d.Get("max_units").(int))),
+		MinUnits:                  aws.Int64(int64(d.Get("min_units").(int))),
+		PipelineConfigurationBody: aws.String(d.Get("pipeline_configuration_body").(string)),
+		PipelineName:              aws.String(name),
+		Tags:                      GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("log_publishing_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LogPublishingOptions = expandLogPublishingOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VpcOptions = expandVPCOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.CreatePipelineWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating OpenSearch Ingestion Pipeline (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitPipelineCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Ingestion Pipeline (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePipelineRead(ctx, d, meta)...)
+}
+
+func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchIngestionConn()
+
+	pipeline, err := FindPipelineByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Ingestion Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Ingestion Pipeline (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", pipeline.PipelineArn)
+	d.Set("ingest_endpoint_urls", aws.StringValueSlice(pipeline.IngestEndpointUrls))
+	if pipeline.LogPublishingOptions != nil {
+		if err := d.Set("log_publishing_options", []interface{}{flattenLogPublishingOptions(pipeline.LogPublishingOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting log_publishing_options: %s", err)
+		}
+	} else {
+		d.Set("log_publishing_options", nil)
+	}
+	d.Set("max_units", pipeline.MaxUnits)
+	d.Set("min_units", pipeline.MinUnits)
+	d.Set("pipeline_configuration_body", pipeline.PipelineConfigurationBody)
+	d.Set("pipeline_name", pipeline.PipelineName)
+	if len(pipeline.VpcEndpoints) > 0 && pipeline.VpcEndpoints[0].VpcOptions != nil {
+		if err := d.Set("vpc_options", []interface{}{flattenVPCOptions(pipeline.VpcEndpoints[0].VpcOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting vpc_options: %s", err)
+		}
+	} else {
+		d.Set("vpc_options", nil)
+	}
+
+	return diags
+}
+
+func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchIngestionConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &osis.UpdatePipelineInput{
+			PipelineName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("log_publishing_options") {
+			if v, ok := d.GetOk("log_publishing_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.LogPublishingOptions = expandLogPublishingOptions(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.LogPublishingOptions = &osis.LogPublishingOptions{
+					IsLoggingEnabled: aws.Bool(false),
+				}
+			}
+		}
+
+		if d.HasChange("max_units") {
+			input.MaxUnits = aws.Int64(int64(d.Get("max_units").(int)))
+		}
+
+		if d.HasChange("min_units") {
+			input.MinUnits = aws.Int64(int64(d.Get("min_units").(int)))
+		}
+
+		if d.HasChange("pipeline_configuration_body") {
+			input.PipelineConfigurationBody = aws.String(d.Get("pipeline_configuration_body").(string))
+		}
+
+		_, err := conn.UpdatePipelineWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Ingestion Pipeline (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitPipelineUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Ingestion Pipeline (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePipelineRead(ctx, d, meta)...)
+}
+
+func resourcePipelineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchIngestionConn()
+
+	log.Printf("[INFO] Deleting OpenSearch Ingestion Pipeline: %s", d.Id())
+	_, err := conn.DeletePipelineWithContext(ctx, &osis.DeletePipelineInput{
+		PipelineName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, osis.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Ingestion Pipeline (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPipelineDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Ingestion Pipeline (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourcePipelineCustomizeDiffValidateConfiguration validates a new or changed
+// pipeline_configuration_body with the ValidatePipeline API, so that an invalid
+// configuration is reported at plan time.
+func resourcePipelineCustomizeDiffValidateConfiguration(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("pipeline_configuration_body") {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChange("pipeline_configuration_body") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).OpenSearchIngestionConn()
+
+	output, err := conn.ValidatePipelineWithContext(ctx, &osis.ValidatePipelineInput{
+		PipelineConfigurationBody: aws.String(diff.Get("pipeline_configuration_body").(string)),
+	})
+
+	// The configuration is only rejected when the service reports it as invalid. Any other error,
+	// e.g. missing osis:ValidatePipeline permission, leaves validation to the create or update call.
+	if err != nil {
+		log.Printf("[WARN] Unable to validate OpenSearch Ingestion Pipeline configuration: %s", err)
+		return nil
+	}
+
+	if output == nil || output.IsValid == nil || aws.BoolValue(output.IsValid) {
+		return nil
+	}
+
+	var messages []string
+
+	for _, v := range output.Errors {
+		if v != nil {
+			messages = append(messages, aws.StringValue(v.Message))
+		}
+	}
+
+	return fmt.Errorf("invalid pipeline_configuration_body: %s", strings.Join(messages, "; "))
+}
+
+func FindPipelineByName(ctx context.Context, conn *osis.OSIS, name string) (*osis.Pipeline, error) {
+	input := &osis.GetPipelineInput{
+		PipelineName: aws.String(name),
+	}
+
+	output, err := conn.GetPipelineWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, osis.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Pipeline == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Pipeline, nil
+}
+
+func statusPipeline(ctx context.Context, conn *osis.OSIS, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPipelineByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitPipelineCreated(ctx context.Context, conn *osis.OSIS, name string, timeout time.Duration) (*osis.Pipeline, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{osis.PipelineStatusCreating, osis.PipelineStatusStarting},
+		Target:     []string{osis.PipelineStatusActive},
+		Refresh:    statusPipeline(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*osis.Pipeline); ok {
+		if reason := output.StatusReason; reason != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(reason.Description)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPipelineUpdated(ctx context.Context, conn *osis.OSIS, name string, timeout time.Duration) (*osis.Pipeline, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{osis.PipelineStatusUpdating},
+		Target:     []string{osis.PipelineStatusActive},
+		Refresh:    statusPipeline(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*osis.Pipeline); ok {
+		if reason := output.StatusReason; reason != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(reason.Description)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPipelineDeleted(ctx context.Context, conn *osis.OSIS, name string, timeout time.Duration) (*osis.Pipeline, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{osis.PipelineStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusPipeline(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*osis.Pipeline); ok {
+		if reason := output.StatusReason; reason != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(reason.Description)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandLogPublishingOptions(tfMap map[string]interface{}) *osis.LogPublishingOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &osis.LogPublishingOptions{}
+
+	if v, ok := tfMap["cloudwatch_log_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CloudWatchLogDestination = expandCloudWatchLogDestination(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["is_logging_enabled"].(bool); ok {
+		apiObject.IsLoggingEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandCloudWatchLogDestination(tfMap map[string]interface{}) *osis.CloudWatchLogDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &osis.CloudWatchLogDestination{}
+
+	if v, ok := tfMap["log_group"].(string); ok && v != "" {
+		apiObject.LogGroup = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandVPCOptions(tfMap map[string]interface{}) *osis.VpcOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &osis.VpcOptions{}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenLogPublishingOptions(apiObject *osis.LogPublishingOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CloudWatchLogDestination; v != nil {
+		tfMap["cloudwatch_log_destination"] = []interface{}{flattenCloudWatchLogDestination(v)}
+	}
+
+	if v := apiObject.IsLoggingEnabled; v != nil {
+		tfMap["is_logging_enabled"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenCloudWatchLogDestination(apiObject *osis.CloudWatchLogDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LogGroup; v != nil {
+		tfMap["log_group"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenVPCOptions(apiObject *osis.VpcOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SecurityGroupIds; v != nil {
+		tfMap["security_group_ids"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.SubnetIds; v != nil {
+		tfMap["subnet_ids"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,76 @@
+package osis
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/osis"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_osis_pipeline_blueprint")
+func DataSourcePipelineBlueprint() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePipelineBlueprintRead,
+
+		Schema: map[string]*schema.Schema{
+			"blueprint_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"pipeline_configuration_body": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePipelineBlueprintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchIngestionConn()
+
+	name := d.Get("blueprint_name").(string)
+	blueprint, err := findPipelineBlueprintByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Ingestion Pipeline Blueprint (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(blueprint.BlueprintName))
+	d.Set("blueprint_name", blueprint.BlueprintName)
+	d.Set("pipeline_configuration_body", blueprint.PipelineConfigurationBody)
+
+	return diags
+}
+
+func findPipelineBlueprintByName(ctx context.Context, conn *osis.OSIS, name string) (*osis.PipelineBlueprint, error) {
+	input := &osis.GetPipelineBlueprintInput{
+		BlueprintName: aws.String(name),
+	}
+
+	output, err := conn.GetPipelineBlueprintWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, osis.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Blueprint == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Blueprint, nil
+}
@@ -0,0 +1,35 @@
+package osis_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/osis"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccOpenSearchIngestionPipelineBlueprintDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_osis_pipeline_blueprint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, osis.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, osis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineBlueprintDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "blueprint_name", "AWS-DynamoDBChangeDataCapturePipeline"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "pipeline_configuration_body"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPipelineBlueprintDataSourceConfig_basic = `
+data "aws_osis_pipeline_blueprint" "test" {
+  blueprint_name = "AWS-DynamoDBChangeDataCapturePipeline"
+}
+`
@@ -0,0 +1,408 @@
+package osis_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/osis"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfosis "github.com/hashicorp/terraform-provider-aws/internal/service/osis"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOpenSearchIngestionPipeline_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v osis.Pipeline
+	rName := fmt.Sprintf("%s-%s", acctest.ResourcePrefix, sdkacctest.RandString(10))
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, osis.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, osis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "osis", regexp.MustCompile(`pipeline/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "ingest_endpoint_urls.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "min_units", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "pipeline_configuration_body"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_options.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchIngestionPipeline_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v osis.Pipeline
+	rName := fmt.Sprintf("%s-%s", acctest.ResourcePrefix, sdkacctest.RandString(10))
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, osis.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, osis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfosis.ResourcePipeline(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchIngestionPipeline_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v osis.Pipeline
+	rName := fmt.Sprintf("%s-%s", acctest.ResourcePrefix, sdkacctest.RandString(10))
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, osis.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, osis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipelineConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPipelineConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchIngestionPipeline_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v osis.Pipeline
+	rName := fmt.Sprintf("%s-%s", acctest.ResourcePrefix, sdkacctest.RandString(10))
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, osis.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, osis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_units", "1"),
+				),
+			},
+			{
+				Config: testAccPipelineConfig_logPublishing(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_options.0.cloudwatch_log_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_publishing_options.0.cloudwatch_log_destination.0.log_group", "aws_cloudwatch_log_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_options.0.is_logging_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "max_units", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchIngestionPipeline_invalidConfigurationBody(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("%s-%s", acctest.ResourcePrefix, sdkacctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, osis.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, osis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPipelineConfig_invalidConfigurationBody(rName),
+				ExpectError: regexp.MustCompile(`contains an invalid YAML`),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchIngestionPipeline_invalidPipeline(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("%s-%s", acctest.ResourcePrefix, sdkacctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, osis.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, osis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPipelineConfig_invalidPipeline(rName),
+				ExpectError: regexp.MustCompile(`invalid pipeline_configuration_body`),
+			},
+		},
+	})
+}
+
+func testAccCheckPipelineDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchIngestionConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_osis_pipeline" {
+				continue
+			}
+
+			_, err := tfosis.FindPipelineByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("OpenSearch Ingestion Pipeline %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPipelineExists(ctx context.Context, n string, v *osis.Pipeline) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Ingestion Pipeline ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchIngestionConn()
+
+		output, err := tfosis.FindPipelineByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPipelineConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "osis-pipelines.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:PutObject"]
+      Effect   = "Allow"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+}
+
+locals {
+  pipeline_configuration_body = <<-EOT
+version: "2"
+test-pipeline:
+  source:
+    http:
+      path: "/test"
+  sink:
+    - s3:
+        aws:
+          sts_role_arn: "${aws_iam_role.test.arn}"
+          region: "${data.aws_region.current.name}"
+        bucket: "${aws_s3_bucket.test.id}"
+        threshold:
+          event_collect_timeout: "60s"
+        codec:
+          ndjson:
+EOT
+}
+`, rName)
+}
+
+func testAccPipelineConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfigBase(rName), fmt.Sprintf(`
+resource "aws_osis_pipeline" "test" {
+  pipeline_name               = %[1]q
+  pipeline_configuration_body = local.pipeline_configuration_body
+  max_units                   = 1
+  min_units                   = 1
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccPipelineConfig_logPublishing(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfigBase(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = "/aws/vendedlogs/OpenSearchIngestion/%[1]s"
+}
+
+resource "aws_osis_pipeline" "test" {
+  pipeline_name               = %[1]q
+  pipeline_configuration_body = local.pipeline_configuration_body
+  max_units                   = 2
+  min_units                   = 1
+
+  log_publishing_options {
+    is_logging_enabled = true
+
+    cloudwatch_log_destination {
+      log_group = aws_cloudwatch_log_group.test.name
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccPipelineConfig_invalidConfigurationBody(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_osis_pipeline" "test" {
+  pipeline_name               = %[1]q
+  pipeline_configuration_body = "version: \"2\"\n  test-pipeline: [\n"
+  max_units                   = 1
+  min_units                   = 1
+}
+`, rName)
+}
+
+func testAccPipelineConfig_invalidPipeline(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_osis_pipeline" "test" {
+  pipeline_name               = %[1]q
+  max_units                   = 1
+  min_units                   = 1
+  pipeline_configuration_body = <<-EOT
+version: "2"
+test-pipeline:
+  source:
+    http:
+      path: "/test"
+EOT
+}
+`, rName)
+}
+
+func testAccPipelineConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPipelineConfigBase(rName), fmt.Sprintf(`
+resource "aws_osis_pipeline" "test" {
+  pipeline_name               = %[1]q
+  pipeline_configuration_body = local.pipeline_configuration_body
+  max_units                   = 1
+  min_units                   = 1
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccPipelineConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPipelineConfigBase(rName), fmt.Sprintf(`
+resource "aws_osis_pipeline" "test" {
+  pipeline_name               = %[1]q
+  pipeline_configuration_body = local.pipeline_configuration_body
+  max_units                   = 1
+  min_units                   = 1
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -1,3 +1,8 @@
 package rds
 
-var FindDBInstanceByID = findDBInstanceByIDSDKv1
+// Exports for use in tests only.
+var (
+	FindDBInstanceByID   = findDBInstanceByIDSDKv1
+	FindIntegrationByARN = findIntegrationByARN
+	ResourceIntegration  = newResourceIntegration
+)
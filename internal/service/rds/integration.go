@@ -0,0 +1,459 @@
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Integration")
+// @Tags(identifierAttribute="arn")
+func newResourceIntegration(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceIntegration{}
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameIntegration = "Integration"
+)
+
+type resourceIntegration struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceIntegration) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_rds_integration"
+}
+
+func (r *resourceIntegration) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"additional_encryption_context": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"arn": framework.ARNAttributeComputedOnly(),
+			"data_filter": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 25600),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"integration_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"kms_key_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.ARN(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"target_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.ARN(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceIntegration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().RDSClient()
+
+	var plan resourceIntegrationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &rds.CreateIntegrationInput{
+		IntegrationName: aws.String(plan.IntegrationName.ValueString()),
+		SourceArn:       aws.String(plan.SourceARN.ValueString()),
+		Tags:            getTagsInV2(ctx),
+		TargetArn:       aws.String(plan.TargetARN.ValueString()),
+	}
+	if !plan.AdditionalEncryptionContext.IsNull() {
+		in.AdditionalEncryptionContext = flex.ExpandFrameworkStringValueMap(ctx, plan.AdditionalEncryptionContext)
+	}
+	if !plan.DataFilter.IsNull() && !plan.DataFilter.IsUnknown() {
+		in.DataFilter = aws.String(plan.DataFilter.ValueString())
+	}
+	if !plan.KMSKeyID.IsNull() && !plan.KMSKeyID.IsUnknown() {
+		in.KMSKeyId = aws.String(plan.KMSKeyID.ValueString())
+	}
+
+	out, err := conn.CreateIntegration(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionCreating, ResNameIntegration, plan.IntegrationName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil || out.IntegrationArn == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionCreating, ResNameIntegration, plan.IntegrationName.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.ID = flex.StringToFramework(ctx, out.IntegrationArn)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	integration, err := waitIntegrationCreated(ctx, conn, plan.ID.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionWaitingForCreation, ResNameIntegration, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.refreshFromOutput(ctx, integration)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceIntegration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().RDSClient()
+
+	var state resourceIntegrationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findIntegrationByARN(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"AWS Resource Not Found During Refresh",
+			fmt.Sprintf("Automatically removing from Terraform State instead of returning the error, which may trigger resource recreation. Original Error: %s", err.Error()),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionReading, ResNameIntegration, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.refreshFromOutput(ctx, out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Only data_filter and tags can be updated in-place; all other arguments force replacement.
+func (r *resourceIntegration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().RDSClient()
+
+	var plan, state resourceIntegrationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.DataFilter.IsUnknown() && !plan.DataFilter.Equal(state.DataFilter) {
+		_, err := conn.ModifyIntegration(ctx, &rds.ModifyIntegrationInput{
+			DataFilter:            aws.String(plan.DataFilter.ValueString()),
+			IntegrationIdentifier: aws.String(plan.ID.ValueString()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.RDS, create.ErrActionUpdating, ResNameIntegration, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+		integration, err := waitIntegrationUpdated(ctx, conn, plan.ID.ValueString(), updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.RDS, create.ErrActionWaitingForUpdate, ResNameIntegration, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		plan.refreshFromOutput(ctx, integration)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceIntegration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().RDSClient()
+
+	var state resourceIntegrationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeleteIntegration(ctx, &rds.DeleteIntegrationInput{
+		IntegrationIdentifier: aws.String(state.ID.ValueString()),
+	})
+	if err != nil {
+		var nfe *awstypes.IntegrationNotFoundFault
+		if errors.As(err, &nfe) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionDeleting, ResNameIntegration, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitIntegrationDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionWaitingForDeletion, ResNameIntegration, state.ID.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+func (r *resourceIntegration) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *resourceIntegration) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, req, resp)
+}
+
+func findIntegrationByARN(ctx context.Context, conn *rds.Client, arn string) (*awstypes.Integration, error) {
+	in := &rds.DescribeIntegrationsInput{
+		IntegrationIdentifier: aws.String(arn),
+	}
+	out, err := conn.DescribeIntegrations(ctx, in)
+	if err != nil {
+		var nfe *awstypes.IntegrationNotFoundFault
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+	if out == nil || len(out.Integrations) == 0 {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+	if len(out.Integrations) != 1 {
+		return nil, tfresource.NewTooManyResultsError(len(out.Integrations), in)
+	}
+
+	return &out.Integrations[0], nil
+}
+
+func statusIntegration(ctx context.Context, conn *rds.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findIntegrationByARN(ctx, conn, arn)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func waitIntegrationCreated(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*awstypes.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.IntegrationStatusCreating, awstypes.IntegrationStatusModifying),
+		Target:     enum.Slice(awstypes.IntegrationStatusActive),
+		Refresh:    statusIntegration(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.Integration); ok {
+		tfresource.SetLastError(err, integrationError(out.Errors))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitIntegrationUpdated(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*awstypes.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.IntegrationStatusModifying),
+		Target:     enum.Slice(awstypes.IntegrationStatusActive),
+		Refresh:    statusIntegration(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.Integration); ok {
+		tfresource.SetLastError(err, integrationError(out.Errors))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitIntegrationDeleted(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*awstypes.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.IntegrationStatusDeleting, awstypes.IntegrationStatusActive),
+		Target:  []string{},
+		Refresh: statusIntegration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.Integration); ok {
+		tfresource.SetLastError(err, integrationError(out.Errors))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func integrationError(apiObjects []awstypes.IntegrationError) error {
+	var errs *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.ToString(apiObject.ErrorCode), aws.ToString(apiObject.ErrorMessage)))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// getTagsInV2 returns rds service tags from Context for use with the AWS SDK for Go v2.
+// nil is returned if there are no input tags.
+func getTagsInV2(ctx context.Context) []awstypes.Tag {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		if tags := inContext.TagsIn.UnwrapOrDefault(); len(tags) > 0 {
+			apiObjects := make([]awstypes.Tag, 0, len(tags))
+
+			for k, v := range tags.Map() {
+				apiObjects = append(apiObjects, awstypes.Tag{
+					Key:   aws.String(k),
+					Value: aws.String(v),
+				})
+			}
+
+			return apiObjects
+		}
+	}
+
+	return nil
+}
+
+type resourceIntegrationData struct {
+	AdditionalEncryptionContext types.Map      `tfsdk:"additional_encryption_context"`
+	ARN                         types.String   `tfsdk:"arn"`
+	DataFilter                  types.String   `tfsdk:"data_filter"`
+	ID                          types.String   `tfsdk:"id"`
+	IntegrationName             types.String   `tfsdk:"integration_name"`
+	KMSKeyID                    types.String   `tfsdk:"kms_key_id"`
+	SourceARN                   types.String   `tfsdk:"source_arn"`
+	Status                      types.String   `tfsdk:"status"`
+	Tags                        types.Map      `tfsdk:"tags"`
+	TagsAll                     types.Map      `tfsdk:"tags_all"`
+	TargetARN                   types.String   `tfsdk:"target_arn"`
+	Timeouts                    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// refreshFromOutput writes state data from an AWS response object
+func (rd *resourceIntegrationData) refreshFromOutput(ctx context.Context, out *awstypes.Integration) {
+	if out == nil {
+		return
+	}
+
+	rd.ARN = flex.StringToFramework(ctx, out.IntegrationArn)
+	rd.ID = flex.StringToFramework(ctx, out.IntegrationArn)
+	if len(out.AdditionalEncryptionContext) > 0 {
+		rd.AdditionalEncryptionContext = flex.FlattenFrameworkStringValueMapLegacy(ctx, out.AdditionalEncryptionContext)
+	}
+	rd.DataFilter = flex.StringToFramework(ctx, out.DataFilter)
+	rd.IntegrationName = flex.StringToFramework(ctx, out.IntegrationName)
+	rd.KMSKeyID = flex.StringToFramework(ctx, out.KMSKeyId)
+	rd.SourceARN = flex.StringToFramework(ctx, out.SourceArn)
+	rd.Status = flex.StringValueToFramework(ctx, out.Status)
+	rd.TargetARN = flex.StringToFramework(ctx, out.TargetArn)
+}
@@ -0,0 +1,227 @@
+package redshift
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	customDomainAssociationIDPartCount = 2
+)
+
+// @SDKResource("aws_redshift_custom_domain_association", name="Custom Domain Association")
+func ResourceCustomDomainAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomDomainAssociationCreate,
+		ReadWithoutTimeout:   resourceCustomDomainAssociationRead,
+		UpdateWithoutTimeout: resourceCustomDomainAssociationUpdate,
+		DeleteWithoutTimeout: resourceCustomDomainAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"custom_domain_certificate_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"custom_domain_certificate_expiry_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 253),
+			},
+		},
+	}
+}
+
+func resourceCustomDomainAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	customDomainName := d.Get("custom_domain_name").(string)
+	clusterID := d.Get("cluster_identifier").(string)
+	id, err := flex.FlattenResourceId([]string{customDomainName, clusterID}, customDomainAssociationIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Redshift Custom Domain Association: %s", err)
+	}
+
+	input := &redshift.CreateCustomDomainAssociationInput{
+		ClusterIdentifier:          aws.String(clusterID),
+		CustomDomainCertificateArn: aws.String(d.Get("custom_domain_certificate_arn").(string)),
+		CustomDomainName:           aws.String(customDomainName),
+	}
+
+	_, err = conn.CreateCustomDomainAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Redshift Custom Domain Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitClusterUpdated(ctx, conn, clusterID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Redshift Cluster (%s) update: %s", clusterID, err)
+	}
+
+	return append(diags, resourceCustomDomainAssociationRead(ctx, d, meta)...)
+}
+
+func resourceCustomDomainAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	parts, err := flex.ExpandResourceId(d.Id(), customDomainAssociationIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Custom Domain Association (%s): %s", d.Id(), err)
+	}
+	customDomainName, clusterID := parts[0], parts[1]
+
+	association, certificateAssociation, err := FindCustomDomainAssociationByTwoPartKey(ctx, conn, customDomainName, clusterID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Custom Domain Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Custom Domain Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cluster_identifier", certificateAssociation.ClusterIdentifier)
+	d.Set("custom_domain_certificate_arn", association.CustomDomainCertificateArn)
+	if v := association.CustomDomainCertificateExpiryDate; v != nil {
+		d.Set("custom_domain_certificate_expiry_time", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("custom_domain_certificate_expiry_time", nil)
+	}
+	d.Set("custom_domain_name", certificateAssociation.CustomDomainName)
+
+	return diags
+}
+
+func resourceCustomDomainAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	clusterID := d.Get("cluster_identifier").(string)
+	input := &redshift.ModifyCustomDomainAssociationInput{
+		ClusterIdentifier:          aws.String(clusterID),
+		CustomDomainCertificateArn: aws.String(d.Get("custom_domain_certificate_arn").(string)),
+		CustomDomainName:           aws.String(d.Get("custom_domain_name").(string)),
+	}
+
+	_, err := conn.ModifyCustomDomainAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "modifying Redshift Custom Domain Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitClusterUpdated(ctx, conn, clusterID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Redshift Cluster (%s) update: %s", clusterID, err)
+	}
+
+	return append(diags, resourceCustomDomainAssociationRead(ctx, d, meta)...)
+}
+
+func resourceCustomDomainAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	clusterID := d.Get("cluster_identifier").(string)
+
+	log.Printf("[DEBUG] Deleting Redshift Custom Domain Association: %s", d.Id())
+	_, err := conn.DeleteCustomDomainAssociationWithContext(ctx, &redshift.DeleteCustomDomainAssociationInput{
+		ClusterIdentifier: aws.String(clusterID),
+		CustomDomainName:  aws.String(d.Get("custom_domain_name").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeClusterNotFoundFault, redshift.ErrCodeCustomDomainAssociationNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Redshift Custom Domain Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitClusterUpdated(ctx, conn, clusterID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Redshift Cluster (%s) update: %s", clusterID, err)
+	}
+
+	return diags
+}
+
+func FindCustomDomainAssociationByTwoPartKey(ctx context.Context, conn *redshift.Redshift, customDomainName, clusterID string) (*redshift.Association, *redshift.CertificateAssociation, error) {
+	input := &redshift.DescribeCustomDomainAssociationsInput{
+		CustomDomainName: aws.String(customDomainName),
+	}
+
+	output, err := conn.DescribeCustomDomainAssociationsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeClusterNotFoundFault, redshift.ErrCodeCustomDomainAssociationNotFoundFault) {
+		return nil, nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if output == nil {
+		return nil, nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, association := range output.Associations {
+		if association == nil {
+			continue
+		}
+
+		for _, certificateAssociation := range association.CertificateAssociations {
+			if certificateAssociation == nil {
+				continue
+			}
+
+			if aws.StringValue(certificateAssociation.ClusterIdentifier) == clusterID && aws.StringValue(certificateAssociation.CustomDomainName) == customDomainName {
+				return association, certificateAssociation, nil
+			}
+		}
+	}
+
+	return nil, nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
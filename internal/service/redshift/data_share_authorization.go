@@ -0,0 +1,144 @@
+package redshift
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	dataShareAuthorizationIDPartCount = 2
+)
+
+// @SDKResource("aws_redshift_data_share_authorization")
+func ResourceDataShareAuthorization() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataShareAuthorizationCreate,
+		ReadWithoutTimeout:   resourceDataShareAuthorizationRead,
+		DeleteWithoutTimeout: resourceDataShareAuthorizationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"managed_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"producer_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareAuthorizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	dataShareARN := d.Get("data_share_arn").(string)
+	consumerID := d.Get("consumer_identifier").(string)
+	id, err := flex.FlattenResourceId([]string{dataShareARN, consumerID}, dataShareAuthorizationIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Redshift Data Share Authorization: %s", err)
+	}
+
+	input := &redshift.AuthorizeDataShareInput{
+		ConsumerIdentifier: aws.String(consumerID),
+		DataShareArn:       aws.String(dataShareARN),
+	}
+
+	_, err = conn.AuthorizeDataShareWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Redshift Data Share Authorization (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceDataShareAuthorizationRead(ctx, d, meta)...)
+}
+
+func resourceDataShareAuthorizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	parts, err := flex.ExpandResourceId(d.Id(), dataShareAuthorizationIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Data Share Authorization (%s): %s", d.Id(), err)
+	}
+	dataShareARN, consumerID := parts[0], parts[1]
+
+	dataShare, association, err := FindDataShareAuthorizationByTwoPartKey(ctx, conn, dataShareARN, consumerID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Data Share Authorization (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Data Share Authorization (%s): %s", d.Id(), err)
+	}
+
+	d.Set("consumer_identifier", association.ConsumerIdentifier)
+	d.Set("data_share_arn", dataShare.DataShareArn)
+	d.Set("managed_by", dataShare.ManagedBy)
+	d.Set("producer_arn", dataShare.ProducerArn)
+	d.Set("status", association.Status)
+
+	return diags
+}
+
+func resourceDataShareAuthorizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	parts, err := flex.ExpandResourceId(d.Id(), dataShareAuthorizationIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Redshift Data Share Authorization (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Redshift Data Share Authorization: %s", d.Id())
+	_, err = conn.DeauthorizeDataShareWithContext(ctx, &redshift.DeauthorizeDataShareInput{
+		ConsumerIdentifier: aws.String(parts[1]),
+		DataShareArn:       aws.String(parts[0]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidDataShareFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Redshift Data Share Authorization (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,179 @@
+package redshift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRedshiftDataShareAuthorization_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_data_share_authorization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckDataShareAuthorizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareAuthorizationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareAuthorizationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "consumer_identifier", "data.aws_caller_identity.test", "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "producer_arn", "aws_redshiftserverless_namespace.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", redshift.DataShareStatusAuthorized),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftDataShareAuthorization_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_data_share_authorization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckDataShareAuthorizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareAuthorizationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareAuthorizationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfredshift.ResourceDataShareAuthorization(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataShareAuthorizationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_redshift_data_share_authorization" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, _, err = tfredshift.FindDataShareAuthorizationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Redshift Data Share Authorization %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDataShareAuthorizationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Data Share Authorization ID is set")
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn()
+
+		_, _, err = tfredshift.FindDataShareAuthorizationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		return err
+	}
+}
+
+// testAccDataShareConfigBase creates a data share in a Redshift Serverless namespace.
+// Data shares can only be created with SQL, so the Redshift Data API is used.
+func testAccDataShareConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+}
+
+resource "aws_redshiftdata_statement" "test" {
+  workgroup_name = aws_redshiftserverless_workgroup.test.workgroup_name
+  database       = "dev"
+  sql            = "CREATE DATASHARE tfacctest;"
+}
+
+locals {
+  data_share_arn = "arn:${data.aws_partition.current.partition}:redshift:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:datashare:${aws_redshiftserverless_namespace.test.namespace_id}/tfacctest"
+}
+`, rName)
+}
+
+func testAccDataShareAuthorizationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateAccountProvider(),
+		testAccDataShareConfigBase(rName),
+		`
+data "aws_caller_identity" "test" {
+  provider = "awsalternate"
+}
+
+resource "aws_redshift_data_share_authorization" "test" {
+  consumer_identifier = data.aws_caller_identity.test.account_id
+  data_share_arn      = local.data_share_arn
+
+  depends_on = [aws_redshiftdata_statement.test]
+}
+`)
+}
@@ -0,0 +1,197 @@
+package redshift
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	dataShareConsumerAssociationIDPartCount = 4
+)
+
+// @SDKResource("aws_redshift_data_share_consumer_association")
+func ResourceDataShareConsumerAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataShareConsumerAssociationCreate,
+		ReadWithoutTimeout:   resourceDataShareConsumerAssociationRead,
+		DeleteWithoutTimeout: resourceDataShareConsumerAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"associate_entire_account": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"associate_entire_account", "consumer_arn"},
+			},
+			"consumer_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"associate_entire_account", "consumer_arn"},
+			},
+			"consumer_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"data_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"managed_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"producer_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareConsumerAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	dataShareARN := d.Get("data_share_arn").(string)
+	associateEntireAccount := d.Get("associate_entire_account").(bool)
+	consumerARN := d.Get("consumer_arn").(string)
+	consumerRegion := d.Get("consumer_region").(string)
+	id, err := flex.FlattenResourceId([]string{dataShareARN, strconv.FormatBool(associateEntireAccount), consumerARN, consumerRegion}, dataShareConsumerAssociationIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Redshift Data Share Consumer Association: %s", err)
+	}
+
+	input := &redshift.AssociateDataShareConsumerInput{
+		DataShareArn: aws.String(dataShareARN),
+	}
+
+	if associateEntireAccount {
+		input.AssociateEntireAccount = aws.Bool(associateEntireAccount)
+	}
+
+	if consumerARN != "" {
+		input.ConsumerArn = aws.String(consumerARN)
+	}
+
+	if consumerRegion != "" {
+		input.ConsumerRegion = aws.String(consumerRegion)
+	}
+
+	_, err = conn.AssociateDataShareConsumerWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Redshift Data Share Consumer Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceDataShareConsumerAssociationRead(ctx, d, meta)...)
+}
+
+func resourceDataShareConsumerAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	parts, err := flex.ExpandResourceId(d.Id(), dataShareConsumerAssociationIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Data Share Consumer Association (%s): %s", d.Id(), err)
+	}
+	dataShareARN, consumerARN, consumerRegion := parts[0], parts[2], parts[3]
+	associateEntireAccount, err := strconv.ParseBool(parts[1])
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Data Share Consumer Association (%s): %s", d.Id(), err)
+	}
+
+	// Associations for the whole account are identified by the consumer's account ID.
+	consumerID := consumerARN
+	if associateEntireAccount {
+		consumerID = meta.(*conns.AWSClient).AccountID
+	}
+
+	dataShare, association, err := FindDataShareConsumerAssociationByThreePartKey(ctx, conn, dataShareARN, consumerID, consumerRegion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Data Share Consumer Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Data Share Consumer Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("associate_entire_account", associateEntireAccount)
+	d.Set("consumer_arn", consumerARN)
+	d.Set("consumer_region", association.ConsumerRegion)
+	d.Set("data_share_arn", dataShare.DataShareArn)
+	d.Set("managed_by", dataShare.ManagedBy)
+	d.Set("producer_arn", dataShare.ProducerArn)
+	d.Set("status", association.Status)
+
+	return diags
+}
+
+func resourceDataShareConsumerAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	parts, err := flex.ExpandResourceId(d.Id(), dataShareConsumerAssociationIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Redshift Data Share Consumer Association (%s): %s", d.Id(), err)
+	}
+
+	input := &redshift.DisassociateDataShareConsumerInput{
+		DataShareArn: aws.String(parts[0]),
+	}
+
+	if v, _ := strconv.ParseBool(parts[1]); v {
+		input.DisassociateEntireAccount = aws.Bool(v)
+	}
+
+	if v := parts[2]; v != "" {
+		input.ConsumerArn = aws.String(v)
+	}
+
+	if v := parts[3]; v != "" {
+		input.ConsumerRegion = aws.String(v)
+	}
+
+	log.Printf("[DEBUG] Deleting Redshift Data Share Consumer Association: %s", d.Id())
+	_, err = conn.DisassociateDataShareConsumerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidDataShareFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Redshift Data Share Consumer Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
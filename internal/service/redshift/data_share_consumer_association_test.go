@@ -0,0 +1,184 @@
+package redshift_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRedshiftDataShareConsumerAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_data_share_consumer_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckDataShareConsumerAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareConsumerAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareConsumerAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "associate_entire_account", "true"),
+					resource.TestCheckResourceAttr(resourceName, "consumer_arn", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "data_share_arn", "aws_redshift_data_share_authorization.test", "data_share_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "producer_arn", "aws_redshiftserverless_namespace.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", redshift.DataShareStatusActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftDataShareConsumerAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_data_share_consumer_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckDataShareConsumerAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareConsumerAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareConsumerAssociationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfredshift.ResourceDataShareConsumerAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccFindDataShareConsumerAssociation(ctx context.Context, id string) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn()
+
+	parts, err := flex.ExpandResourceId(id, 4, true)
+	if err != nil {
+		return err
+	}
+
+	consumerID := parts[2]
+	if v, _ := strconv.ParseBool(parts[1]); v {
+		consumerID = acctest.Provider.Meta().(*conns.AWSClient).AccountID
+	}
+
+	_, _, err = tfredshift.FindDataShareConsumerAssociationByThreePartKey(ctx, conn, parts[0], consumerID, parts[3])
+
+	return err
+}
+
+func testAccCheckDataShareConsumerAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_redshift_data_share_consumer_association" {
+				continue
+			}
+
+			err := testAccFindDataShareConsumerAssociation(ctx, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Redshift Data Share Consumer Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDataShareConsumerAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Data Share Consumer Association ID is set")
+		}
+
+		return testAccFindDataShareConsumerAssociation(ctx, rs.Primary.ID)
+	}
+}
+
+func testAccDataShareConsumerAssociationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_caller_identity" "producer" {
+  provider = "awsalternate"
+}
+
+resource "aws_redshiftserverless_namespace" "test" {
+  provider = "awsalternate"
+
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  provider = "awsalternate"
+
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+}
+
+resource "aws_redshiftdata_statement" "test" {
+  provider = "awsalternate"
+
+  workgroup_name = aws_redshiftserverless_workgroup.test.workgroup_name
+  database       = "dev"
+  sql            = "CREATE DATASHARE tfacctest;"
+}
+
+resource "aws_redshift_data_share_authorization" "test" {
+  provider = "awsalternate"
+
+  consumer_identifier = data.aws_caller_identity.current.account_id
+  data_share_arn      = "arn:${data.aws_partition.current.partition}:redshift:${data.aws_region.current.name}:${data.aws_caller_identity.producer.account_id}:datashare:${aws_redshiftserverless_namespace.test.namespace_id}/tfacctest"
+
+  depends_on = [aws_redshiftdata_statement.test]
+}
+
+resource "aws_redshift_data_share_consumer_association" "test" {
+  associate_entire_account = true
+  data_share_arn           = aws_redshift_data_share_authorization.test.data_share_arn
+}
+`, rName))
+}
@@ -465,3 +465,90 @@ func FindClusterSnapshotByID(ctx context.Context, conn *redshift.Redshift, id st
 
 	return output.Snapshots[0], nil
 }
+
+func findDataShare(ctx context.Context, conn *redshift.Redshift, arn string) (*redshift.DataShare, error) {
+	input := &redshift.DescribeDataSharesInput{
+		DataShareArn: aws.String(arn),
+	}
+	var output []*redshift.DataShare
+
+	err := conn.DescribeDataSharesPagesWithContext(ctx, input, func(page *redshift.DescribeDataSharesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DataShares {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidDataShareFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func findDataShareAssociation(ctx context.Context, conn *redshift.Redshift, arn string, filter func(*redshift.DataShareAssociation) bool) (*redshift.DataShare, *redshift.DataShareAssociation, error) {
+	dataShare, err := findDataShare(ctx, conn, arn)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, v := range dataShare.DataShareAssociations {
+		if v == nil || !filter(v) {
+			continue
+		}
+
+		switch status := aws.StringValue(v.Status); status {
+		case redshift.DataShareStatusDeauthorized, redshift.DataShareStatusRejected:
+			return nil, nil, &retry.NotFoundError{
+				Message: status,
+			}
+		}
+
+		return dataShare, v, nil
+	}
+
+	return nil, nil, &retry.NotFoundError{}
+}
+
+func FindDataShareAuthorizationByTwoPartKey(ctx context.Context, conn *redshift.Redshift, dataShareARN, consumerID string) (*redshift.DataShare, *redshift.DataShareAssociation, error) {
+	return findDataShareAssociation(ctx, conn, dataShareARN, func(v *redshift.DataShareAssociation) bool {
+		return aws.StringValue(v.ConsumerIdentifier) == consumerID
+	})
+}
+
+func FindDataShareConsumerAssociationByThreePartKey(ctx context.Context, conn *redshift.Redshift, dataShareARN, consumerID, consumerRegion string) (*redshift.DataShare, *redshift.DataShareAssociation, error) {
+	return findDataShareAssociation(ctx, conn, dataShareARN, func(v *redshift.DataShareAssociation) bool {
+		if aws.StringValue(v.ConsumerIdentifier) != consumerID {
+			return false
+		}
+
+		if consumerRegion != "" && aws.StringValue(v.ConsumerRegion) != consumerRegion {
+			return false
+		}
+
+		return true
+	})
+}
@@ -0,0 +1,214 @@
+package redshift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRedshiftIdcApplication_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_idc_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdcApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdcApplicationConfig_basic(rName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIdcApplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authorized_token_issuer.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "idc_display_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "idc_instance_arn", "data.aws_ssoadmin_instances.test", "arns.0"),
+					resource.TestCheckResourceAttrSet(resourceName, "idc_managed_application_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "idc_onboard_status"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity_namespace"),
+					resource.TestCheckResourceAttrSet(resourceName, "redshift_idc_application_arn"),
+					resource.TestCheckResourceAttr(resourceName, "redshift_idc_application_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "service_integration.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIdcApplication_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_idc_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdcApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdcApplicationConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdcApplicationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfredshift.ResourceIdcApplication(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIdcApplication_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	displayNameUpdated := rName + "-updated"
+	resourceName := "aws_redshift_idc_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdcApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdcApplicationConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdcApplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "idc_display_name", rName),
+				),
+			},
+			{
+				Config: testAccIdcApplicationConfig_serviceIntegration(rName, displayNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdcApplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "idc_display_name", displayNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "service_integration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_integration.0.lake_formation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_integration.0.lake_formation.0.lake_formation_query.0.authorization", redshift.ServiceAuthorizationEnabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdcApplicationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_redshift_idc_application" {
+				continue
+			}
+
+			_, err := tfredshift.FindIdcApplicationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Redshift IdC Application %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdcApplicationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift IdC Application ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn()
+
+		_, err := tfredshift.FindIdcApplicationByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccIdcApplicationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole", "sts:SetContext"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["redshift.amazonaws.com", "redshift-serverless.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+`, rName)
+}
+
+func testAccIdcApplicationConfig_basic(rName, displayName string) string {
+	return acctest.ConfigCompose(testAccIdcApplicationConfig_base(rName), fmt.Sprintf(`
+resource "aws_redshift_idc_application" "test" {
+  iam_role_arn                  = aws_iam_role.test.arn
+  idc_display_name              = %[2]q
+  idc_instance_arn              = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  redshift_idc_application_name = %[1]q
+}
+`, rName, displayName))
+}
+
+func testAccIdcApplicationConfig_serviceIntegration(rName, displayName string) string {
+	return acctest.ConfigCompose(testAccIdcApplicationConfig_base(rName), fmt.Sprintf(`
+resource "aws_redshift_idc_application" "test" {
+  iam_role_arn                  = aws_iam_role.test.arn
+  idc_display_name              = %[2]q
+  idc_instance_arn              = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  redshift_idc_application_name = %[1]q
+
+  service_integration {
+    lake_formation {
+      lake_formation_query {
+        authorization = "Enabled"
+      }
+    }
+  }
+}
+`, rName, displayName))
+}
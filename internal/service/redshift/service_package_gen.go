@@ -70,6 +70,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceCustomDomainAssociation,
+			TypeName: "aws_redshift_custom_domain_association",
+			Name:     "Custom Domain Association",
+		},
+		{
+			Factory:  ResourceDataShareAuthorization,
+			TypeName: "aws_redshift_data_share_authorization",
+		},
+		{
+			Factory:  ResourceDataShareConsumerAssociation,
+			TypeName: "aws_redshift_data_share_consumer_association",
+		},
 		{
 			Factory:  ResourceEndpointAccess,
 			TypeName: "aws_redshift_endpoint_access",
@@ -102,6 +115,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceIdcApplication,
+			TypeName: "aws_redshift_idc_application",
+			Name:     "IdC Application",
+		},
 		{
 			Factory:  ResourceParameterGroup,
 			TypeName: "aws_redshift_parameter_group",
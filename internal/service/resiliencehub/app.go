@@ -0,0 +1,404 @@
+package resiliencehub
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// appVersionDraft is the version of an application's resources that is modified before being published.
+	appVersionDraft = "draft"
+)
+
+// @SDKResource("aws_resiliencehub_app", name="App")
+// @Tags(identifierAttribute="arn")
+func ResourceApp() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppCreate,
+		ReadWithoutTimeout:   resourceAppRead,
+		UpdateWithoutTimeout: resourceAppUpdate,
+		DeleteWithoutTimeout: resourceAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_schedule": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(resiliencehub.AppAssessmentScheduleType_Values(), false),
+			},
+			"compliance_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 60),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]+$`), "must begin with an alphanumeric character and contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"resiliency_policy_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resiliency_score": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"source_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"terraform_source": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_state_file_url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn()
+
+	name := d.Get("name").(string)
+	input := &resiliencehub.CreateAppInput{
+		Name: aws.String(name),
+		Tags: GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("assessment_schedule"); ok {
+		input.AssessmentSchedule = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resiliency_policy_arn"); ok {
+		input.PolicyArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAppWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resilience Hub App (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.App.AppArn))
+
+	sourceARNs := flex.ExpandStringSet(d.Get("source_arns").(*schema.Set))
+	terraformSources := expandTerraformSources(d.Get("terraform_source").(*schema.Set).List())
+
+	if len(sourceARNs) > 0 || len(terraformSources) > 0 {
+		if err := importAppResources(ctx, conn, d.Id(), sourceARNs, terraformSources, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Resilience Hub App (%s): %s", d.Id(), err)
+		}
+
+		if err := publishAppVersion(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Resilience Hub App (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAppRead(ctx, d, meta)...)
+}
+
+func resourceAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn()
+
+	app, err := FindAppByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resilience Hub App (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resilience Hub App (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", app.AppArn)
+	d.Set("assessment_schedule", app.AssessmentSchedule)
+	d.Set("compliance_status", app.ComplianceStatus)
+	d.Set("description", app.Description)
+	d.Set("name", app.Name)
+	d.Set("resiliency_policy_arn", app.PolicyArn)
+	d.Set("resiliency_score", app.ResiliencyScore)
+	d.Set("status", app.Status)
+
+	inputSources, err := findAppInputSources(ctx, conn, d.Id(), appVersionDraft)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resilience Hub App (%s) input sources: %s", d.Id(), err)
+	}
+
+	var sourceARNs []*string
+	var terraformSources []interface{}
+
+	for _, v := range inputSources {
+		switch {
+		case v.TerraformSource != nil:
+			terraformSources = append(terraformSources, flattenTerraformSource(v.TerraformSource))
+		case v.SourceArn != nil:
+			sourceARNs = append(sourceARNs, v.SourceArn)
+		}
+	}
+
+	d.Set("source_arns", aws.StringValueSlice(sourceARNs))
+	if err := d.Set("terraform_source", terraformSources); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting terraform_source: %s", err)
+	}
+
+	SetTagsOut(ctx, app.Tags)
+
+	return diags
+}
+
+func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn()
+
+	if d.HasChanges("assessment_schedule", "description", "resiliency_policy_arn") {
+		input := &resiliencehub.UpdateAppInput{
+			AppArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("assessment_schedule") {
+			input.AssessmentSchedule = aws.String(d.Get("assessment_schedule").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("resiliency_policy_arn") {
+			if v, ok := d.GetOk("resiliency_policy_arn"); ok {
+				input.PolicyArn = aws.String(v.(string))
+			} else {
+				input.ClearResiliencyPolicyArn = aws.Bool(true)
+			}
+		}
+
+		_, err := conn.UpdateAppWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Resilience Hub App (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("source_arns", "terraform_source") {
+		o, n := d.GetChange("source_arns")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		addSourceARNs, delSourceARNs := flex.ExpandStringSet(ns.Difference(os)), flex.ExpandStringSet(os.Difference(ns))
+
+		o, n = d.GetChange("terraform_source")
+		os, ns = o.(*schema.Set), n.(*schema.Set)
+		addTerraformSources, delTerraformSources := expandTerraformSources(ns.Difference(os).List()), expandTerraformSources(os.Difference(ns).List())
+
+		for _, v := range delSourceARNs {
+			if err := deleteAppInputSource(ctx, conn, &resiliencehub.DeleteAppInputSourceInput{
+				AppArn:    aws.String(d.Id()),
+				SourceArn: v,
+			}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Resilience Hub App (%s): %s", d.Id(), err)
+			}
+		}
+
+		for _, v := range delTerraformSources {
+			if err := deleteAppInputSource(ctx, conn, &resiliencehub.DeleteAppInputSourceInput{
+				AppArn:          aws.String(d.Id()),
+				TerraformSource: v,
+			}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Resilience Hub App (%s): %s", d.Id(), err)
+			}
+		}
+
+		if len(addSourceARNs) > 0 || len(addTerraformSources) > 0 {
+			if err := importAppResources(ctx, conn, d.Id(), addSourceARNs, addTerraformSources, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Resilience Hub App (%s): %s", d.Id(), err)
+			}
+		}
+
+		if err := publishAppVersion(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Resilience Hub App (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAppRead(ctx, d, meta)...)
+}
+
+func resourceAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn()
+
+	log.Printf("[DEBUG] Deleting Resilience Hub App: %s", d.Id())
+	_, err := conn.DeleteAppWithContext(ctx, &resiliencehub.DeleteAppInput{
+		AppArn:      aws.String(d.Id()),
+		ForceDelete: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Resilience Hub App (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAppDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Resilience Hub App (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// importAppResources imports resources from the specified sources into the application's draft version.
+func importAppResources(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string, sourceARNs []*string, terraformSources []*resiliencehub.TerraformSource, timeout time.Duration) error {
+	input := &resiliencehub.ImportResourcesToDraftAppVersionInput{
+		AppArn:         aws.String(arn),
+		ImportStrategy: aws.String(resiliencehub.ResourceImportStrategyTypeAddOnly),
+	}
+
+	if len(sourceARNs) > 0 {
+		input.SourceArns = sourceARNs
+	}
+
+	if len(terraformSources) > 0 {
+		input.TerraformSources = terraformSources
+	}
+
+	if _, err := conn.ImportResourcesToDraftAppVersionWithContext(ctx, input); err != nil {
+		return fmt.Errorf("importing resources: %w", err)
+	}
+
+	if _, err := waitDraftAppVersionResourcesImported(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for resource import: %w", err)
+	}
+
+	return nil
+}
+
+func deleteAppInputSource(ctx context.Context, conn *resiliencehub.ResilienceHub, input *resiliencehub.DeleteAppInputSourceInput) error {
+	_, err := conn.DeleteAppInputSourceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting input source: %w", err)
+	}
+
+	return nil
+}
+
+func publishAppVersion(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) error {
+	_, err := conn.PublishAppVersionWithContext(ctx, &resiliencehub.PublishAppVersionInput{
+		AppArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("publishing version: %w", err)
+	}
+
+	return nil
+}
+
+func expandTerraformSources(tfList []interface{}) []*resiliencehub.TerraformSource {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*resiliencehub.TerraformSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &resiliencehub.TerraformSource{}
+
+		if v, ok := tfMap["s3_state_file_url"].(string); ok && v != "" {
+			apiObject.S3StateFileUrl = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTerraformSource(apiObject *resiliencehub.TerraformSource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_state_file_url": aws.StringValue(apiObject.S3StateFileUrl),
+	}
+
+	return tfMap
+}
@@ -0,0 +1,121 @@
+package resiliencehub
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindResiliencyPolicyByARN(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) (*resiliencehub.ResiliencyPolicy, error) {
+	input := &resiliencehub.DescribeResiliencyPolicyInput{
+		PolicyArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeResiliencyPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Policy, nil
+}
+
+func FindAppByARN(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) (*resiliencehub.App, error) {
+	input := &resiliencehub.DescribeAppInput{
+		AppArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeAppWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.App == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.App, nil
+}
+
+func findAppInputSources(ctx context.Context, conn *resiliencehub.ResilienceHub, arn, version string) ([]*resiliencehub.AppInputSource, error) {
+	input := &resiliencehub.ListAppInputSourcesInput{
+		AppArn:     aws.String(arn),
+		AppVersion: aws.String(version),
+	}
+	var output []*resiliencehub.AppInputSource
+
+	err := conn.ListAppInputSourcesPagesWithContext(ctx, input, func(page *resiliencehub.ListAppInputSourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AppInputSources {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findDraftAppVersionResourcesImportStatus(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) (*resiliencehub.DescribeDraftAppVersionResourcesImportStatusOutput, error) {
+	input := &resiliencehub.DescribeDraftAppVersionResourcesImportStatusInput{
+		AppArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeDraftAppVersionResourcesImportStatusWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
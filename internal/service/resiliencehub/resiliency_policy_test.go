@@ -0,0 +1,345 @@
+package resiliencehub_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresiliencehub "github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccResilienceHubResiliencyPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v resiliencehub.ResiliencyPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_resiliency_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResiliencyPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResiliencyPolicyConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "resiliencehub", regexp.MustCompile(`resiliency-policy/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "data_location_constraint", resiliencehub.DataLocationConstraintAnyLocation),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "estimated_cost_tier"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.az.0.rpo_in_secs", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.az.0.rto_in_secs", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.hardware.0.rpo_in_secs", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.hardware.0.rto_in_secs", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.region.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.software.0.rpo_in_secs", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.software.0.rto_in_secs", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tier", resiliencehub.ResiliencyPolicyTierNonCritical),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubResiliencyPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v resiliencehub.ResiliencyPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_resiliency_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResiliencyPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResiliencyPolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfresiliencehub.ResourceResiliencyPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubResiliencyPolicy_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v resiliencehub.ResiliencyPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_resiliency_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResiliencyPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResiliencyPolicyConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResiliencyPolicyConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccResiliencyPolicyConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubResiliencyPolicy_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v resiliencehub.ResiliencyPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_resiliency_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResiliencyPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResiliencyPolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.region.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tier", resiliencehub.ResiliencyPolicyTierNonCritical),
+				),
+			},
+			{
+				Config: testAccResiliencyPolicyConfig_full(rNameUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "data_location_constraint", resiliencehub.DataLocationConstraintSameContinent),
+					resource.TestCheckResourceAttr(resourceName, "description", "Mission critical workloads"),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.az.0.rpo_in_secs", "60"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.az.0.rto_in_secs", "300"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.hardware.0.rpo_in_secs", "60"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.hardware.0.rto_in_secs", "300"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.region.0.rpo_in_secs", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.region.0.rto_in_secs", "14400"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.software.0.rpo_in_secs", "60"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.software.0.rto_in_secs", "300"),
+					resource.TestCheckResourceAttr(resourceName, "tier", resiliencehub.ResiliencyPolicyTierMissionCritical),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResiliencyPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResilienceHubConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_resiliencehub_resiliency_policy" {
+				continue
+			}
+
+			_, err := tfresiliencehub.FindResiliencyPolicyByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Resilience Hub Resiliency Policy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResiliencyPolicyExists(ctx context.Context, n string, v *resiliencehub.ResiliencyPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Resilience Hub Resiliency Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResilienceHubConn()
+
+		output, err := tfresiliencehub.FindResiliencyPolicyByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccResiliencyPolicyConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_resiliency_policy" "test" {
+  name = %[1]q
+  tier = "NonCritical"
+
+  policy {
+    az {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+
+    hardware {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+
+    software {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+  }
+}
+`, rName)
+}
+
+func testAccResiliencyPolicyConfig_full(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_resiliency_policy" "test" {
+  name        = %[1]q
+  description = "Mission critical workloads"
+  tier        = "MissionCritical"
+
+  data_location_constraint = "SameContinent"
+
+  policy {
+    az {
+      rpo_in_secs = 60
+      rto_in_secs = 300
+    }
+
+    hardware {
+      rpo_in_secs = 60
+      rto_in_secs = 300
+    }
+
+    region {
+      rpo_in_secs = 3600
+      rto_in_secs = 14400
+    }
+
+    software {
+      rpo_in_secs = 60
+      rto_in_secs = 300
+    }
+  }
+}
+`, rName)
+}
+
+func testAccResiliencyPolicyConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_resiliency_policy" "test" {
+  name = %[1]q
+  tier = "NonCritical"
+
+  policy {
+    az {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+
+    hardware {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+
+    software {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccResiliencyPolicyConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_resiliency_policy" "test" {
+  name = %[1]q
+  tier = "NonCritical"
+
+  policy {
+    az {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+
+    hardware {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+
+    software {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,119 @@
+//go:build sweep
+// +build sweep
+
+package resiliencehub
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_resiliencehub_app", &resource.Sweeper{
+		Name: "aws_resiliencehub_app",
+		F:    sweepApps,
+	})
+
+	resource.AddTestSweepers("aws_resiliencehub_resiliency_policy", &resource.Sweeper{
+		Name: "aws_resiliencehub_resiliency_policy",
+		F:    sweepResiliencyPolicies,
+		Dependencies: []string{
+			"aws_resiliencehub_app",
+		},
+	})
+}
+
+func sweepApps(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).ResilienceHubConn()
+	input := &resiliencehub.ListAppsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	err = conn.ListAppsPagesWithContext(ctx, input, func(page *resiliencehub.ListAppsOutput, lastPage bool) bool {
+		if len(page.AppSummaries) == 0 {
+			log.Print("[DEBUG] No Resilience Hub Apps to sweep")
+			return !lastPage
+		}
+
+		for _, app := range page.AppSummaries {
+			r := ResourceApp()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(app.AppArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Resilience Hub Apps: %w", err))
+	}
+
+	if err = sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Resilience Hub Apps for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Resilience Hub Apps sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepResiliencyPolicies(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).ResilienceHubConn()
+	input := &resiliencehub.ListResiliencyPoliciesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	err = conn.ListResiliencyPoliciesPagesWithContext(ctx, input, func(page *resiliencehub.ListResiliencyPoliciesOutput, lastPage bool) bool {
+		if len(page.ResiliencyPolicies) == 0 {
+			log.Print("[DEBUG] No Resilience Hub Resiliency Policies to sweep")
+			return !lastPage
+		}
+
+		for _, policy := range page.ResiliencyPolicies {
+			r := ResourceResiliencyPolicy()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(policy.PolicyArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Resilience Hub Resiliency Policies: %w", err))
+	}
+
+	if err = sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Resilience Hub Resiliency Policies for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Resilience Hub Resiliency Policies sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
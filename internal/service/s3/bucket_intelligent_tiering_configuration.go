@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -79,16 +80,72 @@ func ResourceBucketIntelligentTieringConfiguration() *schema.Resource {
 							ValidateFunc: validation.StringInSlice(s3.IntelligentTieringAccessTier_Values(), false),
 						},
 						"days": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(intelligentTieringArchiveAccessMinDays, intelligentTieringMaxDays),
 						},
 					},
 				},
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				// Plan-time validation of the access tier windows to avoid errors at apply time.
+				if v, ok := diff.GetOk("tiering"); ok {
+					return validIntelligentTierings(v.(*schema.Set).List())
+				}
+
+				return nil
+			},
+		),
 	}
 }
 
+const (
+	intelligentTieringArchiveAccessMinDays     = 90
+	intelligentTieringDeepArchiveAccessMinDays = 180
+	intelligentTieringMaxDays                  = 730
+)
+
+func validIntelligentTierings(tfList []interface{}) error {
+	days := make(map[string]int)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		accessTier, _ := tfMap["access_tier"].(string)
+		v, _ := tfMap["days"].(int)
+
+		// Unknown values are validated at apply time.
+		if accessTier == "" || v == 0 {
+			continue
+		}
+
+		if _, ok := days[accessTier]; ok {
+			return fmt.Errorf("tiering: access_tier %s may only be specified once", accessTier)
+		}
+
+		days[accessTier] = v
+
+		if accessTier == s3.IntelligentTieringAccessTierDeepArchiveAccess && (v < intelligentTieringDeepArchiveAccessMinDays || v > intelligentTieringMaxDays) {
+			return fmt.Errorf("tiering: days for access_tier %s must be between %d and %d, got: %d", accessTier, intelligentTieringDeepArchiveAccessMinDays, intelligentTieringMaxDays, v)
+		}
+	}
+
+	if archive, ok := days[s3.IntelligentTieringAccessTierArchiveAccess]; ok {
+		if deepArchive, ok := days[s3.IntelligentTieringAccessTierDeepArchiveAccess]; ok && deepArchive <= archive {
+			return fmt.Errorf("tiering: days for access_tier %s (%d) must be greater than days for access_tier %s (%d)", s3.IntelligentTieringAccessTierDeepArchiveAccess, deepArchive, s3.IntelligentTieringAccessTierArchiveAccess, archive)
+		}
+	}
+
+	return nil
+}
+
 func resourceBucketIntelligentTieringConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Conn()
@@ -3,6 +3,7 @@ package s3_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -191,6 +192,28 @@ func TestAccS3BucketIntelligentTieringConfiguration_Filter(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketIntelligentTieringConfiguration_tieringValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketIntelligentTieringConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketIntelligentTieringConfigurationConfig_tierings(rName, 90, 120),
+				ExpectError: regexp.MustCompile(`days for access_tier DEEP_ARCHIVE_ACCESS must be between 180 and 730`),
+			},
+			{
+				Config:      testAccBucketIntelligentTieringConfigurationConfig_tierings(rName, 365, 180),
+				ExpectError: regexp.MustCompile(`must be greater than days for access_tier ARCHIVE_ACCESS`),
+			},
+		},
+	})
+}
+
 func testAccBucketIntelligentTieringConfigurationConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
@@ -406,3 +429,26 @@ func testAccCheckBucketIntelligentTieringConfigurationDestroy(ctx context.Contex
 		return nil
 	}
 }
+
+func testAccBucketIntelligentTieringConfigurationConfig_tierings(rName string, archiveDays, deepArchiveDays int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  tiering {
+    access_tier = "ARCHIVE_ACCESS"
+    days        = %[2]d
+  }
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = %[3]d
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName, archiveDays, deepArchiveDays)
+}
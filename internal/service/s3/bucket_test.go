@@ -2285,6 +2285,7 @@ func TestBucketName(t *testing.T) {
 		"bar.",
 		"foo_bar",
 		strings.Repeat("x", 64),
+		"foo--x-s3",
 	}
 
 	for _, v := range invalidDnsNames {
@@ -2313,6 +2314,7 @@ func TestBucketName(t *testing.T) {
 	invalidEastNames := []string{
 		"foo;bar",
 		strings.Repeat("x", 256),
+		"foo--usw2-az1--x-s3",
 	}
 
 	for _, v := range invalidEastNames {
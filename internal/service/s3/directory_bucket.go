@@ -0,0 +1,228 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// directoryBucketNameRegex matches directory bucket names of the form base-name--zone-id--x-s3.
+var directoryBucketNameRegex = regexp.MustCompile(`^[0-9a-z.-]+--[0-9a-z]+(-[0-9a-z]+)+` + directoryBucketNameSuffix + `$`)
+
+// @SDKResource("aws_s3_directory_bucket")
+func ResourceDirectoryBucket() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectoryBucketCreate,
+		ReadWithoutTimeout:   resourceDirectoryBucketRead,
+		DeleteWithoutTimeout: resourceDirectoryBucketDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(directoryBucketNameRegex, `must be in the format [bucket_name]--[azid]--x-s3. Use the aws_s3_bucket resource to manage general purpose buckets`),
+			},
+			"data_redundancy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      s3.DataRedundancySingleAvailabilityZone,
+				ValidateFunc: validation.StringInSlice(s3.DataRedundancy_Values(), false),
+			},
+			"location": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      s3.LocationTypeAvailabilityZone,
+							ValidateFunc: validation.StringInSlice(s3.LocationType_Values(), false),
+						},
+					},
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      s3.BucketTypeDirectory,
+				ValidateFunc: validation.StringInSlice(s3.BucketType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDirectoryBucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ExpressControlConn(ctx)
+
+	bucket := d.Get("bucket").(string)
+	input := &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+		CreateBucketConfiguration: &s3.CreateBucketConfiguration{
+			Bucket: &s3.BucketInfo{
+				DataRedundancy: aws.String(d.Get("data_redundancy").(string)),
+				Type:           aws.String(d.Get("type").(string)),
+			},
+		},
+	}
+
+	if v, ok := d.GetOk("location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		input.CreateBucketConfiguration.Location = &s3.LocationInfo{
+			Name: aws.String(tfMap["name"].(string)),
+			Type: aws.String(tfMap["type"].(string)),
+		}
+	}
+
+	_, err := conn.CreateBucketWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Directory Bucket (%s): %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return append(diags, resourceDirectoryBucketRead(ctx, d, meta)...)
+}
+
+func resourceDirectoryBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ExpressControlConn(ctx)
+
+	_, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return FindDirectoryBucket(ctx, conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Directory Bucket (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Bucket (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "s3express",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("bucket/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("bucket", d.Id())
+
+	// ListDirectoryBuckets returns only the bucket name, so the location is derived from it.
+	if _, ok := d.GetOk("location"); !ok {
+		if err := d.Set("location", []interface{}{map[string]interface{}{
+			"name": directoryBucketLocationName(d.Id()),
+			"type": s3.LocationTypeAvailabilityZone,
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting location: %s", err)
+		}
+	}
+	if _, ok := d.GetOk("data_redundancy"); !ok {
+		d.Set("data_redundancy", s3.DataRedundancySingleAvailabilityZone)
+	}
+	if _, ok := d.GetOk("type"); !ok {
+		d.Set("type", s3.BucketTypeDirectory)
+	}
+
+	return diags
+}
+
+func resourceDirectoryBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ExpressControlConn(ctx)
+
+	log.Printf("[DEBUG] Deleting S3 Directory Bucket: %s", d.Id())
+	_, err := conn.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Bucket (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDirectoryBucket(ctx context.Context, conn *s3.S3, bucket string) (*s3.Bucket, error) {
+	input := &s3.ListDirectoryBucketsInput{}
+	var output *s3.Bucket
+
+	err := conn.ListDirectoryBucketsPagesWithContext(ctx, input, func(page *s3.ListDirectoryBucketsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Buckets {
+			if v != nil && aws.StringValue(v.Name) == bucket {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// directoryBucketLocationName returns the zone ID embedded in a directory bucket name.
+func directoryBucketLocationName(bucket string) string {
+	parts := strings.Split(strings.TrimSuffix(bucket, directoryBucketNameSuffix), "--")
+
+	return parts[len(parts)-1]
+}
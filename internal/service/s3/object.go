@@ -74,6 +74,27 @@ func ResourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -103,8 +124,8 @@ func ResourceObject() *schema.Resource {
 			},
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload.
+				// The Etag then won't match raw-file MD5.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
 				Optional:      true,
 				Computed:      true,
@@ -140,6 +161,16 @@ func ResourceObject() *schema.Resource {
 				Optional:     true,
 				Elem:         &schema.Schema{Type: schema.TypeString},
 			},
+			"multipart_upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"multipart_upload_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
 			"object_lock_legal_hold_status": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -202,7 +233,7 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, objectCreationTimeout, func() (interface{}, error) {
-		return FindObjectByThreePartKey(ctx, conn, bucket, key, "")
+		return findObjectByBucketAndKey(ctx, conn, bucket, key, "", d.Get("checksum_algorithm").(string))
 	}, d.IsNewResource())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -219,6 +250,10 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.Set("bucket_key_enabled", output.BucketKeyEnabled)
 	d.Set("cache_control", output.CacheControl)
+	d.Set("checksum_crc32", output.ChecksumCRC32)
+	d.Set("checksum_crc32c", output.ChecksumCRC32C)
+	d.Set("checksum_sha1", output.ChecksumSHA1)
+	d.Set("checksum_sha256", output.ChecksumSHA256)
 	d.Set("content_disposition", output.ContentDisposition)
 	d.Set("content_encoding", output.ContentEncoding)
 	d.Set("content_language", output.ContentLanguage)
@@ -395,8 +430,19 @@ func resourceObjectImport(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Conn()
-	uploader := s3manager.NewUploaderWithClient(conn)
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		if v, ok := d.GetOk("multipart_upload_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+
+		if v, ok := d.GetOk("multipart_upload_part_size"); ok {
+			u.PartSize = int64(v.(int))
+		}
+	})
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		defaultTagsConfig = inContext.DefaultConfig
+	}
 	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	var body io.ReadSeeker
@@ -456,6 +502,10 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.CacheControl = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumAlgorithm = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("content_type"); ok {
 		input.ContentType = aws.String(v.(string))
 	}
@@ -510,7 +560,8 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ObjectLockRetainUntilDate = expandObjectDate(v.(string))
 	}
 
-	if _, err := uploader.Upload(input); err != nil {
+	// Sources that support io.ReaderAt (e.g. files) are streamed to S3 in parts without being fully read into memory.
+	if _, err := uploader.UploadWithContext(ctx, input); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading object to S3 bucket (%s): %s", bucket, err)
 	}
 
@@ -552,6 +603,14 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	if hasObjectContentChanges(d) {
+		if _, ok := d.GetOk("checksum_algorithm"); ok {
+			for _, key := range []string{"checksum_crc32", "checksum_crc32c", "checksum_sha1", "checksum_sha256"} {
+				if err := d.SetNewComputed(key); err != nil {
+					return err
+				}
+			}
+		}
+
 		return d.SetNewComputed("version_id")
 	}
 
@@ -567,6 +626,7 @@ func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_algorithm",
 		"content_base64",
 		"content_disposition",
 		"content_encoding",
@@ -787,10 +847,17 @@ func flattenObjectDate(t *time.Time) string {
 }
 
 func FindObjectByThreePartKey(ctx context.Context, conn *s3.S3, bucket, key, etag string) (*s3.HeadObjectOutput, error) {
+	return findObjectByBucketAndKey(ctx, conn, bucket, key, etag, "")
+}
+
+func findObjectByBucketAndKey(ctx context.Context, conn *s3.S3, bucket, key, etag, checksumAlgorithm string) (*s3.HeadObjectOutput, error) {
 	input := &s3.HeadObjectInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 	}
+	if checksumAlgorithm != "" {
+		input.ChecksumMode = aws.String(s3.ChecksumModeEnabled)
+	}
 	if etag != "" {
 		input.IfMatch = aws.String(etag)
 	}
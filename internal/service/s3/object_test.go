@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -1338,6 +1339,91 @@ func TestAccS3Object_ignoreTags(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_checksumAlgorithm(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, "SHA256"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "Nbr7HOma7zqwaK+6q66PIf2bnwLTqUQuNk+pLAs+7vA="),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "checksum_algorithm", "checksum_sha256", "content", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, "CRC32C"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC32C"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum_crc32c"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_multipartUpload(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// 12 MiB with a 5 MiB part size is uploaded in 3 parts.
+	source := testAccObjectCreateTempFile(t, strings.Repeat("a", 12*1024*1024))
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_multipartUpload(rName, source, 5*1024*1024, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA256"),
+					resource.TestMatchResourceAttr(resourceName, "checksum_sha256", regexp.MustCompile(`-3$`)),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload_concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload_part_size", "5242880"),
+				),
+			},
+			{
+				// Changing the upload settings does not upload the object again.
+				Config: testAccObjectConfig_multipartUpload(rName, source, 6*1024*1024, 4),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload_concurrency", "4"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload_part_size", "6291456"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObjectVersionIdDiffers(first, second *s3.GetObjectOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if first.VersionId == nil {
@@ -2170,3 +2256,36 @@ resource "aws_s3_object" "object" {
 }
 `, rName, content)
 }
+
+func testAccObjectConfig_checksumAlgorithm(rName, checksumAlgorithm string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  content            = "stuff"
+  checksum_algorithm = %[2]q
+}
+`, rName, checksumAlgorithm)
+}
+
+func testAccObjectConfig_multipartUpload(rName, source string, partSize, concurrency int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  source             = %[2]q
+  checksum_algorithm = "SHA256"
+
+  multipart_upload_part_size   = %[3]d
+  multipart_upload_concurrency = %[4]d
+}
+`, rName, source, partSize, concurrency)
+}
@@ -142,6 +142,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceBucketWebsiteConfiguration,
 			TypeName: "aws_s3_bucket_website_configuration",
 		},
+		{
+			Factory:  ResourceDirectoryBucket,
+			TypeName: "aws_s3_directory_bucket",
+		},
 		{
 			Factory:  ResourceObject,
 			TypeName: "aws_s3_object",
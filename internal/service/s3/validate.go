@@ -9,10 +9,16 @@ import (
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 )
 
+// directoryBucketNameSuffix is the suffix reserved for the names of S3 Express One Zone directory buckets.
+const directoryBucketNameSuffix = "--x-s3"
+
 // ValidBucketName validates any S3 bucket name that is not inside the us-east-1 region.
 // Buckets outside of this region have to be DNS-compliant. After the same restrictions are
 // applied to buckets in the us-east-1 region, this function can be refactored as a SchemaValidateFunc
 func ValidBucketName(value string, region string) error {
+	if strings.HasSuffix(value, directoryBucketNameSuffix) {
+		return fmt.Errorf("%q cannot end with %q, which is reserved for directory bucket names", value, directoryBucketNameSuffix)
+	}
 	if region != endpoints.UsEast1RegionID {
 		if (len(value) < 3) || (len(value) > 63) {
 			return fmt.Errorf("%q must contain from 3 to 63 characters", value)
@@ -0,0 +1,155 @@
+package s3control_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccAccessGrant_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_access_grant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "access_grant_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "access_grant_id"),
+					resource.TestCheckResourceAttr(resourceName, "access_grants_location_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_grants_location_configuration.0.s3_sub_prefix", "prefix1/prefix2/data.txt"),
+					resource.TestCheckResourceAttrPair(resourceName, "access_grants_location_id", "aws_s3control_access_grants_location.test", "access_grants_location_id"),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "grant_scope"),
+					resource.TestCheckResourceAttr(resourceName, "grantee.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "grantee.0.grantee_identifier", "aws_iam_user.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "grantee.0.grantee_type", s3control.GranteeTypeIam),
+					resource.TestCheckResourceAttr(resourceName, "permission", s3control.PermissionRead),
+					resource.TestCheckResourceAttr(resourceName, "s3_prefix_type", s3control.S3PrefixTypeObject),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"s3_prefix_type"},
+			},
+		},
+	})
+}
+
+func testAccAccessGrant_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_access_grant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3control.ResourceAccessGrant(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccessGrantDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3control_access_grant" {
+				continue
+			}
+
+			accountID, grantID, err := tfs3control.AccessGrantParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfs3control.FindAccessGrantByTwoPartKey(ctx, conn, accountID, grantID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Access Grant %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccessGrantExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		accountID, grantID, err := tfs3control.AccessGrantParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn()
+
+		_, err = tfs3control.FindAccessGrantByTwoPartKey(ctx, conn, accountID, grantID)
+
+		return err
+	}
+}
+
+func testAccAccessGrantConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccessGrantsLocationConfig_basic(rName, "test1"), fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_s3control_access_grant" "test" {
+  access_grants_location_id = aws_s3control_access_grants_location.test.access_grants_location_id
+  permission                = "READ"
+  s3_prefix_type            = "Object"
+
+  access_grants_location_configuration {
+    s3_sub_prefix = "prefix1/prefix2/data.txt"
+  }
+
+  grantee {
+    grantee_type       = "IAM"
+    grantee_identifier = aws_iam_user.test.arn
+  }
+}
+`, rName))
+}
@@ -0,0 +1,284 @@
+package s3control
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3control_access_grants_instance", name="Access Grants Instance")
+// @Tags
+func resourceAccessGrantsInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessGrantsInstanceCreate,
+		ReadWithoutTimeout:   resourceAccessGrantsInstanceRead,
+		UpdateWithoutTimeout: resourceAccessGrantsInstanceUpdate,
+		DeleteWithoutTimeout: resourceAccessGrantsInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"access_grants_instance_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_grants_instance_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"identity_center_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAccessGrantsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn()
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+	input := &s3control.CreateAccessGrantsInstanceInput{
+		AccountId: aws.String(accountID),
+		Tags:      accessGrantsTags(KeyValueTags(ctx, GetTagsIn(ctx))),
+	}
+
+	if v, ok := d.GetOk("identity_center_arn"); ok {
+		input.IdentityCenterArn = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateAccessGrantsInstanceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating S3 Access Grants Instance (%s): %s", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	return resourceAccessGrantsInstanceRead(ctx, d, meta)
+}
+
+func resourceAccessGrantsInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn()
+
+	output, err := findAccessGrantsInstance(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Access Grants Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Access Grants Instance (%s): %s", d.Id(), err)
+	}
+
+	d.Set("access_grants_instance_arn", output.AccessGrantsInstanceArn)
+	d.Set("access_grants_instance_id", output.AccessGrantsInstanceId)
+	d.Set("account_id", d.Id())
+	d.Set("identity_center_arn", output.IdentityCenterArn)
+
+	tags, err := accessGrantsListTags(ctx, conn, d.Id(), aws.StringValue(output.AccessGrantsInstanceArn))
+
+	if err != nil {
+		return diag.Errorf("listing tags for S3 Access Grants Instance (%s): %s", d.Id(), err)
+	}
+
+	SetTagsOut(ctx, Tags(tags))
+
+	return nil
+}
+
+func resourceAccessGrantsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn()
+
+	if d.HasChange("identity_center_arn") {
+		o, n := d.GetChange("identity_center_arn")
+
+		if o.(string) != "" {
+			_, err := conn.DissociateAccessGrantsIdentityCenterWithContext(ctx, &s3control.DissociateAccessGrantsIdentityCenterInput{
+				AccountId: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return diag.Errorf("dissociating S3 Access Grants Instance (%s) IAM Identity Center (%s): %s", d.Id(), o, err)
+			}
+		}
+
+		if n.(string) != "" {
+			_, err := conn.AssociateAccessGrantsIdentityCenterWithContext(ctx, &s3control.AssociateAccessGrantsIdentityCenterInput{
+				AccountId:         aws.String(d.Id()),
+				IdentityCenterArn: aws.String(n.(string)),
+			})
+
+			if err != nil {
+				return diag.Errorf("associating S3 Access Grants Instance (%s) IAM Identity Center (%s): %s", d.Id(), n, err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := accessGrantsUpdateTags(ctx, conn, d.Id(), d.Get("access_grants_instance_arn").(string), o, n); err != nil {
+			return diag.Errorf("updating S3 Access Grants Instance (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAccessGrantsInstanceRead(ctx, d, meta)
+}
+
+func resourceAccessGrantsInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn()
+
+	log.Printf("[DEBUG] Deleting S3 Access Grants Instance: %s", d.Id())
+	// An instance cannot be deleted until the locations registered in it have been deleted.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
+		return conn.DeleteAccessGrantsInstanceWithContext(ctx, &s3control.DeleteAccessGrantsInstanceInput{
+			AccountId: aws.String(d.Id()),
+		})
+	}, errCodeAccessGrantsInstanceNotEmptyError)
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting S3 Access Grants Instance (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func findAccessGrantsInstance(ctx context.Context, conn *s3control.S3Control, accountID string) (*s3control.GetAccessGrantsInstanceOutput, error) {
+	input := &s3control.GetAccessGrantsInstanceInput{
+		AccountId: aws.String(accountID),
+	}
+
+	output, err := conn.GetAccessGrantsInstanceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// Access Grants resources are tagged with the generic S3 Control Tag type
+// rather than the S3Tag type used by the generated tagging helpers.
+
+func accessGrantsTags(tags tftags.KeyValueTags) []*s3control.Tag {
+	result := make([]*s3control.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &s3control.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+func keyValueTagsFromAccessGrantsTags(ctx context.Context, tags []*s3control.Tag) tftags.KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return tftags.New(ctx, m)
+}
+
+func accessGrantsListTags(ctx context.Context, conn *s3control.S3Control, accountID, resourceARN string) (tftags.KeyValueTags, error) {
+	input := &s3control.ListTagsForResourceInput{
+		AccountId:   aws.String(accountID),
+		ResourceArn: aws.String(resourceARN),
+	}
+
+	output, err := conn.ListTagsForResourceWithContext(ctx, input)
+
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	return keyValueTagsFromAccessGrantsTags(ctx, output.Tags), nil
+}
+
+func accessGrantsUpdateTags(ctx context.Context, conn *s3control.S3Control, accountID, resourceARN string, oldTagsMap, newTagsMap any) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreSystem(names.S3Control); len(removedTags) > 0 {
+		input := &s3control.UntagResourceInput{
+			AccountId:   aws.String(accountID),
+			ResourceArn: aws.String(resourceARN),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResourceWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", resourceARN, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreSystem(names.S3Control); len(updatedTags) > 0 {
+		input := &s3control.TagResourceInput{
+			AccountId:   aws.String(accountID),
+			ResourceArn: aws.String(resourceARN),
+			Tags:        accessGrantsTags(updatedTags),
+		}
+
+		_, err := conn.TagResourceWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", resourceARN, err)
+		}
+	}
+
+	return nil
+}
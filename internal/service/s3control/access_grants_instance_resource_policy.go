@@ -0,0 +1,155 @@
+package s3control
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_s3control_access_grants_instance_resource_policy", name="Access Grants Instance Resource Policy")
+func resourceAccessGrantsInstanceResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessGrantsInstanceResourcePolicyPut,
+		ReadWithoutTimeout:   resourceAccessGrantsInstanceResourcePolicyRead,
+		UpdateWithoutTimeout: resourceAccessGrantsInstanceResourcePolicyPut,
+		DeleteWithoutTimeout: resourceAccessGrantsInstanceResourcePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"policy": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceAccessGrantsInstanceResourcePolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn()
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+	if err != nil {
+		return diag.Errorf("policy (%s) is invalid JSON: %s", d.Get("policy").(string), err)
+	}
+
+	input := &s3control.PutAccessGrantsInstanceResourcePolicyInput{
+		AccountId: aws.String(accountID),
+		Policy:    aws.String(policy),
+	}
+
+	_, err = conn.PutAccessGrantsInstanceResourcePolicyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("putting S3 Access Grants Instance Resource Policy (%s): %s", accountID, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(accountID)
+	}
+
+	return resourceAccessGrantsInstanceResourcePolicyRead(ctx, d, meta)
+}
+
+func resourceAccessGrantsInstanceResourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn()
+
+	output, err := findAccessGrantsInstanceResourcePolicy(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Access Grants Instance Resource Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Access Grants Instance Resource Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", d.Id())
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.StringValue(output.Policy))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("policy", policyToSet)
+
+	return nil
+}
+
+func resourceAccessGrantsInstanceResourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn()
+
+	log.Printf("[DEBUG] Deleting S3 Access Grants Instance Resource Policy: %s", d.Id())
+	_, err := conn.DeleteAccessGrantsInstanceResourcePolicyWithContext(ctx, &s3control.DeleteAccessGrantsInstanceResourcePolicyInput{
+		AccountId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError, errCodeAccessGrantsInstanceResourcePolicyNotExists) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting S3 Access Grants Instance Resource Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func findAccessGrantsInstanceResourcePolicy(ctx context.Context, conn *s3control.S3Control, accountID string) (*s3control.GetAccessGrantsInstanceResourcePolicyOutput, error) {
+	input := &s3control.GetAccessGrantsInstanceResourcePolicyInput{
+		AccountId: aws.String(accountID),
+	}
+
+	output, err := conn.GetAccessGrantsInstanceResourcePolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError, errCodeAccessGrantsInstanceResourcePolicyNotExists) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || aws.StringValue(output.Policy) == "" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,133 @@
+package s3control_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccAccessGrantsInstanceResourcePolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_access_grants_instance_resource_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsInstanceResourcePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsInstanceResourcePolicyConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsInstanceResourcePolicyExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAccessGrantsInstanceResourcePolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_access_grants_instance_resource_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsInstanceResourcePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsInstanceResourcePolicyConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantsInstanceResourcePolicyExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3control.ResourceAccessGrantsInstanceResourcePolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccessGrantsInstanceResourcePolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3control_access_grants_instance_resource_policy" {
+				continue
+			}
+
+			_, err := tfs3control.FindAccessGrantsInstanceResourcePolicy(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Access Grants Instance Resource Policy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccessGrantsInstanceResourcePolicyExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn()
+
+		_, err := tfs3control.FindAccessGrantsInstanceResourcePolicy(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAccessGrantsInstanceResourcePolicyConfig_basic() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3control_access_grants_instance" "test" {}
+
+resource "aws_s3control_access_grants_instance_resource_policy" "test" {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Id      = "S3AccessGrantsPolicy"
+    Statement = [{
+      Sid    = "AllowAccessToS3AccessGrants"
+      Effect = "Allow"
+      Principal = {
+        AWS = data.aws_caller_identity.current.account_id
+      }
+      Action = [
+        "s3:ListAccessGrants",
+        "s3:ListAccessGrantsLocations",
+        "s3:GetDataAccess",
+      ]
+      Resource = aws_s3control_access_grants_instance.test.access_grants_instance_arn
+    }]
+  })
+}
+`
+}
@@ -0,0 +1,261 @@
+package s3control_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Only one S3 Access Grants instance can exist per account per Region.
+func TestAccS3ControlAccessGrants_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"Instance": {
+			"basic":          testAccAccessGrantsInstance_basic,
+			"disappears":     testAccAccessGrantsInstance_disappears,
+			"tags":           testAccAccessGrantsInstance_tags,
+			"identityCenter": testAccAccessGrantsInstance_identityCenter,
+		},
+		"InstanceResourcePolicy": {
+			"basic":      testAccAccessGrantsInstanceResourcePolicy_basic,
+			"disappears": testAccAccessGrantsInstanceResourcePolicy_disappears,
+		},
+		"Location": {
+			"basic":      testAccAccessGrantsLocation_basic,
+			"disappears": testAccAccessGrantsLocation_disappears,
+			"update":     testAccAccessGrantsLocation_update,
+		},
+		"Grant": {
+			"basic":      testAccAccessGrant_basic,
+			"disappears": testAccAccessGrant_disappears,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 5*time.Second)
+}
+
+func testAccAccessGrantsInstance_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_access_grants_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsInstanceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "access_grants_instance_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "access_grants_instance_id"),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAccessGrantsInstance_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_access_grants_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsInstanceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3control.ResourceAccessGrantsInstance(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAccessGrantsInstance_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_access_grants_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsInstanceConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccessGrantsInstanceConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAccessGrantsInstanceConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessGrantsInstance_identityCenter(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_access_grants_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsInstanceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity_center_arn", ""),
+				),
+			},
+			{
+				Config: testAccAccessGrantsInstanceConfig_identityCenter(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "identity_center_arn", "data.aws_ssoadmin_instances.test", "arns.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccessGrantsInstanceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity_center_arn", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccessGrantsInstanceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3control_access_grants_instance" {
+				continue
+			}
+
+			_, err := tfs3control.FindAccessGrantsInstance(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Access Grants Instance %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccessGrantsInstanceExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn()
+
+		_, err := tfs3control.FindAccessGrantsInstance(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAccessGrantsInstanceConfig_basic() string {
+	return `
+resource "aws_s3control_access_grants_instance" "test" {}
+`
+}
+
+func testAccAccessGrantsInstanceConfig_identityCenter() string {
+	return `
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_s3control_access_grants_instance" "test" {
+  identity_center_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+`
+}
+
+func testAccAccessGrantsInstanceConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_access_grants_instance" "test" {
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAccessGrantsInstanceConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_access_grants_instance" "test" {
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
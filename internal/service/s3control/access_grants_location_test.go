@@ -0,0 +1,191 @@
+package s3control_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccAccessGrantsLocation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_access_grants_location.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsLocationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsLocationConfig_basic(rName, "test1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsLocationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "access_grants_location_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "access_grants_location_id"),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test1", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "location_scope", "s3://"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAccessGrantsLocation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_access_grants_location.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsLocationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsLocationConfig_basic(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantsLocationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3control.ResourceAccessGrantsLocation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAccessGrantsLocation_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_access_grants_location.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsLocationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsLocationConfig_basic(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantsLocationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test1", "arn"),
+				),
+			},
+			{
+				Config: testAccAccessGrantsLocationConfig_basic(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantsLocationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccessGrantsLocationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3control_access_grants_location" {
+				continue
+			}
+
+			accountID, locationID, err := tfs3control.AccessGrantsLocationParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfs3control.FindAccessGrantsLocationByTwoPartKey(ctx, conn, accountID, locationID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Access Grants Location %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccessGrantsLocationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		accountID, locationID, err := tfs3control.AccessGrantsLocationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn()
+
+		_, err = tfs3control.FindAccessGrantsLocationByTwoPartKey(ctx, conn, accountID, locationID)
+
+		return err
+	}
+}
+
+func testAccAccessGrantsLocationConfig_baseIAMRoles(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_access_grants_instance" "test" {}
+
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole", "sts:SetSourceIdentity", "sts:SetContext"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["access-grants.s3.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test1" {
+  name               = "%[1]s-1"
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+
+resource "aws_iam_role" "test2" {
+  name               = "%[1]s-2"
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+`, rName)
+}
+
+func testAccAccessGrantsLocationConfig_basic(rName, roleName string) string {
+	return acctest.ConfigCompose(testAccAccessGrantsLocationConfig_baseIAMRoles(rName), fmt.Sprintf(`
+resource "aws_s3control_access_grants_location" "test" {
+  depends_on = [aws_s3control_access_grants_instance.test]
+
+  iam_role_arn   = aws_iam_role.%[1]s.arn
+  location_scope = "s3://"
+}
+`, roleName))
+}
@@ -3,14 +3,21 @@ package s3control
 // Error code constants missing from AWS Go SDK:
 // https://docs.aws.amazon.com/sdk-for-go/api/service/s3control/#pkg-constants
 const (
-	errCodeInvalidBucketState           = "InvalidBucketState"
-	errCodeNoSuchAccessPoint            = "NoSuchAccessPoint"
-	errCodeNoSuchAccessPointPolicy      = "NoSuchAccessPointPolicy"
-	errCodeNoSuchAsyncRequest           = "NoSuchAsyncRequest"
-	errCodeNoSuchBucket                 = "NoSuchBucket"
-	errCodeNoSuchBucketPolicy           = "NoSuchBucketPolicy"
-	errCodeNoSuchLifecycleConfiguration = "NoSuchLifecycleConfiguration"
-	errCodeNoSuchMultiRegionAccessPoint = "NoSuchMultiRegionAccessPoint"
-	errCodeNoSuchOutpost                = "NoSuchOutpost"
-	errCodeNoSuchTagSet                 = "NoSuchTagSet"
+	errCodeAccessGrantNotExistsError                   = "AccessGrantNotExistsError"
+	errCodeAccessGrantsInstanceNotEmptyError           = "AccessGrantsInstanceNotEmptyError"
+	errCodeAccessGrantsInstanceNotExistsError          = "AccessGrantsInstanceNotExistsError"
+	errCodeAccessGrantsInstanceResourcePolicyNotExists = "AccessGrantsInstanceResourcePolicyNotExists"
+	errCodeAccessGrantsLocationNotEmptyError           = "AccessGrantsLocationNotEmptyError"
+	errCodeAccessGrantsLocationNotExistsError          = "AccessGrantsLocationNotExistsError"
+	errCodeInvalidBucketState                          = "InvalidBucketState"
+	errCodeInvalidIAMRole                              = "InvalidIAMRole"
+	errCodeNoSuchAccessPoint                           = "NoSuchAccessPoint"
+	errCodeNoSuchAccessPointPolicy                     = "NoSuchAccessPointPolicy"
+	errCodeNoSuchAsyncRequest                          = "NoSuchAsyncRequest"
+	errCodeNoSuchBucket                                = "NoSuchBucket"
+	errCodeNoSuchBucketPolicy                          = "NoSuchBucketPolicy"
+	errCodeNoSuchLifecycleConfiguration                = "NoSuchLifecycleConfiguration"
+	errCodeNoSuchMultiRegionAccessPoint                = "NoSuchMultiRegionAccessPoint"
+	errCodeNoSuchOutpost                               = "NoSuchOutpost"
+	errCodeNoSuchTagSet                                = "NoSuchTagSet"
 )
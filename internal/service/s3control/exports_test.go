@@ -2,15 +2,24 @@ package s3control
 
 // Exports for use in tests only.
 var (
-	ResourceAccessPoint                   = resourceAccessPoint
-	ResourceAccessPointPolicy             = resourceAccessPointPolicy
-	ResourceAccountPublicAccessBlock      = resourceAccountPublicAccessBlock
-	ResourceBucket                        = resourceBucket
-	ResourceBucketLifecycleConfiguration  = resourceBucketLifecycleConfiguration
-	ResourceBucketPolicy                  = resourceBucketPolicy
-	ResourceMultiRegionAccessPoint        = resourceMultiRegionAccessPoint
-	ResourceMultiRegionAccessPointPolicy  = resourceMultiRegionAccessPointPolicy
-	ResourceObjectLambdaAccessPoint       = resourceObjectLambdaAccessPoint
-	ResourceObjectLambdaAccessPointPolicy = resourceObjectLambdaAccessPointPolicy
-	ResourceStorageLensConfiguration      = resourceStorageLensConfiguration
+	ResourceAccessGrant                        = resourceAccessGrant
+	ResourceAccessGrantsInstance               = resourceAccessGrantsInstance
+	ResourceAccessGrantsInstanceResourcePolicy = resourceAccessGrantsInstanceResourcePolicy
+	ResourceAccessGrantsLocation               = resourceAccessGrantsLocation
+	ResourceAccessPoint                        = resourceAccessPoint
+	ResourceAccessPointPolicy                  = resourceAccessPointPolicy
+	ResourceAccountPublicAccessBlock           = resourceAccountPublicAccessBlock
+	ResourceBucket                             = resourceBucket
+	ResourceBucketLifecycleConfiguration       = resourceBucketLifecycleConfiguration
+	ResourceBucketPolicy                       = resourceBucketPolicy
+	ResourceMultiRegionAccessPoint             = resourceMultiRegionAccessPoint
+	ResourceMultiRegionAccessPointPolicy       = resourceMultiRegionAccessPointPolicy
+	ResourceObjectLambdaAccessPoint            = resourceObjectLambdaAccessPoint
+	ResourceObjectLambdaAccessPointPolicy      = resourceObjectLambdaAccessPointPolicy
+	ResourceStorageLensConfiguration           = resourceStorageLensConfiguration
+
+	FindAccessGrantByTwoPartKey            = findAccessGrantByTwoPartKey
+	FindAccessGrantsInstance               = findAccessGrantsInstance
+	FindAccessGrantsInstanceResourcePolicy = findAccessGrantsInstanceResourcePolicy
+	FindAccessGrantsLocationByTwoPartKey   = findAccessGrantsLocationByTwoPartKey
 )
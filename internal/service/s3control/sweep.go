@@ -10,13 +10,36 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func init() {
+	resource.AddTestSweepers("aws_s3control_access_grant", &resource.Sweeper{
+		Name: "aws_s3control_access_grant",
+		F:    sweepAccessGrants,
+	})
+
+	resource.AddTestSweepers("aws_s3control_access_grants_instance", &resource.Sweeper{
+		Name: "aws_s3control_access_grants_instance",
+		F:    sweepAccessGrantsInstances,
+		Dependencies: []string{
+			"aws_s3control_access_grants_location",
+		},
+	})
+
+	resource.AddTestSweepers("aws_s3control_access_grants_location", &resource.Sweeper{
+		Name: "aws_s3control_access_grants_location",
+		F:    sweepAccessGrantsLocations,
+		Dependencies: []string{
+			"aws_s3control_access_grant",
+		},
+	})
+
 	resource.AddTestSweepers("aws_s3_access_point", &resource.Sweeper{
 		Name: "aws_s3_access_point",
 		F:    sweepAccessPoints,
@@ -94,6 +117,149 @@ func sweepAccessPoints(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func sweepAccessGrants(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).S3ControlConn()
+	accountID := client.(*conns.AWSClient).AccountID
+	input := &s3control.ListAccessGrantsInput{
+		AccountId: aws.String(accountID),
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListAccessGrantsPagesWithContext(ctx, input, func(page *s3control.ListAccessGrantsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AccessGrantsList {
+			r := resourceAccessGrant()
+			d := r.Data(nil)
+			d.SetId(AccessGrantCreateResourceID(accountID, aws.StringValue(v.AccessGrantId)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError) {
+		return nil
+	}
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping S3 Access Grant sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Access Grants (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping S3 Access Grants (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepAccessGrantsInstances(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).S3ControlConn()
+	accountID := client.(*conns.AWSClient).AccountID
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	// There is at most one Access Grants instance per account and Region.
+	_, err = findAccessGrantsInstance(ctx, conn, accountID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping S3 Access Grants Instance sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Access Grants Instance (%s): %w", region, err)
+	}
+
+	r := resourceAccessGrantsInstance()
+	d := r.Data(nil)
+	d.SetId(accountID)
+
+	sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping S3 Access Grants Instances (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepAccessGrantsLocations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).S3ControlConn()
+	accountID := client.(*conns.AWSClient).AccountID
+	input := &s3control.ListAccessGrantsLocationsInput{
+		AccountId: aws.String(accountID),
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListAccessGrantsLocationsPagesWithContext(ctx, input, func(page *s3control.ListAccessGrantsLocationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AccessGrantsLocationsList {
+			r := resourceAccessGrantsLocation()
+			d := r.Data(nil)
+			d.SetId(AccessGrantsLocationCreateResourceID(accountID, aws.StringValue(v.AccessGrantsLocationId)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError) {
+		return nil
+	}
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping S3 Access Grants Location sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Access Grants Locations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping S3 Access Grants Locations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepMultiRegionAccessPoints(region string) error {
 	ctx := sweep.Context(region)
 	if region != endpoints.UsWest2RegionID {
@@ -0,0 +1,211 @@
+package servicecatalogappregistry
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_servicecatalogappregistry_application", name="Application")
+// @Tags(identifierAttribute="arn")
+func ResourceApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationCreate,
+		ReadWithoutTimeout:   resourceApplicationRead,
+		UpdateWithoutTimeout: resourceApplicationUpdate,
+		DeleteWithoutTimeout: resourceApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[-.\w]+$`), "must contain only alphanumeric, hyphen, period and underscore characters"),
+				),
+			},
+			"resource_group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn()
+
+	name := d.Get("name").(string)
+	input := &appregistry.CreateApplicationInput{
+		Name: aws.String(name),
+		Tags: GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateApplicationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Service Catalog AppRegistry Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Application.Id))
+
+	if _, err := waitApplicationResourceGroupCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Service Catalog AppRegistry Application (%s) resource group create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn()
+
+	output, err := FindApplicationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Catalog AppRegistry Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Service Catalog AppRegistry Application (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+	if output.Integrations != nil && output.Integrations.ResourceGroup != nil {
+		d.Set("resource_group_arn", output.Integrations.ResourceGroup.Arn)
+	} else {
+		d.Set("resource_group_arn", nil)
+	}
+
+	return diags
+}
+
+func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn()
+
+	if d.HasChange("description") {
+		input := &appregistry.UpdateApplicationInput{
+			Application: aws.String(d.Id()),
+			Description: aws.String(d.Get("description").(string)),
+		}
+
+		_, err := conn.UpdateApplicationWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Service Catalog AppRegistry Application (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn()
+
+	log.Printf("[INFO] Deleting Service Catalog AppRegistry Application: %s", d.Id())
+	_, err := conn.DeleteApplicationWithContext(ctx, &appregistry.DeleteApplicationInput{
+		Application: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Service Catalog AppRegistry Application (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func statusApplicationResourceGroup(ctx context.Context, conn *appregistry.AppRegistry, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindApplicationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Integrations == nil || output.Integrations.ResourceGroup == nil {
+			return output, appregistry.ResourceGroupStateCreating, nil
+		}
+
+		return output, aws.StringValue(output.Integrations.ResourceGroup.State), nil
+	}
+}
+
+func waitApplicationResourceGroupCreated(ctx context.Context, conn *appregistry.AppRegistry, id string, timeout time.Duration) (*appregistry.GetApplicationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{appregistry.ResourceGroupStateCreating},
+		Target:  []string{appregistry.ResourceGroupStateCreateComplete},
+		Refresh: statusApplicationResourceGroup(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appregistry.GetApplicationOutput); ok {
+		if output.Integrations != nil && output.Integrations.ResourceGroup != nil {
+			if v := aws.StringValue(output.Integrations.ResourceGroup.ErrorMessage); v != "" {
+				tfresource.SetLastError(err, errors.New(v))
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,7 @@
+package servicequotas
+
+// Exports for use in tests only.
+var (
+	FindTemplateAssociation = findTemplateAssociation
+	FindTemplateByID        = findTemplateByID
+)
@@ -29,6 +29,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceServiceQuota,
 			TypeName: "aws_servicequotas_service_quota",
 		},
+		{
+			Factory:  DataSourceTemplates,
+			TypeName: "aws_servicequotas_templates",
+		},
 	}
 }
 
@@ -38,6 +42,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceServiceQuota,
 			TypeName: "aws_servicequotas_service_quota",
 		},
+		{
+			Factory:  ResourceTemplate,
+			TypeName: "aws_servicequotas_template",
+		},
+		{
+			Factory:  ResourceTemplateAssociation,
+			TypeName: "aws_servicequotas_template_association",
+		},
 	}
 }
 
@@ -0,0 +1,191 @@
+package servicequotas
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_servicequotas_template")
+func ResourceTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTemplatePut,
+		ReadWithoutTimeout:   resourceTemplateRead,
+		UpdateWithoutTimeout: resourceTemplatePut,
+		DeleteWithoutTimeout: resourceTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"global_quota": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"quota_code": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z]`), "must begin with alphabetic character"),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]+$`), "must contain only alphanumeric and hyphen characters"),
+				),
+			},
+			"quota_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_code": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z]`), "must begin with alphabetic character"),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]+$`), "must contain only alphanumeric and hyphen characters"),
+				),
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"value": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceTemplatePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn()
+
+	region := d.Get("region").(string)
+	quotaCode := d.Get("quota_code").(string)
+	serviceCode := d.Get("service_code").(string)
+	id := templateCreateResourceID(region, quotaCode, serviceCode)
+
+	input := &servicequotas.PutServiceQuotaIncreaseRequestIntoTemplateInput{
+		AwsRegion:    aws.String(region),
+		DesiredValue: aws.Float64(d.Get("value").(float64)),
+		QuotaCode:    aws.String(quotaCode),
+		ServiceCode:  aws.String(serviceCode),
+	}
+
+	_, err := conn.PutServiceQuotaIncreaseRequestIntoTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Service Quotas Template (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceTemplateRead(ctx, d, meta)...)
+}
+
+func resourceTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn()
+
+	region, quotaCode, serviceCode, err := templateParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Service Quotas Template (%s): %s", d.Id(), err)
+	}
+
+	template, err := findTemplateByID(ctx, conn, region, quotaCode, serviceCode)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Quotas Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Service Quotas Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("global_quota", template.GlobalQuota)
+	d.Set("quota_code", template.QuotaCode)
+	d.Set("quota_name", template.QuotaName)
+	d.Set("region", template.AwsRegion)
+	d.Set("service_code", template.ServiceCode)
+	d.Set("service_name", template.ServiceName)
+	d.Set("unit", template.Unit)
+	d.Set("value", template.DesiredValue)
+
+	return diags
+}
+
+func resourceTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn()
+
+	region, quotaCode, serviceCode, err := templateParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Service Quotas Template (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Service Quotas Template: %s", d.Id())
+	_, err = conn.DeleteServiceQuotaIncreaseRequestFromTemplateWithContext(ctx, &servicequotas.DeleteServiceQuotaIncreaseRequestFromTemplateInput{
+		AwsRegion:   aws.String(region),
+		QuotaCode:   aws.String(quotaCode),
+		ServiceCode: aws.String(serviceCode),
+	})
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeNoSuchResourceException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Service Quotas Template (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const templateResourceIDSeparator = ","
+
+func templateCreateResourceID(region, quotaCode, serviceCode string) string {
+	parts := []string{region, quotaCode, serviceCode}
+	id := strings.Join(parts, templateResourceIDSeparator)
+
+	return id
+}
+
+func templateParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, templateResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected REGION%[2]sQUOTA-CODE%[2]sSERVICE-CODE", id, templateResourceIDSeparator)
+}
@@ -0,0 +1,107 @@
+package servicequotas
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_servicequotas_template_association")
+func ResourceTemplateAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTemplateAssociationCreate,
+		ReadWithoutTimeout:   resourceTemplateAssociationRead,
+		UpdateWithoutTimeout: resourceTemplateAssociationUpdate,
+		DeleteWithoutTimeout: resourceTemplateAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"skip_destroy": {
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTemplateAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn()
+
+	_, err := conn.AssociateServiceQuotaTemplateWithContext(ctx, &servicequotas.AssociateServiceQuotaTemplateInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Service Quotas Template Association: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return append(diags, resourceTemplateAssociationRead(ctx, d, meta)...)
+}
+
+func resourceTemplateAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn()
+
+	status, err := findTemplateAssociation(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Quotas Template Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Service Quotas Template Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("status", status)
+
+	return diags
+}
+
+func resourceTemplateAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Only skip_destroy can be updated, and it is only used during Delete.
+
+	return append(diags, resourceTemplateAssociationRead(ctx, d, meta)...)
+}
+
+func resourceTemplateAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn()
+
+	if _, ok := d.GetOk("skip_destroy"); ok {
+		log.Printf("[DEBUG] Retaining Service Quotas Template Association: %s", d.Id())
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Service Quotas Template Association: %s", d.Id())
+	_, err := conn.DisassociateServiceQuotaTemplateWithContext(ctx, &servicequotas.DisassociateServiceQuotaTemplateInput{})
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeServiceQuotaTemplateNotInUseException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Service Quotas Template Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
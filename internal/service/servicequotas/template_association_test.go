@@ -0,0 +1,142 @@
+package servicequotas_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicequotas "github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccTemplateAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicequotas_template_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateAssociationConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", servicequotas.ServiceQuotaTemplateAssociationStatusAssociated),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_destroy"},
+			},
+		},
+	})
+}
+
+func testAccTemplateAssociation_skipDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicequotas_template_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateAssociationRetained(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateAssociationConfig_skipDestroy,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "skip_destroy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicequotas.ServiceQuotaTemplateAssociationStatusAssociated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_servicequotas_template_association" {
+				continue
+			}
+
+			_, err := tfservicequotas.FindTemplateAssociation(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Service Quotas Template Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckTemplateAssociationRetained verifies that the template is still associated and then disassociates it.
+func testAccCheckTemplateAssociationRetained(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn()
+
+		if _, err := tfservicequotas.FindTemplateAssociation(ctx, conn); err != nil {
+			return err
+		}
+
+		_, err := conn.DisassociateServiceQuotaTemplateWithContext(ctx, &servicequotas.DisassociateServiceQuotaTemplateInput{})
+
+		return err
+	}
+}
+
+func testAccCheckTemplateAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Quotas Template Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn()
+
+		_, err := tfservicequotas.FindTemplateAssociation(ctx, conn)
+
+		return err
+	}
+}
+
+const testAccTemplateAssociationConfig_basic = `
+resource "aws_servicequotas_template_association" "test" {}
+`
+
+const testAccTemplateAssociationConfig_skipDestroy = `
+resource "aws_servicequotas_template_association" "test" {
+  skip_destroy = true
+}
+`
@@ -0,0 +1,192 @@
+package servicequotas_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicequotas "github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// S3 general purpose buckets
+	templateServiceCode = "s3"
+	templateQuotaCode   = "L-DC2B2D3D"
+)
+
+// Service Quotas templates are organization-wide, so tests are run serially.
+func TestAccServiceQuotasTemplate_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		"basic":       testAccTemplate_basic,
+		"disappears":  testAccTemplate_disappears,
+		"value":       testAccTemplate_value,
+		"association": testAccTemplateAssociation_basic,
+		"skipDestroy": testAccTemplateAssociation_skipDestroy,
+		"dataSource":  testAccTemplatesDataSource_basic,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicequotas_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic("200"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "region", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttr(resourceName, "quota_code", templateQuotaCode),
+					resource.TestCheckResourceAttrSet(resourceName, "quota_name"),
+					resource.TestCheckResourceAttr(resourceName, "service_code", templateServiceCode),
+					resource.TestCheckResourceAttrSet(resourceName, "service_name"),
+					resource.TestCheckResourceAttr(resourceName, "value", "200"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicequotas_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic("200"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfservicequotas.ResourceTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTemplate_value(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicequotas_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic("200"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "200"),
+				),
+			},
+			{
+				Config: testAccTemplateConfig_basic("300"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "300"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_servicequotas_template" {
+				continue
+			}
+
+			_, err := tfservicequotas.FindTemplateByID(ctx, conn, rs.Primary.Attributes["region"], rs.Primary.Attributes["quota_code"], rs.Primary.Attributes["service_code"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Service Quotas Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTemplateExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Quotas Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn()
+
+		_, err := tfservicequotas.FindTemplateByID(ctx, conn, rs.Primary.Attributes["region"], rs.Primary.Attributes["quota_code"], rs.Primary.Attributes["service_code"])
+
+		return err
+	}
+}
+
+func testAccTemplateConfig_basic(value string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_servicequotas_template" "test" {
+  region       = data.aws_region.current.name
+  quota_code   = %[1]q
+  service_code = %[2]q
+  value        = %[3]s
+}
+`, templateQuotaCode, templateServiceCode, value)
+}
@@ -0,0 +1,125 @@
+package servicequotas
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_servicequotas_templates")
+func DataSourceTemplates() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTemplatesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"service_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"templates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"global_quota": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"quota_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quota_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn()
+
+	region := d.Get("region").(string)
+	input := &servicequotas.ListServiceQuotaIncreaseRequestsInTemplateInput{
+		AwsRegion: aws.String(region),
+	}
+
+	if v, ok := d.GetOk("service_code"); ok {
+		input.ServiceCode = aws.String(v.(string))
+	}
+
+	templates, err := findTemplates(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Service Quotas Templates: %s", err)
+	}
+
+	d.SetId(region)
+
+	if err := d.Set("templates", flattenTemplates(templates)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting templates: %s", err)
+	}
+
+	return diags
+}
+
+func flattenTemplates(apiObjects []*servicequotas.ServiceQuotaIncreaseRequestInTemplate) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"global_quota": aws.BoolValue(apiObject.GlobalQuota),
+			"quota_code":   aws.StringValue(apiObject.QuotaCode),
+			"quota_name":   aws.StringValue(apiObject.QuotaName),
+			"region":       aws.StringValue(apiObject.AwsRegion),
+			"service_code": aws.StringValue(apiObject.ServiceCode),
+			"service_name": aws.StringValue(apiObject.ServiceName),
+			"unit":         aws.StringValue(apiObject.Unit),
+			"value":        aws.Float64Value(apiObject.DesiredValue),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,46 @@
+package servicequotas_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTemplatesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_servicequotas_templates.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplatesDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "templates.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "templates.0.quota_code", "aws_servicequotas_template.test", "quota_code"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "templates.0.value", "aws_servicequotas_template.test", "value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTemplatesDataSourceConfig_basic() string {
+	return acctest.ConfigCompose(testAccTemplateConfig_basic("200"), `
+data "aws_servicequotas_templates" "test" {
+  region       = aws_servicequotas_template.test.region
+  service_code = aws_servicequotas_template.test.service_code
+}
+`)
+}
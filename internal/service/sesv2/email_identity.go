@@ -61,6 +61,7 @@ func ResourceEmailIdentity() *schema.Resource {
 						"domain_signing_private_key": {
 							Type:         schema.TypeString,
 							Optional:     true,
+							Sensitive:    true,
 							RequiredWith: []string{"dkim_signing_attributes.0.domain_signing_selector"},
 							ValidateFunc: validation.All(
 								validation.StringLenBetween(1, 20480),
@@ -108,6 +109,26 @@ func ResourceEmailIdentity() *schema.Resource {
 					},
 				},
 			},
+			"dkim_records": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"email_identity": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -195,6 +216,11 @@ func resourceEmailIdentityRead(ctx context.Context, d *schema.ResourceData, meta
 		tfMap["domain_signing_private_key"] = d.Get("dkim_signing_attributes.0.domain_signing_private_key").(string)
 		tfMap["domain_signing_selector"] = d.Get("dkim_signing_attributes.0.domain_signing_selector").(string)
 
+		// With BYODKIM the tokens contain the selector of the current public key.
+		if out.DkimAttributes.SigningAttributesOrigin == types.DkimSigningAttributesOriginExternal && len(out.DkimAttributes.Tokens) > 0 {
+			tfMap["domain_signing_selector"] = out.DkimAttributes.Tokens[0]
+		}
+
 		if err := d.Set("dkim_signing_attributes", []interface{}{tfMap}); err != nil {
 			return create.DiagError(names.SESV2, create.ErrActionSetting, ResNameEmailIdentity, d.Id(), err)
 		}
@@ -202,6 +228,14 @@ func resourceEmailIdentityRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("dkim_signing_attributes", nil)
 	}
 
+	if out.IdentityType == types.IdentityTypeDomain {
+		if err := d.Set("dkim_records", flattenDKIMRecords(d.Id(), out.DkimAttributes)); err != nil {
+			return create.DiagError(names.SESV2, create.ErrActionSetting, ResNameEmailIdentity, d.Id(), err)
+		}
+	} else {
+		d.Set("dkim_records", nil)
+	}
+
 	d.Set("identity_type", string(out.IdentityType))
 	d.Set("verified_for_sending_status", out.VerifiedForSendingStatus)
 
@@ -357,3 +391,23 @@ func flattenDKIMAttributes(apiObject *types.DkimAttributes) map[string]interface
 
 	return m
 }
+
+// flattenDKIMRecords returns the CNAME records to publish for Easy DKIM tokens.
+// BYODKIM public keys are managed outside of SES, so no records are returned for them.
+func flattenDKIMRecords(domain string, apiObject *types.DkimAttributes) []interface{} {
+	if apiObject == nil || apiObject.SigningAttributesOrigin == types.DkimSigningAttributesOriginExternal {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, token := range apiObject.Tokens {
+		tfList = append(tfList, map[string]interface{}{
+			"name":  fmt.Sprintf("%s._domainkey.%s", token, domain),
+			"type":  "CNAME",
+			"value": fmt.Sprintf("%s.dkim.amazonses.com", token),
+		})
+	}
+
+	return tfList
+}
@@ -43,6 +43,7 @@ func TestAccSESV2EmailIdentity_basic_emailAddress(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.signing_attributes_origin", "AWS_SES"),
 					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.status", "NOT_STARTED"),
 					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.tokens.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_records.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "identity_type", "EMAIL_ADDRESS"),
 					resource.TestCheckResourceAttr(resourceName, "verified_for_sending_status", "false"),
 				),
@@ -80,6 +81,10 @@ func TestAccSESV2EmailIdentity_basic_domain(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.signing_attributes_origin", "AWS_SES"),
 					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.status", "PENDING"),
 					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.tokens.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_records.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "dkim_records.*", map[string]string{
+						"type": "CNAME",
+					}),
 					resource.TestCheckResourceAttr(resourceName, "identity_type", "DOMAIN"),
 					resource.TestCheckResourceAttr(resourceName, "verified_for_sending_status", "false"),
 				),
@@ -210,13 +215,16 @@ func TestAccSESV2EmailIdentity_domainSigning(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.domain_signing_private_key", key1),
 					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.domain_signing_selector", selector1),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.signing_attributes_origin", "EXTERNAL"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.tokens.0", selector1),
+					resource.TestCheckResourceAttr(resourceName, "dkim_records.#", "0"),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"dkim_signing_attributes.0.domain_signing_private_key", "dkim_signing_attributes.0.domain_signing_selector"},
+				ImportStateVerifyIgnore: []string{"dkim_signing_attributes.0.domain_signing_private_key"},
 			},
 			{
 				Config: testAccEmailIdentityConfig_domainSigning(rName, key2, selector2),
@@ -225,6 +233,9 @@ func TestAccSESV2EmailIdentity_domainSigning(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.domain_signing_private_key", key2),
 					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.domain_signing_selector", selector2),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.signing_attributes_origin", "EXTERNAL"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.tokens.0", selector2),
+					resource.TestCheckResourceAttr(resourceName, "dkim_records.#", "0"),
 				),
 			},
 		},
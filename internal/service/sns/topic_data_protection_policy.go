@@ -69,7 +69,7 @@ func resourceTopicDataProtectionPolicyUpsert(ctx context.Context, d *schema.Reso
 	_, err = conn.PutDataProtectionPolicyWithContext(ctx, input)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating SNS Data Protection Policy (%s): %s", d.Id(), err)
+		return sdkdiag.AppendErrorf(diags, "putting SNS Data Protection Policy (%s): %s", topicArn, err)
 	}
 
 	if d.IsNewResource() {
@@ -94,17 +94,28 @@ func resourceTopicDataProtectionPolicyRead(ctx context.Context, d *schema.Resour
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading SNS Data Protection Policy: %s", err)
+		return sdkdiag.AppendErrorf(diags, "reading SNS Data Protection Policy (%s): %s", d.Id(), err)
 	}
 
 	if output == nil || output.DataProtectionPolicy == nil {
 		return sdkdiag.AppendErrorf(diags, "reading SNS Data Protection Policy (%s): empty output", d.Id())
 	}
 
-	dataProtectionPolicy := output.DataProtectionPolicy
+	// Deleting the policy sets it to an empty string.
+	if !d.IsNewResource() && aws.StringValue(output.DataProtectionPolicy) == "" {
+		log.Printf("[WARN] SNS Data Protection Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.StringValue(output.DataProtectionPolicy))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SNS Data Protection Policy (%s): %s", d.Id(), err)
+	}
 
 	d.Set("arn", d.Id())
-	d.Set("policy", dataProtectionPolicy)
+	d.Set("policy", policyToSet)
 
 	return diags
 }
@@ -118,6 +129,10 @@ func resourceTopicDataProtectionPolicyDelete(ctx context.Context, d *schema.Reso
 		ResourceArn:          aws.String(d.Get("arn").(string)),
 	})
 
+	if tfawserr.ErrCodeEquals(err, sns.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting SNS Data Protection Policy (%s): %s", d.Id(), err)
 	}
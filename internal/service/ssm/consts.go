@@ -7,3 +7,7 @@ import (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	errCodeResourceNotFoundException = "ResourceNotFoundException"
+)
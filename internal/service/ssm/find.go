@@ -87,3 +87,43 @@ func FindServiceSettingByID(ctx context.Context, conn *ssm.SSM, id string) (*ssm
 
 	return output.ServiceSetting, nil
 }
+
+func FindResourcePolicyByTwoPartKey(ctx context.Context, conn *ssm.SSM, resourceARN, policyID string) (*ssm.GetResourcePoliciesResponseEntry, error) {
+	input := &ssm.GetResourcePoliciesInput{
+		ResourceArn: aws.String(resourceARN),
+	}
+	var output *ssm.GetResourcePoliciesResponseEntry
+
+	err := conn.GetResourcePoliciesPagesWithContext(ctx, input, func(page *ssm.GetResourcePoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Policies {
+			if v != nil && aws.StringValue(v.PolicyId) == policyID {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeParameterNotFound, errCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -69,7 +70,10 @@ func dataParameterRead(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	d.SetId(aws.StringValue(param.Name))
 	d.Set("arn", param.ARN)
-	d.Set("name", param.Name)
+	// Parameters shared from other accounts can only be referenced by ARN.
+	if !arn.IsARN(name) {
+		d.Set("name", param.Name)
+	}
 	d.Set("type", param.Type)
 	d.Set("value", param.Value)
 	d.Set("version", param.Version)
@@ -69,6 +69,29 @@ func TestAccSSMParameterDataSource_fullPath(t *testing.T) {
 	})
 }
 
+func TestAccSSMParameterDataSource_arn(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "data.aws_ssm_parameter.test"
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterDataSourceConfig_arn(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "arn", "aws_ssm_parameter.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", "aws_ssm_parameter.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "type", "String"),
+					resource.TestCheckResourceAttr(resourceName, "value", "TestValue"),
+				),
+			},
+		},
+	})
+}
+
 func testAccParameterDataSourceConfig_basic(name string, withDecryption string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_parameter" "test" {
@@ -83,3 +106,17 @@ data "aws_ssm_parameter" "test" {
 }
 `, name, withDecryption)
 }
+
+func testAccParameterDataSourceConfig_arn(name string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "String"
+  value = "TestValue"
+}
+
+data "aws_ssm_parameter" "test" {
+  name = aws_ssm_parameter.test.arn
+}
+`, name)
+}
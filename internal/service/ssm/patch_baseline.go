@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -222,7 +223,10 @@ func ResourcePatchBaseline() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePatchBaselineCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -458,6 +462,25 @@ func flattenPatchFilterGroup(group *ssm.PatchFilterGroup) []map[string]interface
 	return result
 }
 
+func resourcePatchBaselineCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	operatingSystem := diff.Get("operating_system").(string)
+
+	// Installing non-security updates is supported on Linux managed nodes only.
+	if operatingSystem == ssm.OperatingSystemWindows {
+		if diff.Get("approved_patches_enable_non_security").(bool) {
+			return fmt.Errorf("'approved_patches_enable_non_security' must not be set when 'operating_system' is '%s'", operatingSystem)
+		}
+
+		for _, v := range diff.Get("approval_rule").([]interface{}) {
+			if tfMap, ok := v.(map[string]interface{}); ok && tfMap["enable_non_security"].(bool) {
+				return fmt.Errorf("'approval_rule.enable_non_security' must not be set when 'operating_system' is '%s'", operatingSystem)
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandPatchRuleGroup(d *schema.ResourceData) *ssm.PatchRuleGroup {
 	var rules []*ssm.PatchRule
 
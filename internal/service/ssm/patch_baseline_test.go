@@ -342,6 +342,24 @@ func TestAccSSMPatchBaseline_rejectPatchesAction(t *testing.T) {
 	})
 }
 
+func TestAccSSMPatchBaseline_enableNonSecurityWindows(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPatchBaselineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPatchBaselineConfig_enableNonSecurityWindows(name),
+				ExpectError: regexp.MustCompile(`'approval_rule.enable_non_security' must not be set when 'operating_system' is 'WINDOWS'`),
+			},
+		},
+	})
+}
+
 // testAccSSMPatchBaseline_deleteDefault needs to be serialized with the other
 // Default Patch Baseline acceptance tests because it sets the default patch baseline
 func testAccSSMPatchBaseline_deleteDefault(t *testing.T) {
@@ -696,3 +714,22 @@ resource "aws_ssm_patch_baseline" "test" {
 }
 `, rName)
 }
+
+func testAccPatchBaselineConfig_enableNonSecurityWindows(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name             = "patch-baseline-%s"
+  operating_system = "WINDOWS"
+
+  approval_rule {
+    approve_after_days  = 7
+    enable_non_security = true
+
+    patch_filter {
+      key    = "PRODUCT"
+      values = ["WindowsServer2019"]
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,178 @@
+package ssm
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	resourcePolicyResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_ssm_resource_policy", name="Resource Policy")
+func ResourceResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourcePolicyCreate,
+		ReadWithoutTimeout:   resourceResourcePolicyRead,
+		UpdateWithoutTimeout: resourceResourcePolicyUpdate,
+		DeleteWithoutTimeout: resourceResourcePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"policy_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceResourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "policy (%s) is invalid JSON: %s", d.Get("policy").(string), err)
+	}
+
+	resourceARN := d.Get("resource_arn").(string)
+	input := &ssm.PutResourcePolicyInput{
+		Policy:      aws.String(policy),
+		ResourceArn: aws.String(resourceARN),
+	}
+
+	output, err := conn.PutResourcePolicyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM Resource Policy (%s): %s", resourceARN, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{resourceARN, aws.StringValue(output.PolicyId)}, resourcePolicyResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM Resource Policy (%s): %s", resourceARN, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceResourcePolicyRead(ctx, d, meta)...)
+}
+
+func resourceResourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	parts, err := flex.ExpandResourceId(d.Id(), resourcePolicyResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	resourceARN, policyID := parts[0], parts[1]
+	policy, err := FindResourcePolicyByTwoPartKey(ctx, conn, resourceARN, policyID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Resource Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Resource Policy (%s): %s", d.Id(), err)
+	}
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.StringValue(policy.Policy))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Resource Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("policy", policyToSet)
+	d.Set("policy_hash", policy.PolicyHash)
+	d.Set("policy_id", policy.PolicyId)
+	d.Set("resource_arn", resourceARN)
+
+	return diags
+}
+
+func resourceResourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "policy (%s) is invalid JSON: %s", d.Get("policy").(string), err)
+	}
+
+	input := &ssm.PutResourcePolicyInput{
+		Policy:      aws.String(policy),
+		PolicyHash:  aws.String(d.Get("policy_hash").(string)),
+		PolicyId:    aws.String(d.Get("policy_id").(string)),
+		ResourceArn: aws.String(d.Get("resource_arn").(string)),
+	}
+
+	_, err = conn.PutResourcePolicyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating SSM Resource Policy (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceResourcePolicyRead(ctx, d, meta)...)
+}
+
+func resourceResourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	log.Printf("[DEBUG] Deleting SSM Resource Policy: %s", d.Id())
+	_, err := conn.DeleteResourcePolicyWithContext(ctx, &ssm.DeleteResourcePolicyInput{
+		PolicyHash:  aws.String(d.Get("policy_hash").(string)),
+		PolicyId:    aws.String(d.Get("policy_id").(string)),
+		ResourceArn: aws.String(d.Get("resource_arn").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeParameterNotFound, errCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM Resource Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
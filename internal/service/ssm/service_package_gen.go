@@ -116,6 +116,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceResourceDataSync,
 			TypeName: "aws_ssm_resource_data_sync",
 		},
+		{
+			Factory:  ResourceResourcePolicy,
+			TypeName: "aws_ssm_resource_policy",
+			Name:     "Resource Policy",
+		},
 		{
 			Factory:  ResourceServiceSetting,
 			TypeName: "aws_ssm_service_setting",
@@ -318,8 +318,9 @@ func expandDynamicParameters(parameterMap map[string]interface{}) map[string]typ
 func flattenDynamicParameters(parameterMap map[string]types.DynamicSsmParameterValue) map[string]interface{} {
 	result := make(map[string]interface{})
 	for key, value := range parameterMap {
-		parameterValue := value.(*types.DynamicSsmParameterValueMemberVariable)
-		result[key] = parameterValue.Value
+		if parameterValue, ok := value.(*types.DynamicSsmParameterValueMemberVariable); ok {
+			result[key] = string(parameterValue.Value)
+		}
 	}
 
 	return result
@@ -396,15 +397,15 @@ func flattenPagerDutyIntegration(integrations []types.Integration) []interface{}
 			pagerDutyData := map[string]interface{}{}
 
 			if v := pagerDutyConfiguration.Name; v != nil {
-				pagerDutyData["name"] = v
+				pagerDutyData["name"] = aws.ToString(v)
 			}
 
-			if v := pagerDutyConfiguration.PagerDutyIncidentConfiguration.ServiceId; v != nil {
-				pagerDutyData["service_id"] = v
+			if v := pagerDutyConfiguration.PagerDutyIncidentConfiguration; v != nil {
+				pagerDutyData["service_id"] = aws.ToString(v.ServiceId)
 			}
 
 			if v := pagerDutyConfiguration.SecretId; v != nil {
-				pagerDutyData["secret_id"] = v
+				pagerDutyData["secret_id"] = aws.ToString(v)
 			}
 
 			result = append(result, pagerDutyData)
@@ -4,14 +4,17 @@ import (
 	"context"
 	"errors"
 	"log"
+	"regexp"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
 	"github.com/aws/aws-sdk-go-v2/service/ssmincidents/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -49,16 +52,18 @@ func ResourceResponsePlan() *schema.Resource {
 										Required: true,
 									},
 									"role_arn": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
 									},
 									"document_version": {
 										Type:     schema.TypeString,
 										Optional: true,
 									},
 									"target_account": {
-										Type:     schema.TypeString,
-										Optional: true,
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[types.SsmTargetAccount](),
 									},
 									"parameter": {
 										Type:     schema.TypeSet,
@@ -78,9 +83,10 @@ func ResourceResponsePlan() *schema.Resource {
 										},
 									},
 									"dynamic_parameters": {
-										Type:     schema.TypeMap,
-										Optional: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
+										Type:             schema.TypeMap,
+										Optional:         true,
+										Elem:             &schema.Schema{Type: schema.TypeString},
+										ValidateDiagFunc: validation.MapValueMatch(regexp.MustCompile(`^(INCIDENT_RECORD_ARN|INVOLVED_RESOURCES)$`), "must be one of INCIDENT_RECORD_ARN, INVOLVED_RESOURCES"),
 									},
 								},
 							},
@@ -166,8 +172,9 @@ func ResourceResponsePlan() *schema.Resource {
 										Required: true,
 									},
 									"secret_id": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
 									},
 								},
 							},
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"testing"
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -787,72 +788,80 @@ func testResponsePlan_action(t *testing.T) {
 	})
 }
 
-//
-//	Comment out integration test as the configured PagerDuty secretId is invalid and the test will fail,
-//	as we do not want to expose credentials to public repository.
-//
-//	Tested locally and PagerDuty integration work with response plan.
-//
-//func testResponsePlan_integration(t *testing.T) {
-//	if testing.Short() {
-//		t.Skip("skipping long-running test in short mode")
-//	}
-//
-//	ctx := context.Background()
-//
-//	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-//
-//	resourceName := "aws_ssmincidents_response_plan.test"
-//	pagerdutyName := "pagerduty-test-terraform"
-//	pagerdutyServiceId := "example"
-//	pagerdutySecretId := "example"
-//
-//	resource.Test(t, resource.TestCase{
-//		PreCheck: func() {
-//			acctest.PreCheck(ctx, t)
-//			acctest.PreCheckPartitionHasService(t, names.SSMIncidentsEndpointID)
-//		},
-//		ErrorCheck:               acctest.ErrorCheck(t, names.SSMIncidentsEndpointID),
-//		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-//		CheckDestroy:             testAccCheckResponsePlanDestroy,
-//		Steps: []resource.TestStep{
-//			{
-//				Config: testAccResponsePlanConfig_pagerdutyIntegration(
-//					rName,
-//					pagerdutyName,
-//					pagerdutyServiceId,
-//					pagerdutySecretId,
-//				),
-//				Check: resource.ComposeTestCheckFunc(
-//					testAccCheckResponsePlanExists(resourceName),
-//					resource.TestCheckResourceAttr(resourceName, "integration.#", "1"),
-//					resource.TestCheckResourceAttr(resourceName, "integration.0.pagerduty.#", "1"),
-//					resource.TestCheckResourceAttr(
-//						resourceName,
-//						"integration.0.pagerduty.0.name",
-//						pagerdutyName,
-//					),
-//					resource.TestCheckResourceAttr(
-//						resourceName,
-//						"integration.0.pagerduty.0.service_id",
-//						pagerdutyServiceId,
-//					),
-//					resource.TestCheckResourceAttr(
-//						resourceName,
-//						"integration.0.pagerduty.0.secret_id",
-//						pagerdutySecretId,
-//					),
-//				),
-//			},
-//			{
-//				ResourceName:            resourceName,
-//				ImportState:             true,
-//				ImportStateVerify:       true,
-//				ImportStateVerifyIgnore: []string{"replication_set_arn"},
-//			},
-//		},
-//	})
-//}
+func testResponsePlan_integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	key := "SSMINCIDENTS_PAGERDUTY_SECRET_ID"
+	pagerdutySecretID := os.Getenv(key)
+	if pagerdutySecretID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	key = "SSMINCIDENTS_PAGERDUTY_SERVICE_ID"
+	pagerdutyServiceID := os.Getenv(key)
+	if pagerdutyServiceID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	ctx := context.Background()
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resourceName := "aws_ssmincidents_response_plan.test"
+	pagerdutyName := "pagerduty-test-terraform"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMIncidentsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMIncidentsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_pagerdutyIntegration(
+					rName,
+					pagerdutyName,
+					pagerdutyServiceID,
+					pagerdutySecretID,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "integration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "integration.0.pagerduty.#", "1"),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"integration.0.pagerduty.0.name",
+						pagerdutyName,
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"integration.0.pagerduty.0.service_id",
+						pagerdutyServiceID,
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"integration.0.pagerduty.0.secret_id",
+						pagerdutySecretID,
+					),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replication_set_arn"},
+			},
+			{
+				Config: testAccResponsePlanConfig_none(),
+				Check:  testAccCheckResponsePlanDestroy,
+			},
+		},
+	})
+}
 
 func testAccCheckResponsePlanDestroy(s *terraform.State) error {
 	client := acctest.Provider.Meta().(*conns.AWSClient).SSMIncidentsClient()
@@ -1329,31 +1338,31 @@ DOC
 `, name+"-test-documen-one", name+"-test-documen-two")
 }
 
-//func testAccResponsePlanConfig_pagerdutyIntegration(
-//	name,
-//	pagerdutyName,
-//	pagerdutyServiceId,
-//	pagerdutySecretId string) string {
-//	return acctest.ConfigCompose(
-//		testAccResponsePlanConfigBase(),
-//		fmt.Sprintf(`
-//resource "aws_ssmincidents_response_plan" "test" {
-//  name = %[1]q
-//
-//  incident_template {
-//    title  = %[1]q
-//    impact = "1"
-//  }
-//
-//  integration {
-//    pagerduty {
-//      name       = %[2]q
-//      service_id = %[3]q
-//      secret_id  = %[4]q
-//    }
-//  }
-//
-//  depends_on = [aws_ssmincidents_replication_set.test_replication_set]
-//}
-//`, name, pagerdutyName, pagerdutyServiceId, pagerdutySecretId))
-//}
+func testAccResponsePlanConfig_pagerdutyIntegration(
+	name,
+	pagerdutyName,
+	pagerdutyServiceId,
+	pagerdutySecretId string) string {
+	return acctest.ConfigCompose(
+		testAccResponsePlanConfig_base(),
+		fmt.Sprintf(`
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[1]q
+    impact = "1"
+  }
+
+  integration {
+    pagerduty {
+      name       = %[2]q
+      service_id = %[3]q
+      secret_id  = %[4]q
+    }
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test_replication_set]
+}
+`, name, pagerdutyName, pagerdutyServiceId, pagerdutySecretId))
+}
@@ -33,6 +33,7 @@ func TestAccSSMIncidents_serial(t *testing.T) {
 			"chatChannel":            testResponsePlan_chatChannel,
 			"engagement":             testResponsePlan_engagement,
 			"action":                 testResponsePlan_action,
+			"integration":            testResponsePlan_integration,
 		},
 		"Response Plan Data Source Tests": {
 			"basic": testResponsePlanDataSource_basic,
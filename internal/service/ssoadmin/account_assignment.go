@@ -6,6 +6,7 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
@@ -29,6 +30,11 @@ func ResourceAccountAssignment() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_arn": {
 				Type:         schema.TypeString,
@@ -72,6 +78,7 @@ func ResourceAccountAssignment() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
+				Default:      ssoadmin.TargetTypeAwsAccount,
 				ValidateFunc: validation.StringInSlice(ssoadmin.TargetType_Values(), false),
 			},
 		},
@@ -120,7 +127,7 @@ func resourceAccountAssignmentCreate(ctx context.Context, d *schema.ResourceData
 
 	status := output.AccountAssignmentCreationStatus
 
-	_, err = waitAccountAssignmentCreated(ctx, conn, instanceArn, aws.StringValue(status.RequestId))
+	_, err = waitAccountAssignmentCreated(ctx, conn, instanceArn, aws.StringValue(status.RequestId), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for SSO Account Assignment for %s (%s) to be created: %s", principalType, principalID, err)
 	}
@@ -217,7 +224,7 @@ func resourceAccountAssignmentDelete(ctx context.Context, d *schema.ResourceData
 
 	status := output.AccountAssignmentDeletionStatus
 
-	_, err = waitAccountAssignmentDeleted(ctx, conn, instanceArn, aws.StringValue(status.RequestId))
+	_, err = waitAccountAssignmentDeleted(ctx, conn, instanceArn, aws.StringValue(status.RequestId), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for SSO Account Assignment for Principal (%s) to be deleted: %s", principalID, err)
 	}
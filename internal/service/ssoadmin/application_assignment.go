@@ -0,0 +1,182 @@
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_ssoadmin_application_assignment", name="Application Assignment")
+func ResourceApplicationAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationAssignmentCreate,
+		ReadWithoutTimeout:   resourceApplicationAssignmentRead,
+		DeleteWithoutTimeout: resourceApplicationAssignmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"principal_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+			"principal_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ssoadmin.PrincipalType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceApplicationAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
+
+	applicationARN := d.Get("application_arn").(string)
+	principalID := d.Get("principal_id").(string)
+	principalType := d.Get("principal_type").(string)
+	id := ApplicationAssignmentCreateResourceID(applicationARN, principalID, principalType)
+	input := &ssoadmin.CreateApplicationAssignmentInput{
+		ApplicationArn: aws.String(applicationARN),
+		PrincipalId:    aws.String(principalID),
+		PrincipalType:  aws.String(principalType),
+	}
+
+	_, err := conn.CreateApplicationAssignmentWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSO Application Assignment (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceApplicationAssignmentRead(ctx, d, meta)...)
+}
+
+func resourceApplicationAssignmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
+
+	applicationARN, principalID, principalType, err := ApplicationAssignmentParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindApplicationAssignmentByThreePartKey(ctx, conn, applicationARN, principalID, principalType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Application Assignment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSO Application Assignment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_arn", output.ApplicationArn)
+	d.Set("principal_id", output.PrincipalId)
+	d.Set("principal_type", output.PrincipalType)
+
+	return diags
+}
+
+func resourceApplicationAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
+
+	applicationARN, principalID, principalType, err := ApplicationAssignmentParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting SSO Application Assignment: %s", d.Id())
+	_, err = conn.DeleteApplicationAssignmentWithContext(ctx, &ssoadmin.DeleteApplicationAssignmentInput{
+		ApplicationArn: aws.String(applicationARN),
+		PrincipalId:    aws.String(principalID),
+		PrincipalType:  aws.String(principalType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSO Application Assignment (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const applicationAssignmentIDSeparator = ","
+
+func ApplicationAssignmentCreateResourceID(applicationARN, principalID, principalType string) string {
+	parts := []string{applicationARN, principalID, principalType}
+	id := strings.Join(parts, applicationAssignmentIDSeparator)
+
+	return id
+}
+
+func ApplicationAssignmentParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, applicationAssignmentIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected APPLICATION_ARN%[2]sPRINCIPAL_ID%[2]sPRINCIPAL_TYPE", id, applicationAssignmentIDSeparator)
+}
+
+func FindApplicationAssignmentByThreePartKey(ctx context.Context, conn *ssoadmin.SSOAdmin, applicationARN, principalID, principalType string) (*ssoadmin.DescribeApplicationAssignmentOutput, error) {
+	input := &ssoadmin.DescribeApplicationAssignmentInput{
+		ApplicationArn: aws.String(applicationARN),
+		PrincipalId:    aws.String(principalID),
+		PrincipalType:  aws.String(principalType),
+	}
+
+	output, err := conn.DescribeApplicationAssignmentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,79 @@
+package ssoadmin_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+)
+
+func TestAccSSOAdminApplicationAssignmentConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_application_assignment_configuration.test"
+	applicationResourceName := "aws_ssoadmin_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationAssignmentConfigurationConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationAssignmentConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_arn", applicationResourceName, "application_arn"),
+					resource.TestCheckResourceAttr(resourceName, "assignment_required", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationAssignmentConfigurationConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationAssignmentConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignment_required", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationAssignmentConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSO Application Assignment Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn()
+
+		_, err := tfssoadmin.FindApplicationAssignmentConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccApplicationAssignmentConfigurationConfig_basic(rName string, assignmentRequired bool) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ssoadmin_application_assignment_configuration" "test" {
+  application_arn     = aws_ssoadmin_application.test.application_arn
+  assignment_required = %[1]t
+}
+`, assignmentRequired))
+}
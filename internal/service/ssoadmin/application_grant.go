@@ -0,0 +1,329 @@
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_ssoadmin_application_grant", name="Application Grant")
+func ResourceApplicationGrant() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationGrantPut,
+		ReadWithoutTimeout:   resourceApplicationGrantRead,
+		UpdateWithoutTimeout: resourceApplicationGrantPut,
+		DeleteWithoutTimeout: resourceApplicationGrantDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"authorization_code": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"jwt_bearer"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"redirect_uris": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsURLWithHTTPS,
+							},
+						},
+					},
+				},
+			},
+			"grant_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ssoadmin.GrantType_Values(), false),
+			},
+			"jwt_bearer": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"authorization_code"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorized_token_issuer": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"authorized_audiences": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"trusted_token_issuer_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceApplicationGrantPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
+
+	applicationARN := d.Get("application_arn").(string)
+	grantType := d.Get("grant_type").(string)
+	id := ApplicationGrantCreateResourceID(applicationARN, grantType)
+	input := &ssoadmin.PutApplicationGrantInput{
+		ApplicationArn: aws.String(applicationARN),
+		Grant:          expandGrant(d),
+		GrantType:      aws.String(grantType),
+	}
+
+	_, err := conn.PutApplicationGrantWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting SSO Application Grant (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceApplicationGrantRead(ctx, d, meta)...)
+}
+
+func resourceApplicationGrantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
+
+	applicationARN, grantType, err := ApplicationGrantParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	grant, err := FindApplicationGrantByTwoPartKey(ctx, conn, applicationARN, grantType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Application Grant (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSO Application Grant (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_arn", applicationARN)
+	if err := d.Set("authorization_code", flattenAuthorizationCodeGrant(grant.AuthorizationCode)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting authorization_code: %s", err)
+	}
+	d.Set("grant_type", grantType)
+	if err := d.Set("jwt_bearer", flattenJWTBearerGrant(grant.JwtBearer)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting jwt_bearer: %s", err)
+	}
+
+	return diags
+}
+
+func resourceApplicationGrantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
+
+	applicationARN, grantType, err := ApplicationGrantParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting SSO Application Grant: %s", d.Id())
+	_, err = conn.DeleteApplicationGrantWithContext(ctx, &ssoadmin.DeleteApplicationGrantInput{
+		ApplicationArn: aws.String(applicationARN),
+		GrantType:      aws.String(grantType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSO Application Grant (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const applicationGrantIDSeparator = ","
+
+func ApplicationGrantCreateResourceID(applicationARN, grantType string) string {
+	parts := []string{applicationARN, grantType}
+	id := strings.Join(parts, applicationGrantIDSeparator)
+
+	return id
+}
+
+func ApplicationGrantParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, applicationGrantIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected APPLICATION_ARN%[2]sGRANT_TYPE", id, applicationGrantIDSeparator)
+}
+
+func FindApplicationGrantByTwoPartKey(ctx context.Context, conn *ssoadmin.SSOAdmin, applicationARN, grantType string) (*ssoadmin.Grant, error) {
+	input := &ssoadmin.GetApplicationGrantInput{
+		ApplicationArn: aws.String(applicationARN),
+		GrantType:      aws.String(grantType),
+	}
+
+	output, err := conn.GetApplicationGrantWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Grant == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Grant, nil
+}
+
+// expandGrant builds the grant for the configured grant type.
+// The refresh token and token exchange grants carry no settings.
+func expandGrant(d *schema.ResourceData) *ssoadmin.Grant {
+	apiObject := &ssoadmin.Grant{}
+
+	switch d.Get("grant_type").(string) {
+	case ssoadmin.GrantTypeAuthorizationCode:
+		apiObject.AuthorizationCode = &ssoadmin.AuthorizationCodeGrant{}
+
+		if v, ok := d.GetOk("authorization_code"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			if v, ok := tfMap["redirect_uris"].([]interface{}); ok && len(v) > 0 {
+				apiObject.AuthorizationCode.RedirectUris = flex.ExpandStringList(v)
+			}
+		}
+	case ssoadmin.GrantTypeUrnIetfParamsOauthGrantTypeJwtBearer:
+		apiObject.JwtBearer = &ssoadmin.JwtBearerGrant{}
+
+		if v, ok := d.GetOk("jwt_bearer"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			if v, ok := tfMap["authorized_token_issuer"].([]interface{}); ok && len(v) > 0 {
+				apiObject.JwtBearer.AuthorizedTokenIssuers = expandAuthorizedTokenIssuers(v)
+			}
+		}
+	case ssoadmin.GrantTypeRefreshToken:
+		apiObject.RefreshToken = &ssoadmin.RefreshTokenGrant{}
+	case ssoadmin.GrantTypeUrnIetfParamsOauthGrantTypeTokenExchange:
+		apiObject.TokenExchange = &ssoadmin.TokenExchangeGrant{}
+	}
+
+	return apiObject
+}
+
+func expandAuthorizedTokenIssuers(tfList []interface{}) []*ssoadmin.AuthorizedTokenIssuer {
+	var apiObjects []*ssoadmin.AuthorizedTokenIssuer
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssoadmin.AuthorizedTokenIssuer{}
+
+		if v, ok := tfMap["authorized_audiences"].([]interface{}); ok && len(v) > 0 {
+			apiObject.AuthorizedAudiences = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["trusted_token_issuer_arn"].(string); ok && v != "" {
+			apiObject.TrustedTokenIssuerArn = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAuthorizationCodeGrant(apiObject *ssoadmin.AuthorizationCodeGrant) []interface{} {
+	if apiObject == nil || len(apiObject.RedirectUris) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"redirect_uris": aws.StringValueSlice(apiObject.RedirectUris),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenJWTBearerGrant(apiObject *ssoadmin.JwtBearerGrant) []interface{} {
+	if apiObject == nil || len(apiObject.AuthorizedTokenIssuers) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.AuthorizedTokenIssuers {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"authorized_audiences":     aws.StringValueSlice(apiObject.AuthorizedAudiences),
+			"trusted_token_issuer_arn": aws.StringValue(apiObject.TrustedTokenIssuerArn),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"authorized_token_issuer": tfList,
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,187 @@
+package ssoadmin_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSOAdminApplicationGrant_authorizationCode(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_application_grant.test"
+	applicationResourceName := "aws_ssoadmin_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationGrantConfig_authorizationCode(rName, "https://example.com/callback"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_arn", applicationResourceName, "application_arn"),
+					resource.TestCheckResourceAttr(resourceName, "authorization_code.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authorization_code.0.redirect_uris.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authorization_code.0.redirect_uris.0", "https://example.com/callback"),
+					resource.TestCheckResourceAttr(resourceName, "grant_type", ssoadmin.GrantTypeAuthorizationCode),
+					resource.TestCheckResourceAttr(resourceName, "jwt_bearer.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationGrantConfig_authorizationCode(rName, "https://example.org/callback"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authorization_code.0.redirect_uris.0", "https://example.org/callback"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminApplicationGrant_refreshToken(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_application_grant.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationGrantConfig_grantType(rName, ssoadmin.GrantTypeRefreshToken),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authorization_code.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "grant_type", ssoadmin.GrantTypeRefreshToken),
+					resource.TestCheckResourceAttr(resourceName, "jwt_bearer.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminApplicationGrant_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_application_grant.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationGrantConfig_grantType(rName, ssoadmin.GrantTypeRefreshToken),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationGrantExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssoadmin.ResourceApplicationGrant(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationGrantDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssoadmin_application_grant" {
+				continue
+			}
+
+			applicationARN, grantType, err := tfssoadmin.ApplicationGrantParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfssoadmin.FindApplicationGrantByTwoPartKey(ctx, conn, applicationARN, grantType)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SSO Application Grant %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckApplicationGrantExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSO Application Grant ID is set")
+		}
+
+		applicationARN, grantType, err := tfssoadmin.ApplicationGrantParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn()
+
+		_, err = tfssoadmin.FindApplicationGrantByTwoPartKey(ctx, conn, applicationARN, grantType)
+
+		return err
+	}
+}
+
+func testAccApplicationGrantConfig_authorizationCode(rName, redirectURI string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ssoadmin_application_grant" "test" {
+  application_arn = aws_ssoadmin_application.test.application_arn
+  grant_type      = "authorization_code"
+
+  authorization_code {
+    redirect_uris = [%[1]q]
+  }
+}
+`, redirectURI))
+}
+
+func testAccApplicationGrantConfig_grantType(rName, grantType string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ssoadmin_application_grant" "test" {
+  application_arn = aws_ssoadmin_application.test.application_arn
+  grant_type      = %[1]q
+}
+`, grantType))
+}
@@ -102,7 +102,7 @@ func resourceCustomerManagedPolicyAttachmentCreate(ctx context.Context, d *schem
 	d.SetId(id)
 
 	// After the policy has been attached to the permission set, provision in all accounts that use this permission set.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN); err != nil {
+	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, permissionSetProvisionTimeout); err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating SSO Customer Managed Policy Attachment (%s): %s", d.Id(), err)
 	}
 
@@ -173,7 +173,7 @@ func resourceCustomerManagedPolicyAttachmentDelete(ctx context.Context, d *schem
 	}
 
 	// After the policy has been detached from the permission set, provision in all accounts that use this permission set.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN); err != nil {
+	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, permissionSetProvisionTimeout); err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting SSO Customer Managed Policy Attachment (%s): %s", d.Id(), err)
 	}
 
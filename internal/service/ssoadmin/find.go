@@ -143,3 +143,32 @@ func FindPermissionsBoundary(ctx context.Context, conn *ssoadmin.SSOAdmin, permi
 
 	return output.PermissionsBoundary, nil
 }
+
+// FindPermissionSetInlinePolicy returns the inline policy of a permission set within a specified SSO instance.
+// Returns an error if the permission set has no inline policy.
+func FindPermissionSetInlinePolicy(ctx context.Context, conn *ssoadmin.SSOAdmin, permissionSetArn, instanceArn string) (string, error) {
+	input := &ssoadmin.GetInlinePolicyForPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	output, err := conn.GetInlinePolicyForPermissionSetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	// The SSO API returns an empty string when the permission set has no inline policy.
+	if output == nil || aws.StringValue(output.InlinePolicy) == "" {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.InlinePolicy), nil
+}
@@ -78,7 +78,7 @@ func resourceManagedPolicyAttachmentCreate(ctx context.Context, d *schema.Resour
 	d.SetId(fmt.Sprintf("%s,%s,%s", managedPolicyArn, permissionSetArn, instanceArn))
 
 	// Provision ALL accounts after attaching the managed policy
-	if err := provisionPermissionSet(ctx, conn, permissionSetArn, instanceArn); err != nil {
+	if err := provisionPermissionSet(ctx, conn, permissionSetArn, instanceArn, permissionSetProvisionTimeout); err != nil {
 		return sdkdiag.AppendErrorf(diags, "provisioning SSO Permission Set (%s): %s", permissionSetArn, err)
 	}
 
@@ -145,7 +145,7 @@ func resourceManagedPolicyAttachmentDelete(ctx context.Context, d *schema.Resour
 	}
 
 	// Provision ALL accounts after detaching the managed policy
-	if err := provisionPermissionSet(ctx, conn, permissionSetArn, instanceArn); err != nil {
+	if err := provisionPermissionSet(ctx, conn, permissionSetArn, instanceArn, permissionSetProvisionTimeout); err != nil {
 		return sdkdiag.AppendErrorf(diags, "provisioning SSO Permission Set (%s): %s", permissionSetArn, err)
 	}
 
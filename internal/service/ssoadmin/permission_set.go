@@ -36,6 +36,10 @@ func ResourcePermissionSet() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(permissionSetProvisionTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -82,6 +86,11 @@ func ResourcePermissionSet() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 100),
 				Default:      "PT1H",
 			},
+			"skip_provisioning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -184,6 +193,9 @@ func resourcePermissionSetUpdate(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "parsing resource ID: %s", err)
 	}
 
+	// Tags are not part of what is provisioned to accounts.
+	provision := false
+
 	if d.HasChanges("description", "relay_state", "session_duration") {
 		input := &ssoadmin.UpdatePermissionSetInput{
 			InstanceArn:      aws.String(instanceARN),
@@ -211,6 +223,8 @@ func resourcePermissionSetUpdate(ctx context.Context, d *schema.ResourceData, me
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating SSO Permission Set (%s): %s", arn, err)
 		}
+
+		provision = true
 	}
 
 	if d.HasChange("tags_all") {
@@ -220,9 +234,17 @@ func resourcePermissionSetUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	// Updates skipped earlier are still pending once provisioning is re-enabled.
+	if d.HasChange("skip_provisioning") && !d.Get("skip_provisioning").(bool) {
+		provision = true
+	}
+
 	// Re-provision ALL accounts after making the above changes
-	if err := provisionPermissionSet(ctx, conn, arn, instanceARN); err != nil {
-		return sdkdiag.AppendErrorf(diags, "provisioning SSO Permission Set (%s): %s", arn, err)
+	// unless provisioning has been deferred to the account assignments.
+	if provision && !d.Get("skip_provisioning").(bool) {
+		if err := provisionPermissionSet(ctx, conn, arn, instanceARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "provisioning SSO Permission Set (%s): %s", arn, err)
+		}
 	}
 
 	return append(diags, resourcePermissionSetRead(ctx, d, meta)...)
@@ -263,7 +285,7 @@ func ParseResourceID(id string) (string, string, error) {
 	return idParts[0], idParts[1], nil
 }
 
-func provisionPermissionSet(ctx context.Context, conn *ssoadmin.SSOAdmin, arn, instanceArn string) error {
+func provisionPermissionSet(ctx context.Context, conn *ssoadmin.SSOAdmin, arn, instanceArn string, timeout time.Duration) error {
 	input := &ssoadmin.ProvisionPermissionSetInput{
 		InstanceArn:      aws.String(instanceArn),
 		PermissionSetArn: aws.String(arn),
@@ -271,7 +293,7 @@ func provisionPermissionSet(ctx context.Context, conn *ssoadmin.SSOAdmin, arn, i
 	}
 
 	var output *ssoadmin.ProvisionPermissionSetOutput
-	err := retry.RetryContext(ctx, permissionSetProvisionTimeout, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		var err error
 		output, err = conn.ProvisionPermissionSetWithContext(ctx, input)
 
@@ -300,7 +322,7 @@ func provisionPermissionSet(ctx context.Context, conn *ssoadmin.SSOAdmin, arn, i
 		return fmt.Errorf("provisioning SSO Permission Set (%s): empty output", arn)
 	}
 
-	_, err = waitPermissionSetProvisioned(ctx, conn, instanceArn, aws.StringValue(output.PermissionSetProvisioningStatus.RequestId))
+	_, err = waitPermissionSetProvisioned(ctx, conn, instanceArn, aws.StringValue(output.PermissionSetProvisioningStatus.RequestId), timeout)
 	if err != nil {
 		return fmt.Errorf("waiting for SSO Permission Set (%s) to provision: %w", arn, err)
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -82,7 +83,7 @@ func resourcePermissionSetInlinePolicyPut(ctx context.Context, d *schema.Resourc
 	d.SetId(fmt.Sprintf("%s,%s", permissionSetArn, instanceArn))
 
 	// (Re)provision ALL accounts after making the above changes
-	if err := provisionPermissionSet(ctx, conn, permissionSetArn, instanceArn); err != nil {
+	if err := provisionPermissionSet(ctx, conn, permissionSetArn, instanceArn, permissionSetProvisionTimeout); err != nil {
 		return sdkdiag.AppendErrorf(diags, "provisioning SSO Permission Set (%s): %s", permissionSetArn, err)
 	}
 
@@ -98,14 +99,9 @@ func resourcePermissionSetInlinePolicyRead(ctx context.Context, d *schema.Resour
 		return sdkdiag.AppendErrorf(diags, "parsing SSO Permission Set Inline Policy ID: %s", err)
 	}
 
-	input := &ssoadmin.GetInlinePolicyForPermissionSetInput{
-		InstanceArn:      aws.String(instanceArn),
-		PermissionSetArn: aws.String(permissionSetArn),
-	}
+	policy, err := FindPermissionSetInlinePolicy(ctx, conn, permissionSetArn, instanceArn)
 
-	output, err := conn.GetInlinePolicyForPermissionSetWithContext(ctx, input)
-
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Inline Policy for SSO Permission Set (%s) not found, removing from state", permissionSetArn)
 		d.SetId("")
 		return diags
@@ -115,11 +111,7 @@ func resourcePermissionSetInlinePolicyRead(ctx context.Context, d *schema.Resour
 		return sdkdiag.AppendErrorf(diags, "reading Inline Policy for SSO Permission Set (%s): %s", permissionSetArn, err)
 	}
 
-	if output == nil {
-		return sdkdiag.AppendErrorf(diags, "reading Inline Policy for SSO Permission Set (%s): empty output", permissionSetArn)
-	}
-
-	policyToSet, err := verify.PolicyToSet(d.Get("inline_policy").(string), aws.StringValue(output.InlinePolicy))
+	policyToSet, err := verify.PolicyToSet(d.Get("inline_policy").(string), policy)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Inline Policy for SSO Permission Set (%s): %s", permissionSetArn, err)
@@ -156,5 +148,10 @@ func resourcePermissionSetInlinePolicyDelete(ctx context.Context, d *schema.Reso
 		return sdkdiag.AppendErrorf(diags, "detaching Inline Policy from SSO Permission Set (%s): %s", permissionSetArn, err)
 	}
 
+	// Provision ALL accounts after removing the inline policy
+	if err := provisionPermissionSet(ctx, conn, permissionSetArn, instanceArn, permissionSetProvisionTimeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "provisioning SSO Permission Set (%s): %s", permissionSetArn, err)
+	}
+
 	return diags
 }
@@ -6,15 +6,14 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func TestAccSSOAdminPermissionSetInlinePolicy_basic(t *testing.T) {
@@ -143,13 +142,9 @@ func testAccCheckPermissionSetInlinePolicyDestroy(ctx context.Context) resource.
 				return fmt.Errorf("error parsing SSO Permission Set Inline Policy ID (%s): %w", rs.Primary.ID, err)
 			}
 
-			input := &ssoadmin.GetInlinePolicyForPermissionSetInput{
-				InstanceArn:      aws.String(instanceArn),
-				PermissionSetArn: aws.String(permissionSetArn),
-			}
+			_, err = tfssoadmin.FindPermissionSetInlinePolicy(ctx, conn, permissionSetArn, instanceArn)
 
-			output, err := conn.GetInlinePolicyForPermissionSetWithContext(ctx, input)
-			if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+			if tfresource.NotFound(err) {
 				continue
 			}
 
@@ -157,15 +152,6 @@ func testAccCheckPermissionSetInlinePolicyDestroy(ctx context.Context) resource.
 				return err
 			}
 
-			if output == nil {
-				continue
-			}
-
-			// SSO API returns empty string when removed from Permission Set
-			if aws.StringValue(output.InlinePolicy) == "" {
-				continue
-			}
-
 			return fmt.Errorf("Inline Policy for SSO PermissionSet (%s) still exists", permissionSetArn)
 		}
 
@@ -192,21 +178,9 @@ func testAccCheckPermissionSetInlinePolicyExists(ctx context.Context, resourceNa
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn()
 
-		input := &ssoadmin.GetInlinePolicyForPermissionSetInput{
-			InstanceArn:      aws.String(instanceArn),
-			PermissionSetArn: aws.String(permissionSetArn),
-		}
-
-		output, err := conn.GetInlinePolicyForPermissionSetWithContext(ctx, input)
-		if err != nil {
-			return err
-		}
+		_, err = tfssoadmin.FindPermissionSetInlinePolicy(ctx, conn, permissionSetArn, instanceArn)
 
-		if output == nil || output.InlinePolicy == nil {
-			return fmt.Errorf("Inline Policy for SSO Permission Set (%s) not found", permissionSetArn)
-		}
-
-		return nil
+		return err
 	}
 }
 
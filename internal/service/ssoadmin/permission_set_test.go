@@ -36,9 +36,10 @@ func TestAccSSOAdminPermissionSet_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_provisioning"},
 			},
 		},
 	})
@@ -64,9 +65,10 @@ func TestAccSSOAdminPermissionSet_tags(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_provisioning"},
 			},
 			{
 				Config: testAccPermissionSetConfig_tags2(rName, "key1", "updatedvalue1", "key2", "value2"),
@@ -78,9 +80,10 @@ func TestAccSSOAdminPermissionSet_tags(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_provisioning"},
 			},
 			{
 				Config: testAccPermissionSetConfig_tags1(rName, "key2", "value2"),
@@ -91,9 +94,10 @@ func TestAccSSOAdminPermissionSet_tags(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_provisioning"},
 			},
 		},
 	})
@@ -125,9 +129,49 @@ func TestAccSSOAdminPermissionSet_updateDescription(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_provisioning"},
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminPermissionSet_skipProvisioning(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_permission_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetConfig_skipProvisioning(rName, "PT1H", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSOAdminPermissionSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "session_duration", "PT1H"),
+					resource.TestCheckResourceAttr(resourceName, "skip_provisioning", "true"),
+				),
+			},
+			{
+				Config: testAccPermissionSetConfig_skipProvisioning(rName, "PT2H", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSOAdminPermissionSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "session_duration", "PT2H"),
+					resource.TestCheckResourceAttr(resourceName, "skip_provisioning", "true"),
+				),
+			},
+			{
+				Config: testAccPermissionSetConfig_skipProvisioning(rName, "PT2H", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSOAdminPermissionSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "session_duration", "PT2H"),
+					resource.TestCheckResourceAttr(resourceName, "skip_provisioning", "false"),
+				),
 			},
 		},
 	})
@@ -159,9 +203,10 @@ func TestAccSSOAdminPermissionSet_updateRelayState(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_provisioning"},
 			},
 		},
 	})
@@ -192,9 +237,10 @@ func TestAccSSOAdminPermissionSet_updateSessionDuration(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_provisioning"},
 			},
 		},
 	})
@@ -235,9 +281,10 @@ func TestAccSSOAdminPermissionSet_RelayState_updateSessionDuration(t *testing.T)
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_provisioning"},
 			},
 		},
 	})
@@ -267,9 +314,10 @@ func TestAccSSOAdminPermissionSet_mixedPolicyAttachments(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_provisioning"},
 			},
 		},
 	})
@@ -363,6 +411,19 @@ resource "aws_ssoadmin_permission_set" "test" {
 `, rName)
 }
 
+func testAccPermissionSetConfig_skipProvisioning(rName, sessionDuration string, skipProvisioning bool) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name              = %[1]q
+  instance_arn      = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  session_duration  = %[2]q
+  skip_provisioning = %[3]t
+}
+`, rName, sessionDuration, skipProvisioning)
+}
+
 func testAccPermissionSetConfig_updateRelayState(rName string) string {
 	return fmt.Sprintf(`
 data "aws_ssoadmin_instances" "test" {}
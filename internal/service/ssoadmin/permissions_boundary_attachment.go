@@ -117,7 +117,7 @@ func resourcePermissionsBoundaryAttachmentCreate(ctx context.Context, d *schema.
 	d.SetId(id)
 
 	// After the policy has been attached to the permission set, provision in all accounts that use this permission set.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN); err != nil {
+	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, permissionSetProvisionTimeout); err != nil {
 		return sdkdiag.AppendErrorf(diags, "provisioning SSO Permission Set (%s): %s", permissionSetARN, err)
 	}
 
@@ -184,7 +184,7 @@ func resourcePermissionsBoundaryAttachmentDelete(ctx context.Context, d *schema.
 	}
 
 	// After the policy has been detached from the permission set, provision in all accounts that use this permission set.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN); err != nil {
+	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, permissionSetProvisionTimeout); err != nil {
 		return sdkdiag.AppendErrorf(diags, "provisioning SSO Permission Set (%s): %s", permissionSetARN, err)
 	}
 
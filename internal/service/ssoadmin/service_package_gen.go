@@ -38,6 +38,32 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceAccountAssignment,
 			TypeName: "aws_ssoadmin_account_assignment",
 		},
+		{
+			Factory:  ResourceApplication,
+			TypeName: "aws_ssoadmin_application",
+			Name:     "Application",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
+		{
+			Factory:  ResourceApplicationAccessScope,
+			TypeName: "aws_ssoadmin_application_access_scope",
+			Name:     "Application Access Scope",
+		},
+		{
+			Factory:  ResourceApplicationAssignment,
+			TypeName: "aws_ssoadmin_application_assignment",
+			Name:     "Application Assignment",
+		},
+		{
+			Factory:  ResourceApplicationAssignmentConfiguration,
+			TypeName: "aws_ssoadmin_application_assignment_configuration",
+			Name:     "Application Assignment Configuration",
+		},
+		{
+			Factory:  ResourceApplicationGrant,
+			TypeName: "aws_ssoadmin_application_grant",
+			Name:     "Application Grant",
+		},
 		{
 			Factory:  ResourceCustomerManagedPolicyAttachment,
 			TypeName: "aws_ssoadmin_customer_managed_policy_attachment",
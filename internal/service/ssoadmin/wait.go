@@ -2,64 +2,73 @@ package ssoadmin
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
-	accountAssignmentCreateTimeout      = 5 * time.Minute
-	accountAssignmentDeleteTimeout      = 5 * time.Minute
 	accountAssignmentDelay              = 10 * time.Second
 	accountAssignmentMinTimeout         = 5 * time.Second
 	permissionSetProvisioningRetryDelay = 5 * time.Second
 	permissionSetProvisionTimeout       = 10 * time.Minute
 )
 
-func waitAccountAssignmentCreated(ctx context.Context, conn *ssoadmin.SSOAdmin, instanceArn, requestID string) (*ssoadmin.AccountAssignmentOperationStatus, error) {
+func waitAccountAssignmentCreated(ctx context.Context, conn *ssoadmin.SSOAdmin, instanceArn, requestID string, timeout time.Duration) (*ssoadmin.AccountAssignmentOperationStatus, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{ssoadmin.StatusValuesInProgress},
 		Target:     []string{ssoadmin.StatusValuesSucceeded},
 		Refresh:    statusAccountAssignmentCreation(ctx, conn, instanceArn, requestID),
-		Timeout:    accountAssignmentCreateTimeout,
+		Timeout:    timeout,
 		Delay:      accountAssignmentDelay,
 		MinTimeout: accountAssignmentMinTimeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if v, ok := outputRaw.(*ssoadmin.AccountAssignmentOperationStatus); ok {
+		if status := aws.StringValue(v.Status); status == ssoadmin.StatusValuesFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.FailureReason)))
+		}
+
 		return v, err
 	}
 
 	return nil, err
 }
 
-func waitAccountAssignmentDeleted(ctx context.Context, conn *ssoadmin.SSOAdmin, instanceArn, requestID string) (*ssoadmin.AccountAssignmentOperationStatus, error) {
+func waitAccountAssignmentDeleted(ctx context.Context, conn *ssoadmin.SSOAdmin, instanceArn, requestID string, timeout time.Duration) (*ssoadmin.AccountAssignmentOperationStatus, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{ssoadmin.StatusValuesInProgress},
 		Target:     []string{ssoadmin.StatusValuesSucceeded},
 		Refresh:    statusAccountAssignmentDeletion(ctx, conn, instanceArn, requestID),
-		Timeout:    accountAssignmentDeleteTimeout,
+		Timeout:    timeout,
 		Delay:      accountAssignmentDelay,
 		MinTimeout: accountAssignmentMinTimeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if v, ok := outputRaw.(*ssoadmin.AccountAssignmentOperationStatus); ok {
+		if status := aws.StringValue(v.Status); status == ssoadmin.StatusValuesFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.FailureReason)))
+		}
+
 		return v, err
 	}
 
 	return nil, err
 }
 
-func waitPermissionSetProvisioned(ctx context.Context, conn *ssoadmin.SSOAdmin, instanceArn, requestID string) (*ssoadmin.PermissionSetProvisioningStatus, error) {
+func waitPermissionSetProvisioned(ctx context.Context, conn *ssoadmin.SSOAdmin, instanceArn, requestID string, timeout time.Duration) (*ssoadmin.PermissionSetProvisioningStatus, error) {
 	stateConf := retry.StateChangeConf{
 		Delay:   permissionSetProvisioningRetryDelay,
 		Pending: []string{ssoadmin.StatusValuesInProgress},
 		Target:  []string{ssoadmin.StatusValuesSucceeded},
 		Refresh: statusPermissionSetProvisioning(ctx, conn, instanceArn, requestID),
-		Timeout: permissionSetProvisionTimeout,
+		Timeout: timeout,
 	}
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if v, ok := outputRaw.(*ssoadmin.PermissionSetProvisioningStatus); ok {
@@ -0,0 +1,118 @@
+package tnb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/tnb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindFunctionPackageByID(ctx context.Context, conn *tnb.Tnb, id string) (*tnb.GetSolFunctionPackageOutput, error) {
+	input := &tnb.GetSolFunctionPackageInput{
+		VnfPkgId: aws.String(id),
+	}
+
+	output, err := conn.GetSolFunctionPackageWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindNetworkPackageByID(ctx context.Context, conn *tnb.Tnb, id string) (*tnb.GetSolNetworkPackageOutput, error) {
+	input := &tnb.GetSolNetworkPackageInput{
+		NsdInfoId: aws.String(id),
+	}
+
+	output, err := conn.GetSolNetworkPackageWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindNetworkInstanceByID(ctx context.Context, conn *tnb.Tnb, id string) (*tnb.GetSolNetworkInstanceOutput, error) {
+	input := &tnb.GetSolNetworkInstanceInput{
+		NsInstanceId: aws.String(id),
+	}
+
+	output, err := conn.GetSolNetworkInstanceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.NsState); state == tnb.NsStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindNetworkOperationByID(ctx context.Context, conn *tnb.Tnb, id string) (*tnb.GetSolNetworkOperationOutput, error) {
+	input := &tnb.GetSolNetworkOperationInput{
+		NsLcmOpOccId: aws.String(id),
+	}
+
+	output, err := conn.GetSolNetworkOperationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
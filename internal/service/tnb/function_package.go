@@ -0,0 +1,235 @@
+package tnb
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/tnb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+// @SDKResource("aws_tnb_function_package", name="Function Package")
+// @Tags(identifierAttribute="arn")
+func ResourceFunctionPackage() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFunctionPackageCreate,
+		ReadWithoutTimeout:   resourceFunctionPackageRead,
+		UpdateWithoutTimeout: resourceFunctionPackageUpdate,
+		DeleteWithoutTimeout: resourceFunctionPackageDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"file_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"onboarding_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operational_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(tnb.OperationalState_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"usage_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vnf_product_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vnf_provider": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vnfd_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vnfd_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFunctionPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn()
+
+	file := d.Get("file").(string)
+	content, err := readFileContents(file)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading TNB Function Package file (%s): %s", file, err)
+	}
+
+	output, err := conn.CreateSolFunctionPackageWithContext(ctx, &tnb.CreateSolFunctionPackageInput{
+		Tags: GetTagsIn(ctx),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating TNB Function Package: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	_, err = conn.PutSolFunctionPackageContentWithContext(ctx, &tnb.PutSolFunctionPackageContentInput{
+		ContentType: aws.String(tnb.PackageContentTypeApplicationZip),
+		File:        content,
+		VnfPkgId:    aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting TNB Function Package (%s) content: %s", d.Id(), err)
+	}
+
+	pkg, err := waitFunctionPackageOnboarded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for TNB Function Package (%s) onboard: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("operational_state"); ok && v.(string) != aws.StringValue(pkg.OperationalState) {
+		if err := updateFunctionPackageOperationalState(ctx, conn, d.Id(), v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating TNB Function Package (%s) operational state: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFunctionPackageRead(ctx, d, meta)...)
+}
+
+func resourceFunctionPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn()
+
+	pkg, err := FindFunctionPackageByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] TNB Function Package (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading TNB Function Package (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", pkg.Arn)
+	d.Set("onboarding_state", pkg.OnboardingState)
+	d.Set("operational_state", pkg.OperationalState)
+	d.Set("usage_state", pkg.UsageState)
+	d.Set("vnf_product_name", pkg.VnfProductName)
+	d.Set("vnf_provider", pkg.VnfProvider)
+	d.Set("vnfd_id", pkg.VnfdId)
+	d.Set("vnfd_version", pkg.VnfdVersion)
+
+	SetTagsOut(ctx, pkg.Tags)
+
+	return diags
+}
+
+func resourceFunctionPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn()
+
+	if d.HasChange("operational_state") {
+		if err := updateFunctionPackageOperationalState(ctx, conn, d.Id(), d.Get("operational_state").(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating TNB Function Package (%s) operational state: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFunctionPackageRead(ctx, d, meta)...)
+}
+
+func resourceFunctionPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn()
+
+	// A function package must be disabled before it can be deleted.
+	if d.Get("operational_state").(string) == tnb.OperationalStateEnabled {
+		err := updateFunctionPackageOperationalState(ctx, conn, d.Id(), tnb.OperationalStateDisabled)
+
+		if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "disabling TNB Function Package (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting TNB Function Package: %s", d.Id())
+	_, err := conn.DeleteSolFunctionPackageWithContext(ctx, &tnb.DeleteSolFunctionPackageInput{
+		VnfPkgId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting TNB Function Package (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateFunctionPackageOperationalState(ctx context.Context, conn *tnb.Tnb, id, state string) error {
+	_, err := conn.UpdateSolFunctionPackageWithContext(ctx, &tnb.UpdateSolFunctionPackageInput{
+		OperationalState: aws.String(state),
+		VnfPkgId:         aws.String(id),
+	})
+
+	return err
+}
+
+func readFileContents(v string) ([]byte, error) {
+	filename, err := homedir.Expand(v)
+	if err != nil {
+		return nil, err
+	}
+	fileContent, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return fileContent, nil
+}
@@ -0,0 +1,249 @@
+package tnb_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/tnb"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftnb "github.com/hashicorp/terraform-provider-aws/internal/service/tnb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTNBFunctionPackage_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v tnb.GetSolFunctionPackageOutput
+	resourceName := "aws_tnb_function_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tnb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionPackageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionPackageConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionPackageExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "tnb", regexp.MustCompile(`function-package/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "onboarding_state", "ONBOARDED"),
+					resource.TestCheckResourceAttr(resourceName, "operational_state", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "usage_state", "NOT_IN_USE"),
+					resource.TestCheckResourceAttr(resourceName, "vnf_product_name", "Terraform acceptance test function 1.0.0"),
+					resource.TestCheckResourceAttr(resourceName, "vnf_provider", "Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "vnfd_id", "b6f2e6d2-1d4a-4d0b-9c3e-2f0c6f9f7a11"),
+					resource.TestCheckResourceAttr(resourceName, "vnfd_version", "1.0.0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file"},
+			},
+		},
+	})
+}
+
+func TestAccTNBFunctionPackage_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v tnb.GetSolFunctionPackageOutput
+	resourceName := "aws_tnb_function_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tnb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionPackageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionPackageConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionPackageExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftnb.ResourceFunctionPackage(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTNBFunctionPackage_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v tnb.GetSolFunctionPackageOutput
+	resourceName := "aws_tnb_function_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tnb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionPackageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionPackageConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionPackageExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file"},
+			},
+			{
+				Config: testAccFunctionPackageConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionPackageExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFunctionPackageConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionPackageExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTNBFunctionPackage_operationalState(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v tnb.GetSolFunctionPackageOutput
+	resourceName := "aws_tnb_function_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tnb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionPackageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionPackageConfig_operationalState("DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionPackageExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "operational_state", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file"},
+			},
+			{
+				Config: testAccFunctionPackageConfig_operationalState("ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionPackageExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "operational_state", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFunctionPackageDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TNBConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_tnb_function_package" {
+				continue
+			}
+
+			_, err := tftnb.FindFunctionPackageByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("TNB Function Package %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFunctionPackageExists(ctx context.Context, n string, v *tnb.GetSolFunctionPackageOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No TNB Function Package ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TNBConn()
+
+		output, err := tftnb.FindFunctionPackageByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccFunctionPackageConfig_basic() string {
+	return `
+resource "aws_tnb_function_package" "test" {
+  file = "test-fixtures/function-package.zip"
+}
+`
+}
+
+func testAccFunctionPackageConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_tnb_function_package" "test" {
+  file = "test-fixtures/function-package.zip"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccFunctionPackageConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_tnb_function_package" "test" {
+  file = "test-fixtures/function-package.zip"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccFunctionPackageConfig_operationalState(state string) string {
+	return fmt.Sprintf(`
+resource "aws_tnb_function_package" "test" {
+  file              = "test-fixtures/function-package.zip"
+  operational_state = %[1]q
+}
+`, state)
+}
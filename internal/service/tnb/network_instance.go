@@ -0,0 +1,193 @@
+package tnb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/tnb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_tnb_network_instance", name="Network Instance")
+// @Tags(identifierAttribute="arn")
+func ResourceNetworkInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNetworkInstanceCreate,
+		ReadWithoutTimeout:   resourceNetworkInstanceRead,
+		UpdateWithoutTimeout: resourceNetworkInstanceUpdate,
+		DeleteWithoutTimeout: resourceNetworkInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"network_package_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"nsd_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceNetworkInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn()
+
+	name := d.Get("name").(string)
+	input := &tnb.CreateSolNetworkInstanceInput{
+		NsName:    aws.String(name),
+		NsdInfoId: aws.String(d.Get("network_package_id").(string)),
+		Tags:      GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.NsDescription = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateSolNetworkInstanceWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating TNB Network Instance (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	instantiateOutput, err := conn.InstantiateSolNetworkInstanceWithContext(ctx, &tnb.InstantiateSolNetworkInstanceInput{
+		NsInstanceId: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "instantiating TNB Network Instance (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitNetworkOperationCompleted(ctx, conn, aws.StringValue(instantiateOutput.NsLcmOpOccId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for TNB Network Instance (%s) instantiate: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceNetworkInstanceRead(ctx, d, meta)...)
+}
+
+func resourceNetworkInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn()
+
+	instance, err := FindNetworkInstanceByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] TNB Network Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading TNB Network Instance (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", instance.Arn)
+	d.Set("description", instance.NsInstanceDescription)
+	d.Set("name", instance.NsInstanceName)
+	d.Set("network_package_id", instance.NsdInfoId)
+	d.Set("nsd_id", instance.NsdId)
+	d.Set("state", instance.NsState)
+
+	SetTagsOut(ctx, instance.Tags)
+
+	return diags
+}
+
+func resourceNetworkInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceNetworkInstanceRead(ctx, d, meta)...)
+}
+
+func resourceNetworkInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn()
+
+	// An instantiated network must be terminated before it can be deleted.
+	if state := d.Get("state").(string); state != "" && state != tnb.NsStateNotInstantiated {
+		log.Printf("[DEBUG] Terminating TNB Network Instance: %s", d.Id())
+		output, err := conn.TerminateSolNetworkInstanceWithContext(ctx, &tnb.TerminateSolNetworkInstanceInput{
+			NsInstanceId: aws.String(d.Id()),
+		})
+
+		if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "terminating TNB Network Instance (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitNetworkOperationCompleted(ctx, conn, aws.StringValue(output.NsLcmOpOccId), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for TNB Network Instance (%s) terminate: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting TNB Network Instance: %s", d.Id())
+	_, err := conn.DeleteSolNetworkInstanceWithContext(ctx, &tnb.DeleteSolNetworkInstanceInput{
+		NsInstanceId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting TNB Network Instance (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitNetworkInstanceDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for TNB Network Instance (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
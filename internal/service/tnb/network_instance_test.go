@@ -0,0 +1,135 @@
+package tnb_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/tnb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftnb "github.com/hashicorp/terraform-provider-aws/internal/service/tnb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTNBNetworkInstance_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v tnb.GetSolNetworkInstanceOutput
+	resourceName := "aws_tnb_network_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tnb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInstanceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkInstanceExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "tnb", regexp.MustCompile(`network-instance/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "network_package_id", "aws_tnb_network_package.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "nsd_id", "aws_tnb_network_package.test", "nsd_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "INSTANTIATED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTNBNetworkInstance_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v tnb.GetSolNetworkInstanceOutput
+	resourceName := "aws_tnb_network_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tnb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInstanceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInstanceExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftnb.ResourceNetworkInstance(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkInstanceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TNBConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_tnb_network_instance" {
+				continue
+			}
+
+			_, err := tftnb.FindNetworkInstanceByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("TNB Network Instance %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkInstanceExists(ctx context.Context, n string, v *tnb.GetSolNetworkInstanceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No TNB Network Instance ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TNBConn()
+
+		output, err := tftnb.FindNetworkInstanceByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccNetworkInstanceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccNetworkPackageConfig_basic(), fmt.Sprintf(`
+resource "aws_tnb_network_instance" "test" {
+  name               = %[1]q
+  network_package_id = aws_tnb_network_package.test.id
+}
+`, rName))
+}
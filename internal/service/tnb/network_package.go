@@ -0,0 +1,222 @@
+package tnb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/tnb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_tnb_network_package", name="Network Package")
+// @Tags(identifierAttribute="arn")
+func ResourceNetworkPackage() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNetworkPackageCreate,
+		ReadWithoutTimeout:   resourceNetworkPackageRead,
+		UpdateWithoutTimeout: resourceNetworkPackageUpdate,
+		DeleteWithoutTimeout: resourceNetworkPackageDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"file_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"nsd_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nsd_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nsd_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"onboarding_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operational_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(tnb.NsdOperationalState_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"usage_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vnf_pkg_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceNetworkPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn()
+
+	file := d.Get("file").(string)
+	content, err := readFileContents(file)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading TNB Network Package file (%s): %s", file, err)
+	}
+
+	output, err := conn.CreateSolNetworkPackageWithContext(ctx, &tnb.CreateSolNetworkPackageInput{
+		Tags: GetTagsIn(ctx),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating TNB Network Package: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	_, err = conn.PutSolNetworkPackageContentWithContext(ctx, &tnb.PutSolNetworkPackageContentInput{
+		ContentType: aws.String(tnb.PackageContentTypeApplicationZip),
+		File:        content,
+		NsdInfoId:   aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting TNB Network Package (%s) content: %s", d.Id(), err)
+	}
+
+	pkg, err := waitNetworkPackageOnboarded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for TNB Network Package (%s) onboard: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("operational_state"); ok && v.(string) != aws.StringValue(pkg.NsdOperationalState) {
+		if err := updateNetworkPackageOperationalState(ctx, conn, d.Id(), v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating TNB Network Package (%s) operational state: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceNetworkPackageRead(ctx, d, meta)...)
+}
+
+func resourceNetworkPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn()
+
+	pkg, err := FindNetworkPackageByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] TNB Network Package (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading TNB Network Package (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", pkg.Arn)
+	d.Set("nsd_id", pkg.NsdId)
+	d.Set("nsd_name", pkg.NsdName)
+	d.Set("nsd_version", pkg.NsdVersion)
+	d.Set("onboarding_state", pkg.NsdOnboardingState)
+	d.Set("operational_state", pkg.NsdOperationalState)
+	d.Set("usage_state", pkg.NsdUsageState)
+	d.Set("vnf_pkg_ids", aws.StringValueSlice(pkg.VnfPkgIds))
+
+	SetTagsOut(ctx, pkg.Tags)
+
+	return diags
+}
+
+func resourceNetworkPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn()
+
+	if d.HasChange("operational_state") {
+		if err := updateNetworkPackageOperationalState(ctx, conn, d.Id(), d.Get("operational_state").(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating TNB Network Package (%s) operational state: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceNetworkPackageRead(ctx, d, meta)...)
+}
+
+func resourceNetworkPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn()
+
+	// A network package must be disabled before it can be deleted.
+	if d.Get("operational_state").(string) == tnb.NsdOperationalStateEnabled {
+		err := updateNetworkPackageOperationalState(ctx, conn, d.Id(), tnb.NsdOperationalStateDisabled)
+
+		if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "disabling TNB Network Package (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting TNB Network Package: %s", d.Id())
+	_, err := conn.DeleteSolNetworkPackageWithContext(ctx, &tnb.DeleteSolNetworkPackageInput{
+		NsdInfoId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting TNB Network Package (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateNetworkPackageOperationalState(ctx context.Context, conn *tnb.Tnb, id, state string) error {
+	_, err := conn.UpdateSolNetworkPackageWithContext(ctx, &tnb.UpdateSolNetworkPackageInput{
+		NsdInfoId:           aws.String(id),
+		NsdOperationalState: aws.String(state),
+	})
+
+	return err
+}
@@ -0,0 +1,185 @@
+package tnb_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/tnb"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftnb "github.com/hashicorp/terraform-provider-aws/internal/service/tnb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTNBNetworkPackage_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v tnb.GetSolNetworkPackageOutput
+	resourceName := "aws_tnb_network_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tnb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkPackageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkPackageConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkPackageExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "tnb", regexp.MustCompile(`network-package/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "nsd_id", "e1a2d6c4-8f3b-4c55-a0f1-7d2b3c4e5f60"),
+					resource.TestCheckResourceAttr(resourceName, "nsd_name", "Terraform acceptance test network 1.0.0"),
+					resource.TestCheckResourceAttr(resourceName, "nsd_version", "1.0.0"),
+					resource.TestCheckResourceAttr(resourceName, "onboarding_state", "ONBOARDED"),
+					resource.TestCheckResourceAttr(resourceName, "operational_state", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "usage_state", "NOT_IN_USE"),
+					resource.TestCheckResourceAttr(resourceName, "vnf_pkg_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "vnf_pkg_ids.0", "aws_tnb_function_package.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file"},
+			},
+		},
+	})
+}
+
+func TestAccTNBNetworkPackage_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v tnb.GetSolNetworkPackageOutput
+	resourceName := "aws_tnb_network_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tnb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkPackageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkPackageConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkPackageExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftnb.ResourceNetworkPackage(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTNBNetworkPackage_operationalState(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v tnb.GetSolNetworkPackageOutput
+	resourceName := "aws_tnb_network_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tnb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkPackageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkPackageConfig_operationalState("DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkPackageExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "operational_state", "DISABLED"),
+				),
+			},
+			{
+				Config: testAccNetworkPackageConfig_operationalState("ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkPackageExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "operational_state", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkPackageDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TNBConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_tnb_network_package" {
+				continue
+			}
+
+			_, err := tftnb.FindNetworkPackageByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("TNB Network Package %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkPackageExists(ctx context.Context, n string, v *tnb.GetSolNetworkPackageOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No TNB Network Package ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TNBConn()
+
+		output, err := tftnb.FindNetworkPackageByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccNetworkPackageConfig_base() string {
+	return `
+resource "aws_tnb_function_package" "test" {
+  file = "test-fixtures/function-package.zip"
+}
+`
+}
+
+func testAccNetworkPackageConfig_basic() string {
+	return acctest.ConfigCompose(testAccNetworkPackageConfig_base(), `
+resource "aws_tnb_network_package" "test" {
+  file = "test-fixtures/network-package.zip"
+
+  depends_on = [aws_tnb_function_package.test]
+}
+`)
+}
+
+func testAccNetworkPackageConfig_operationalState(state string) string {
+	return acctest.ConfigCompose(testAccNetworkPackageConfig_base(), fmt.Sprintf(`
+resource "aws_tnb_network_package" "test" {
+  file              = "test-fixtures/network-package.zip"
+  operational_state = %[1]q
+
+  depends_on = [aws_tnb_function_package.test]
+}
+`, state))
+}
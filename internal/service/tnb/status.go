@@ -0,0 +1,74 @@
+package tnb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/tnb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusFunctionPackageOnboarding(ctx context.Context, conn *tnb.Tnb, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFunctionPackageByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.OnboardingState), nil
+	}
+}
+
+func statusNetworkPackageOnboarding(ctx context.Context, conn *tnb.Tnb, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindNetworkPackageByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.NsdOnboardingState), nil
+	}
+}
+
+func statusNetworkInstance(ctx context.Context, conn *tnb.Tnb, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindNetworkInstanceByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.NsState), nil
+	}
+}
+
+func statusNetworkOperation(ctx context.Context, conn *tnb.Tnb, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindNetworkOperationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.OperationState), nil
+	}
+}
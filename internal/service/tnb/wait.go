@@ -0,0 +1,94 @@
+package tnb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/tnb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitFunctionPackageOnboarded(ctx context.Context, conn *tnb.Tnb, id string, timeout time.Duration) (*tnb.GetSolFunctionPackageOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{tnb.OnboardingStateCreated},
+		Target:  []string{tnb.OnboardingStateOnboarded},
+		Refresh: statusFunctionPackageOnboarding(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*tnb.GetSolFunctionPackageOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitNetworkPackageOnboarded(ctx context.Context, conn *tnb.Tnb, id string, timeout time.Duration) (*tnb.GetSolNetworkPackageOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{tnb.NsdOnboardingStateCreated},
+		Target:  []string{tnb.NsdOnboardingStateOnboarded},
+		Refresh: statusNetworkPackageOnboarding(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*tnb.GetSolNetworkPackageOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitNetworkInstanceDeleted(ctx context.Context, conn *tnb.Tnb, id string, timeout time.Duration) (*tnb.GetSolNetworkInstanceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: tnb.NsState_Values(),
+		Target:  []string{},
+		Refresh: statusNetworkInstance(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*tnb.GetSolNetworkInstanceOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitNetworkOperationCompleted(ctx context.Context, conn *tnb.Tnb, id string, timeout time.Duration) (*tnb.GetSolNetworkOperationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{tnb.NsLcmOperationStateProcessing, tnb.NsLcmOperationStateCancelling},
+		Target:  []string{tnb.NsLcmOperationStateCompleted},
+		Refresh: statusNetworkOperation(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*tnb.GetSolNetworkOperationOutput); ok {
+		if v := output.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(networkOperationErrorString(v)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func networkOperationErrorString(apiObject *tnb.ProblemDetails) string {
+	if title := aws.StringValue(apiObject.Title); title != "" {
+		return fmt.Sprintf("%s: %s", title, aws.StringValue(apiObject.Detail))
+	}
+
+	return aws.StringValue(apiObject.Detail)
+}
@@ -58,6 +58,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceWebApp,
+			TypeName: "aws_transfer_web_app",
+			Name:     "Web App",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceWorkflow,
 			TypeName: "aws_transfer_workflow",
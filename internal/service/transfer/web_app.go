@@ -0,0 +1,361 @@
+package transfer
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	transfer_sdkv2 "github.com/aws/aws-sdk-go-v2/service/transfer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_transfer_web_app", name="Web App")
+// @Tags(identifierAttribute="arn")
+func ResourceWebApp() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWebAppCreate,
+		ReadWithoutTimeout:   resourceWebAppRead,
+		UpdateWithoutTimeout: resourceWebAppUpdate,
+		DeleteWithoutTimeout: resourceWebAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"access_endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_provider_details": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identity_center_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"instance_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"role": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"web_app_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"web_app_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"web_app_units": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provisioned": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceWebAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferClient()
+
+	input := &transfer_sdkv2.CreateWebAppInput{
+		IdentityProviderDetails: expandWebAppIdentityProviderDetails(d.Get("identity_provider_details").([]interface{})),
+		Tags:                    webAppTags(GetTagsIn(ctx)),
+	}
+
+	if v, ok := d.GetOk("access_endpoint"); ok {
+		input.AccessEndpoint = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("web_app_units"); ok {
+		input.WebAppUnits = expandWebAppUnits(v.([]interface{}))
+	}
+
+	output, err := conn.CreateWebApp(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Transfer Web App: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.WebAppId))
+
+	return append(diags, resourceWebAppRead(ctx, d, meta)...)
+}
+
+func resourceWebAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferClient()
+
+	webApp, err := FindWebAppByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Transfer Web App (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Transfer Web App (%s): %s", d.Id(), err)
+	}
+
+	d.Set("access_endpoint", webApp.AccessEndpoint)
+	d.Set("arn", webApp.Arn)
+	if err := d.Set("identity_provider_details", flattenDescribedWebAppIdentityProviderDetails(webApp.DescribedIdentityProviderDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting identity_provider_details: %s", err)
+	}
+	d.Set("web_app_endpoint", webApp.WebAppEndpoint)
+	d.Set("web_app_id", webApp.WebAppId)
+	if err := d.Set("web_app_units", flattenWebAppUnits(webApp.WebAppUnits)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting web_app_units: %s", err)
+	}
+
+	return diags
+}
+
+func resourceWebAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferClient()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &transfer_sdkv2.UpdateWebAppInput{
+			WebAppId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("access_endpoint") {
+			input.AccessEndpoint = aws.String(d.Get("access_endpoint").(string))
+		}
+
+		if d.HasChange("identity_provider_details") {
+			input.IdentityProviderDetails = expandUpdateWebAppIdentityProviderDetails(d.Get("identity_provider_details").([]interface{}))
+		}
+
+		if d.HasChange("web_app_units") {
+			input.WebAppUnits = expandWebAppUnits(d.Get("web_app_units").([]interface{}))
+		}
+
+		_, err := conn.UpdateWebApp(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Transfer Web App (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceWebAppRead(ctx, d, meta)...)
+}
+
+func resourceWebAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferClient()
+
+	log.Printf("[DEBUG] Deleting Transfer Web App: %s", d.Id())
+	_, err := conn.DeleteWebApp(ctx, &transfer_sdkv2.DeleteWebAppInput{
+		WebAppId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Transfer Web App (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindWebAppByID(ctx context.Context, conn *transfer_sdkv2.Client, id string) (*awstypes.DescribedWebApp, error) {
+	input := &transfer_sdkv2.DescribeWebAppInput{
+		WebAppId: aws.String(id),
+	}
+
+	output, err := conn.DescribeWebApp(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.WebApp == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.WebApp, nil
+}
+
+func expandWebAppIdentityProviderDetails(tfList []interface{}) awstypes.WebAppIdentityProviderDetails {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["identity_center_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject := awstypes.IdentityCenterConfig{}
+
+		if v, ok := tfMap["instance_arn"].(string); ok && v != "" {
+			apiObject.InstanceArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["role"].(string); ok && v != "" {
+			apiObject.Role = aws.String(v)
+		}
+
+		return &awstypes.WebAppIdentityProviderDetailsMemberIdentityCenterConfig{
+			Value: apiObject,
+		}
+	}
+
+	return nil
+}
+
+func expandUpdateWebAppIdentityProviderDetails(tfList []interface{}) awstypes.UpdateWebAppIdentityProviderDetails {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["identity_center_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject := awstypes.UpdateWebAppIdentityCenterConfig{}
+
+		if v, ok := tfMap["role"].(string); ok && v != "" {
+			apiObject.Role = aws.String(v)
+		}
+
+		return &awstypes.UpdateWebAppIdentityProviderDetailsMemberIdentityCenterConfig{
+			Value: apiObject,
+		}
+	}
+
+	return nil
+}
+
+func expandWebAppUnits(tfList []interface{}) awstypes.WebAppUnits {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["provisioned"].(int); ok && v != 0 {
+		return &awstypes.WebAppUnitsMemberProvisioned{
+			Value: int32(v),
+		}
+	}
+
+	return nil
+}
+
+func flattenDescribedWebAppIdentityProviderDetails(apiObject awstypes.DescribedWebAppIdentityProviderDetails) []interface{} {
+	switch v := apiObject.(type) {
+	case *awstypes.DescribedWebAppIdentityProviderDetailsMemberIdentityCenterConfig:
+		tfMap := map[string]interface{}{
+			"identity_center_config": []interface{}{map[string]interface{}{
+				"application_arn": aws.ToString(v.Value.ApplicationArn),
+				"instance_arn":    aws.ToString(v.Value.InstanceArn),
+				"role":            aws.ToString(v.Value.Role),
+			}},
+		}
+
+		return []interface{}{tfMap}
+	}
+
+	return nil
+}
+
+func flattenWebAppUnits(apiObject awstypes.WebAppUnits) []interface{} {
+	switch v := apiObject.(type) {
+	case *awstypes.WebAppUnitsMemberProvisioned:
+		tfMap := map[string]interface{}{
+			"provisioned": int(v.Value),
+		}
+
+		return []interface{}{tfMap}
+	}
+
+	return nil
+}
+
+// webAppTags converts the AWS SDK for Go v1 tags used by this package's generated
+// tagging code into the AWS SDK for Go v2 tags accepted by CreateWebApp.
+func webAppTags(tags []*transfer.Tag) []awstypes.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	result := make([]awstypes.Tag, 0, len(tags))
+
+	for _, tag := range tags {
+		result = append(result, awstypes.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+
+	return result
+}
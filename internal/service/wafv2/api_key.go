@@ -0,0 +1,151 @@
+package wafv2
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	apiKeyResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_wafv2_api_key", name="API Key")
+func ResourceAPIKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAPIKeyCreate,
+		ReadWithoutTimeout:   resourceAPIKeyRead,
+		DeleteWithoutTimeout: resourceAPIKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"scope": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(wafv2.Scope_Values(), false),
+			},
+			"token_domains": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 253),
+						validation.StringMatch(regexp.MustCompile(`^[\w\.\-/]+$`), "must contain only alphanumeric, hyphen, dot, underscore and forward-slash characters"),
+					),
+				},
+			},
+		},
+	}
+}
+
+func resourceAPIKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WAFV2Conn()
+
+	scope := d.Get("scope").(string)
+	input := &wafv2.CreateAPIKeyInput{
+		Scope:        aws.String(scope),
+		TokenDomains: flex.ExpandStringSet(d.Get("token_domains").(*schema.Set)),
+	}
+
+	output, err := conn.CreateAPIKeyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating WAFv2 API Key: %s", err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{aws.StringValue(output.APIKey), scope}, apiKeyResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.Errorf("creating WAFv2 API Key: %s", err)
+	}
+
+	d.SetId(id)
+
+	return resourceAPIKeyRead(ctx, d, meta)
+}
+
+func resourceAPIKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WAFV2Conn()
+
+	parts, err := flex.ExpandResourceId(d.Id(), apiKeyResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	apiKey, scope := parts[0], parts[1]
+	output, err := FindAPIKeyByTwoPartKey(ctx, conn, apiKey, scope)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WAFv2 API Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading WAFv2 API Key (%s): %s", d.Id(), err)
+	}
+
+	d.Set("api_key", apiKey)
+	d.Set("scope", scope)
+	d.Set("token_domains", aws.StringValueSlice(output.TokenDomains))
+
+	return nil
+}
+
+func resourceAPIKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// API keys can't be deleted through the WAFv2 API; they are removed from state only.
+	log.Printf("[WARN] WAFv2 API Key (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func FindAPIKeyByTwoPartKey(ctx context.Context, conn *wafv2.WAFV2, apiKey, scope string) (*wafv2.GetDecryptedAPIKeyOutput, error) {
+	input := &wafv2.GetDecryptedAPIKeyInput{
+		APIKey: aws.String(apiKey),
+		Scope:  aws.String(scope),
+	}
+
+	output, err := conn.GetDecryptedAPIKeyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, wafv2.ErrCodeWAFNonexistentItemException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
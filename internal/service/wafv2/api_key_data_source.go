@@ -0,0 +1,111 @@
+package wafv2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_wafv2_api_key")
+func DataSourceAPIKey() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAPIKeyRead,
+
+		Schema: map[string]*schema.Schema{
+			"api_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"application_integration_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scope": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(wafv2.Scope_Values(), false),
+			},
+			"token_domains": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAPIKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WAFV2Conn()
+
+	scope := d.Get("scope").(string)
+	input := &wafv2.ListAPIKeysInput{
+		Scope: aws.String(scope),
+	}
+	var tokenDomains *schema.Set
+	if v, ok := d.GetOk("token_domains"); ok && v.(*schema.Set).Len() > 0 {
+		tokenDomains = v.(*schema.Set)
+	}
+
+	var applicationIntegrationURL string
+	var apiKeys []*wafv2.APIKeySummary
+
+	err := listAPIKeysPages(ctx, conn, input, func(page *wafv2.ListAPIKeysOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		applicationIntegrationURL = aws.StringValue(page.ApplicationIntegrationURL)
+
+		for _, v := range page.APIKeySummaries {
+			if v == nil {
+				continue
+			}
+
+			if tokenDomains != nil && !tokenDomains.Equal(flex.FlattenStringSet(v.TokenDomains)) {
+				continue
+			}
+
+			apiKeys = append(apiKeys, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.Errorf("listing WAFv2 API Keys: %s", err)
+	}
+
+	apiKey, err := tfresource.AssertSinglePtrResult(apiKeys)
+
+	if err != nil {
+		return diag.FromErr(tfresource.SingularDataSourceFindError("WAFv2 API Key", err))
+	}
+
+	d.SetId(aws.StringValue(apiKey.APIKey))
+	d.Set("api_key", apiKey.APIKey)
+	d.Set("application_integration_url", applicationIntegrationURL)
+	d.Set("creation_time", aws.TimeValue(apiKey.CreationTimestamp).Format(time.RFC3339))
+	d.Set("scope", scope)
+	d.Set("token_domains", aws.StringValueSlice(apiKey.TokenDomains))
+	d.Set("version", apiKey.Version)
+
+	return nil
+}
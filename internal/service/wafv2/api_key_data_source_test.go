@@ -0,0 +1,45 @@
+package wafv2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccWAFV2APIKeyDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_api_key.test"
+	datasourceName := "data.aws_wafv2_api_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, wafv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyDataSourceConfig_tokenDomains(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "api_key", resourceName, "api_key"),
+					resource.TestCheckResourceAttrSet(datasourceName, "application_integration_url"),
+					acctest.CheckResourceAttrRFC3339(datasourceName, "creation_time"),
+					resource.TestCheckResourceAttrPair(datasourceName, "scope", resourceName, "scope"),
+					resource.TestCheckResourceAttrPair(datasourceName, "token_domains.#", resourceName, "token_domains.#"),
+					resource.TestCheckResourceAttrSet(datasourceName, "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAPIKeyDataSourceConfig_tokenDomains(rName string) string {
+	return acctest.ConfigCompose(testAccAPIKeyConfig_basic(rName), `
+data "aws_wafv2_api_key" "test" {
+  scope         = aws_wafv2_api_key.test.scope
+  token_domains = aws_wafv2_api_key.test.token_domains
+}
+`)
+}
@@ -1,6 +1,25 @@
 package wafv2
 
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/wafv2"
+)
+
+// Maximum nesting depth of logical (and/not/or) statements beneath a rule's root statement.
 const (
-	ruleGroupRootStatementSchemaLevel = 3
-	webACLRootStatementSchemaLevel    = 3
+	ruleGroupRootStatementSchemaLevel = 5
+	webACLRootStatementSchemaLevel    = 5
 )
+
+// requestBodyAssociatedResourceTypes maps association_config request_body block names to AssociatedResourceType values.
+// Each block is named after the lower-cased resource type, e.g. "api_gateway" for API_GATEWAY.
+var requestBodyAssociatedResourceTypes = func() map[string]string {
+	m := make(map[string]string)
+
+	for _, v := range wafv2.AssociatedResourceType_Values() {
+		m[strings.ToLower(v)] = v
+	}
+
+	return m
+}()
@@ -45,6 +45,38 @@ func expandRule(m map[string]interface{}) *wafv2.Rule {
 	return rule
 }
 
+func expandAssociationConfig(l []interface{}) *wafv2.AssociationConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	v, ok := m["request_body"].([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	m = v[0].(map[string]interface{})
+	requestBody := make(map[string]*wafv2.RequestBodyAssociatedResourceTypeConfig)
+
+	for k, resourceType := range requestBodyAssociatedResourceTypes {
+		if v, ok := m[k].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			requestBody[resourceType] = &wafv2.RequestBodyAssociatedResourceTypeConfig{
+				DefaultSizeInspectionLimit: aws.String(v[0].(map[string]interface{})["default_size_inspection_limit"].(string)),
+			}
+		}
+	}
+
+	if len(requestBody) == 0 {
+		return nil
+	}
+
+	return &wafv2.AssociationConfig{
+		RequestBody: requestBody,
+	}
+}
+
 func expandCaptchaConfig(l []interface{}) *wafv2.CaptchaConfig {
 	configuration := &wafv2.CaptchaConfig{}
 
@@ -1239,6 +1271,10 @@ func expandRateBasedStatement(l []interface{}) *wafv2.RateBasedStatement {
 		Limit:            aws.Int64(int64(m["limit"].(int))),
 	}
 
+	if v, ok := m["custom_key"]; ok {
+		r.CustomKeys = expandRateBasedStatementCustomKeys(v.([]interface{}))
+	}
+
 	if v, ok := m["forwarded_ip_config"]; ok {
 		r.ForwardedIPConfig = expandForwardedIPConfig(v.([]interface{}))
 	}
@@ -1251,6 +1287,84 @@ func expandRateBasedStatement(l []interface{}) *wafv2.RateBasedStatement {
 	return r
 }
 
+func expandRateBasedStatementCustomKeys(l []interface{}) []*wafv2.RateBasedStatementCustomKey {
+	if len(l) == 0 {
+		return nil
+	}
+
+	out := make([]*wafv2.RateBasedStatementCustomKey, 0, len(l))
+
+	for _, v := range l {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		r := &wafv2.RateBasedStatementCustomKey{}
+
+		if v, ok := m["cookie"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			r.Cookie = &wafv2.RateLimitCookie{
+				Name:                aws.String(tfMap["name"].(string)),
+				TextTransformations: expandTextTransformations(tfMap["text_transformation"].(*schema.Set).List()),
+			}
+		}
+
+		if v, ok := m["forwarded_ip"].([]interface{}); ok && len(v) > 0 {
+			r.ForwardedIP = &wafv2.RateLimitForwardedIP{}
+		}
+
+		if v, ok := m["header"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			r.Header = &wafv2.RateLimitHeader{
+				Name:                aws.String(tfMap["name"].(string)),
+				TextTransformations: expandTextTransformations(tfMap["text_transformation"].(*schema.Set).List()),
+			}
+		}
+
+		if v, ok := m["http_method"].([]interface{}); ok && len(v) > 0 {
+			r.HTTPMethod = &wafv2.RateLimitHTTPMethod{}
+		}
+
+		if v, ok := m["ip"].([]interface{}); ok && len(v) > 0 {
+			r.IP = &wafv2.RateLimitIP{}
+		}
+
+		if v, ok := m["label_namespace"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			r.LabelNamespace = &wafv2.RateLimitLabelNamespace{
+				Namespace: aws.String(tfMap["namespace"].(string)),
+			}
+		}
+
+		if v, ok := m["query_argument"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			r.QueryArgument = &wafv2.RateLimitQueryArgument{
+				Name:                aws.String(tfMap["name"].(string)),
+				TextTransformations: expandTextTransformations(tfMap["text_transformation"].(*schema.Set).List()),
+			}
+		}
+
+		if v, ok := m["query_string"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			r.QueryString = &wafv2.RateLimitQueryString{
+				TextTransformations: expandTextTransformations(tfMap["text_transformation"].(*schema.Set).List()),
+			}
+		}
+
+		if v, ok := m["uri_path"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			r.UriPath = &wafv2.RateLimitUriPath{
+				TextTransformations: expandTextTransformations(tfMap["text_transformation"].(*schema.Set).List()),
+			}
+		}
+
+		out = append(out, r)
+	}
+
+	return out
+}
+
 func expandRuleGroupReferenceStatement(l []interface{}) *wafv2.RuleGroupReferenceStatement {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1260,10 +1374,38 @@ func expandRuleGroupReferenceStatement(l []interface{}) *wafv2.RuleGroupReferenc
 
 	return &wafv2.RuleGroupReferenceStatement{
 		ARN:                 aws.String(m["arn"].(string)),
+		ExcludedRules:       expandExcludedRules(m["excluded_rule"].([]interface{})),
 		RuleActionOverrides: expandRuleActionOverrides(m["rule_action_override"].([]interface{})),
 	}
 }
 
+func expandExcludedRules(l []interface{}) []*wafv2.ExcludedRule {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	rules := make([]*wafv2.ExcludedRule, 0)
+
+	for _, rule := range l {
+		if rule == nil {
+			continue
+		}
+		rules = append(rules, expandExcludedRule(rule.(map[string]interface{})))
+	}
+
+	return rules
+}
+
+func expandExcludedRule(m map[string]interface{}) *wafv2.ExcludedRule {
+	if m == nil {
+		return nil
+	}
+
+	return &wafv2.ExcludedRule{
+		Name: aws.String(m["name"].(string)),
+	}
+}
+
 func expandRuleActionOverrides(l []interface{}) []*wafv2.RuleActionOverride {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1406,6 +1548,28 @@ func flattenCaptcha(a *wafv2.CaptchaAction) []interface{} {
 	return []interface{}{m}
 }
 
+func flattenAssociationConfig(config *wafv2.AssociationConfig) interface{} {
+	if config == nil || len(config.RequestBody) == 0 {
+		return []interface{}{}
+	}
+
+	requestBody := map[string]interface{}{}
+
+	for k, resourceType := range requestBodyAssociatedResourceTypes {
+		if v, ok := config.RequestBody[resourceType]; ok && v != nil {
+			requestBody[k] = []interface{}{map[string]interface{}{
+				"default_size_inspection_limit": aws.StringValue(v.DefaultSizeInspectionLimit),
+			}}
+		}
+	}
+
+	m := map[string]interface{}{
+		"request_body": []interface{}{requestBody},
+	}
+
+	return []interface{}{m}
+}
+
 func flattenCaptchaConfig(config *wafv2.CaptchaConfig) interface{} {
 	if config == nil {
 		return []interface{}{}
@@ -2363,6 +2527,10 @@ func flattenRateBasedStatement(apiObject *wafv2.RateBasedStatement) interface{}
 		tfMap["aggregate_key_type"] = aws.StringValue(apiObject.AggregateKeyType)
 	}
 
+	if apiObject.CustomKeys != nil {
+		tfMap["custom_key"] = flattenRateBasedStatementCustomKeys(apiObject.CustomKeys)
+	}
+
 	if apiObject.ForwardedIPConfig != nil {
 		tfMap["forwarded_ip_config"] = flattenForwardedIPConfig(apiObject.ForwardedIPConfig)
 	}
@@ -2378,6 +2546,77 @@ func flattenRateBasedStatement(apiObject *wafv2.RateBasedStatement) interface{}
 	return []interface{}{tfMap}
 }
 
+func flattenRateBasedStatementCustomKeys(apiObjects []*wafv2.RateBasedStatementCustomKey) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	out := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Cookie; v != nil {
+			tfMap["cookie"] = []interface{}{map[string]interface{}{
+				"name":                aws.StringValue(v.Name),
+				"text_transformation": flattenTextTransformations(v.TextTransformations),
+			}}
+		}
+
+		if apiObject.ForwardedIP != nil {
+			tfMap["forwarded_ip"] = make([]map[string]interface{}, 1)
+		}
+
+		if v := apiObject.Header; v != nil {
+			tfMap["header"] = []interface{}{map[string]interface{}{
+				"name":                aws.StringValue(v.Name),
+				"text_transformation": flattenTextTransformations(v.TextTransformations),
+			}}
+		}
+
+		if apiObject.HTTPMethod != nil {
+			tfMap["http_method"] = make([]map[string]interface{}, 1)
+		}
+
+		if apiObject.IP != nil {
+			tfMap["ip"] = make([]map[string]interface{}, 1)
+		}
+
+		if v := apiObject.LabelNamespace; v != nil {
+			tfMap["label_namespace"] = []interface{}{map[string]interface{}{
+				"namespace": aws.StringValue(v.Namespace),
+			}}
+		}
+
+		if v := apiObject.QueryArgument; v != nil {
+			tfMap["query_argument"] = []interface{}{map[string]interface{}{
+				"name":                aws.StringValue(v.Name),
+				"text_transformation": flattenTextTransformations(v.TextTransformations),
+			}}
+		}
+
+		if v := apiObject.QueryString; v != nil {
+			tfMap["query_string"] = []interface{}{map[string]interface{}{
+				"text_transformation": flattenTextTransformations(v.TextTransformations),
+			}}
+		}
+
+		if v := apiObject.UriPath; v != nil {
+			tfMap["uri_path"] = []interface{}{map[string]interface{}{
+				"text_transformation": flattenTextTransformations(v.TextTransformations),
+			}}
+		}
+
+		out = append(out, tfMap)
+	}
+
+	return out
+}
+
 func flattenRuleGroupReferenceStatement(apiObject *wafv2.RuleGroupReferenceStatement) interface{} {
 	if apiObject == nil {
 		return []interface{}{}
@@ -2387,6 +2626,10 @@ func flattenRuleGroupReferenceStatement(apiObject *wafv2.RuleGroupReferenceState
 		"arn": aws.StringValue(apiObject.ARN),
 	}
 
+	if apiObject.ExcludedRules != nil {
+		tfMap["excluded_rule"] = flattenExcludedRules(apiObject.ExcludedRules)
+	}
+
 	if apiObject.RuleActionOverrides != nil {
 		tfMap["rule_action_override"] = flattenRuleActionOverrides(apiObject.RuleActionOverrides)
 	}
@@ -2394,6 +2637,17 @@ func flattenRuleGroupReferenceStatement(apiObject *wafv2.RuleGroupReferenceState
 	return []interface{}{tfMap}
 }
 
+func flattenExcludedRules(r []*wafv2.ExcludedRule) interface{} {
+	out := make([]map[string]interface{}, len(r))
+	for i, rule := range r {
+		m := make(map[string]interface{})
+		m["name"] = aws.StringValue(rule.Name)
+		out[i] = m
+	}
+
+	return out
+}
+
 func flattenRuleActionOverrides(r []*wafv2.RuleActionOverride) interface{} {
 	out := make([]map[string]interface{}, len(r))
 	for i, override := range r {
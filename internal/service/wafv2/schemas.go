@@ -3,6 +3,7 @@ package wafv2
 import (
 	"math"
 	"regexp"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +22,25 @@ func emptySchema() *schema.Schema {
 	}
 }
 
+// sharedSchema returns a function that builds the schema returned by f on first use and then hands
+// the same instance to every caller. The statement schemas are referenced from every nesting level of
+// every WAFv2 resource, so building a fresh copy for each reference is needlessly expensive.
+// Shared schemas must not be modified by their callers.
+func sharedSchema(f func() *schema.Schema) func() *schema.Schema {
+	var (
+		once sync.Once
+		v    *schema.Schema
+	)
+
+	return func() *schema.Schema {
+		once.Do(func() {
+			v = f()
+		})
+
+		return v
+	}
+}
+
 func ruleLabelsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -65,7 +85,34 @@ func ruleGroupRootStatementSchema(level int) *schema.Schema {
 	}
 }
 
+// statementSchemas holds the shared logical (and/not/or) statement schema for each nesting level,
+// starting at level 1. Each level's schema is built on first use and then shared by every parent at
+// the level above, so the schema tree held in memory grows linearly rather than exponentially with
+// the nesting depth.
+var statementSchemas []func() *schema.Schema
+
+func init() {
+	maxLevel := ruleGroupRootStatementSchemaLevel
+	if webACLRootStatementSchemaLevel > maxLevel {
+		maxLevel = webACLRootStatementSchemaLevel
+	}
+
+	statementSchemas = make([]func() *schema.Schema, maxLevel)
+
+	for i := range statementSchemas {
+		level := i + 1
+		statementSchemas[i] = sharedSchema(func() *schema.Schema {
+			return newStatementSchema(level)
+		})
+	}
+}
+
+// statementSchema returns the schema for a logical statement allowing up to level levels of nesting.
 func statementSchema(level int) *schema.Schema {
+	return statementSchemas[level-1]()
+}
+
+func newStatementSchema(level int) *schema.Schema {
 	if level > 1 {
 		return &schema.Schema{
 			Type:     schema.TypeList,
@@ -126,7 +173,7 @@ func statementSchema(level int) *schema.Schema {
 	}
 }
 
-func byteMatchStatementSchema() *schema.Schema {
+var byteMatchStatementSchema = sharedSchema(func() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
@@ -148,9 +195,9 @@ func byteMatchStatementSchema() *schema.Schema {
 			},
 		},
 	}
-}
+})
 
-func geoMatchStatementSchema() *schema.Schema {
+var geoMatchStatementSchema = sharedSchema(func() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
@@ -167,9 +214,9 @@ func geoMatchStatementSchema() *schema.Schema {
 			},
 		},
 	}
-}
+})
 
-func ipSetReferenceStatementSchema() *schema.Schema {
+var ipSetReferenceStatementSchema = sharedSchema(func() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
@@ -211,9 +258,9 @@ func ipSetReferenceStatementSchema() *schema.Schema {
 			},
 		},
 	}
-}
+})
 
-func labelMatchStatementSchema() *schema.Schema {
+var labelMatchStatementSchema = sharedSchema(func() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
@@ -236,9 +283,9 @@ func labelMatchStatementSchema() *schema.Schema {
 			},
 		},
 	}
-}
+})
 
-func regexMatchStatementSchema() *schema.Schema {
+var regexMatchStatementSchema = sharedSchema(func() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
@@ -258,9 +305,9 @@ func regexMatchStatementSchema() *schema.Schema {
 			},
 		},
 	}
-}
+})
 
-func regexPatternSetReferenceStatementSchema() *schema.Schema {
+var regexPatternSetReferenceStatementSchema = sharedSchema(func() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
@@ -277,9 +324,9 @@ func regexPatternSetReferenceStatementSchema() *schema.Schema {
 			},
 		},
 	}
-}
+})
 
-func sizeConstraintSchema() *schema.Schema {
+var sizeConstraintSchema = sharedSchema(func() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
@@ -301,9 +348,9 @@ func sizeConstraintSchema() *schema.Schema {
 			},
 		},
 	}
-}
+})
 
-func sqliMatchStatementSchema() *schema.Schema {
+var sqliMatchStatementSchema = sharedSchema(func() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
@@ -315,9 +362,9 @@ func sqliMatchStatementSchema() *schema.Schema {
 			},
 		},
 	}
-}
+})
 
-func xssMatchStatementSchema() *schema.Schema {
+var xssMatchStatementSchema = sharedSchema(func() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
@@ -329,7 +376,7 @@ func xssMatchStatementSchema() *schema.Schema {
 			},
 		},
 	}
-}
+})
 
 func fieldToMatchBaseSchema() *schema.Resource {
 	return &schema.Resource{
@@ -384,14 +431,14 @@ func fieldToMatchBaseSchema() *schema.Resource {
 	}
 }
 
-func fieldToMatchSchema() *schema.Schema {
+var fieldToMatchSchema = sharedSchema(func() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
 		MaxItems: 1,
 		Elem:     fieldToMatchBaseSchema(),
 	}
-}
+})
 
 func jsonBodySchema() *schema.Schema {
 	return &schema.Schema{
@@ -462,7 +509,7 @@ func forwardedIPConfigSchema() *schema.Schema {
 	}
 }
 
-func textTransformationSchema() *schema.Schema {
+var textTransformationSchema = sharedSchema(func() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
 		Required: true,
@@ -481,7 +528,7 @@ func textTransformationSchema() *schema.Schema {
 			},
 		},
 	}
-}
+})
 
 func visibilityConfigSchema() *schema.Schema {
 	return &schema.Schema{
@@ -562,6 +609,57 @@ func outerCaptchaConfigSchema() *schema.Schema {
 	}
 }
 
+func associationConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeList,
+		Optional:         true,
+		MaxItems:         1,
+		DiffSuppressFunc: suppressAssociationConfigDefaultsDiff,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"request_body": {
+					Type:             schema.TypeList,
+					Optional:         true,
+					MaxItems:         1,
+					DiffSuppressFunc: suppressAssociationConfigDefaultsDiff,
+					Elem: &schema.Resource{
+						Schema: requestBodySchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func requestBodySchema() map[string]*schema.Schema {
+	m := make(map[string]*schema.Schema, len(requestBodyAssociatedResourceTypes))
+
+	for k := range requestBodyAssociatedResourceTypes {
+		m[k] = requestBodyAssociatedResourceTypeConfigSchema()
+	}
+
+	return m
+}
+
+func requestBodyAssociatedResourceTypeConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeList,
+		Optional:         true,
+		MaxItems:         1,
+		DiffSuppressFunc: suppressAssociationConfigDefaultsDiff,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"default_size_inspection_limit": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateFunc:     validation.StringInSlice(wafv2.SizeInspectionLimit_Values(), false),
+					DiffSuppressFunc: suppressAssociationConfigDefaultsDiff,
+				},
+			},
+		},
+	}
+}
+
 func challengeConfigSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -897,6 +995,58 @@ func rateBasedStatementSchema(level int) *schema.Schema {
 					Default:      wafv2.RateBasedStatementAggregateKeyTypeIp,
 					ValidateFunc: validation.StringInSlice(wafv2.RateBasedStatementAggregateKeyType_Values(), false),
 				},
+				"custom_key": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 5,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"cookie":       rateLimitNamedKeySchema(),
+							"forwarded_ip": emptySchema(),
+							"header":       rateLimitNamedKeySchema(),
+							"http_method":  emptySchema(),
+							"ip":           emptySchema(),
+							"label_namespace": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"namespace": {
+											Type:     schema.TypeString,
+											Required: true,
+											ValidateFunc: validation.All(
+												validation.StringLenBetween(1, 1024),
+												validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z_\-:]+:$`), "must contain only alphanumeric, underscore, hyphen and colon characters and end with a colon"),
+											),
+										},
+									},
+								},
+							},
+							"query_argument": rateLimitNamedKeySchema(),
+							"query_string": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"text_transformation": textTransformationSchema(),
+									},
+								},
+							},
+							"uri_path": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"text_transformation": textTransformationSchema(),
+									},
+								},
+							},
+						},
+					},
+				},
 				"forwarded_ip_config": forwardedIPConfigSchema(),
 				"limit": {
 					Type:         schema.TypeInt,
@@ -909,6 +1059,24 @@ func rateBasedStatementSchema(level int) *schema.Schema {
 	}
 }
 
+func rateLimitNamedKeySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+				"text_transformation": textTransformationSchema(),
+			},
+		},
+	}
+}
+
 func scopeDownStatementSchema(level int) *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -1070,12 +1238,31 @@ func ruleGroupReferenceStatementSchema() *schema.Schema {
 					Required:     true,
 					ValidateFunc: verify.ValidARN,
 				},
+				"excluded_rule":        excludedRuleSchema(),
 				"rule_action_override": ruleActionOverrideSchema(),
 			},
 		},
 	}
 }
 
+func excludedRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:       schema.TypeList,
+		Optional:   true,
+		MaxItems:   100,
+		Deprecated: "Use rule_action_override with action_to_use { count {} } instead",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+}
+
 func managedRuleGroupConfigATPRequestInspectionSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -21,6 +21,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceAPIKey,
+			TypeName: "aws_wafv2_api_key",
+		},
 		{
 			Factory:  DataSourceIPSet,
 			TypeName: "aws_wafv2_ip_set",
@@ -42,6 +46,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceAPIKey,
+			TypeName: "aws_wafv2_api_key",
+			Name:     "API Key",
+		},
 		{
 			Factory:  ResourceIPSet,
 			TypeName: "aws_wafv2_ip_set",
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"strings"
 	"time"
@@ -59,6 +60,7 @@ func ResourceWebACL() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"association_config": associationConfigSchema(),
 			"capacity": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -171,13 +173,14 @@ func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	name := d.Get("name").(string)
 	input := &wafv2.CreateWebACLInput{
-		CaptchaConfig:    expandCaptchaConfig(d.Get("captcha_config").([]interface{})),
-		DefaultAction:    expandDefaultAction(d.Get("default_action").([]interface{})),
-		Name:             aws.String(name),
-		Rules:            expandWebACLRules(d.Get("rule").(*schema.Set).List()),
-		Scope:            aws.String(d.Get("scope").(string)),
-		Tags:             GetTagsIn(ctx),
-		VisibilityConfig: expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
+		AssociationConfig: expandAssociationConfig(d.Get("association_config").([]interface{})),
+		CaptchaConfig:     expandCaptchaConfig(d.Get("captcha_config").([]interface{})),
+		DefaultAction:     expandDefaultAction(d.Get("default_action").([]interface{})),
+		Name:              aws.String(name),
+		Rules:             expandWebACLRules(d.Get("rule").(*schema.Set).List()),
+		Scope:             aws.String(d.Get("scope").(string)),
+		Tags:              GetTagsIn(ctx),
+		VisibilityConfig:  expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
 	}
 
 	if v, ok := d.GetOk("custom_response_body"); ok && v.(*schema.Set).Len() > 0 {
@@ -225,6 +228,9 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 	webACL := output.WebACL
 	arn := aws.StringValue(webACL.ARN)
 	d.Set("arn", arn)
+	if err := d.Set("association_config", flattenAssociationConfig(webACL.AssociationConfig)); err != nil {
+		return diag.Errorf("setting association_config: %s", err)
+	}
 	d.Set("capacity", webACL.Capacity)
 	if err := d.Set("captcha_config", flattenCaptchaConfig(webACL.CaptchaConfig)); err != nil {
 		return diag.Errorf("setting captcha_config: %s", err)
@@ -255,14 +261,15 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		input := &wafv2.UpdateWebACLInput{
-			CaptchaConfig:    expandCaptchaConfig(d.Get("captcha_config").([]interface{})),
-			DefaultAction:    expandDefaultAction(d.Get("default_action").([]interface{})),
-			Id:               aws.String(d.Id()),
-			LockToken:        aws.String(d.Get("lock_token").(string)),
-			Name:             aws.String(d.Get("name").(string)),
-			Rules:            expandWebACLRules(d.Get("rule").(*schema.Set).List()),
-			Scope:            aws.String(d.Get("scope").(string)),
-			VisibilityConfig: expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
+			AssociationConfig: expandAssociationConfig(d.Get("association_config").([]interface{})),
+			CaptchaConfig:     expandCaptchaConfig(d.Get("captcha_config").([]interface{})),
+			DefaultAction:     expandDefaultAction(d.Get("default_action").([]interface{})),
+			Id:                aws.String(d.Id()),
+			LockToken:         aws.String(d.Get("lock_token").(string)),
+			Name:              aws.String(d.Get("name").(string)),
+			Rules:             expandWebACLRules(d.Get("rule").(*schema.Set).List()),
+			Scope:             aws.String(d.Get("scope").(string)),
+			VisibilityConfig:  expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
 		}
 
 		if v, ok := d.GetOk("custom_response_body"); ok && v.(*schema.Set).Len() > 0 {
@@ -346,6 +353,30 @@ func FindWebACLByThreePartKey(ctx context.Context, conn *wafv2.WAFV2, id, name,
 	return output, nil
 }
 
+// suppressAssociationConfigDefaultsDiff suppresses association_config differences where a
+// request body size inspection limit is only being set to, or removed in favor of, the API default.
+func suppressAssociationConfigDefaultsDiff(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("association_config")
+
+	return reflect.DeepEqual(nonDefaultSizeInspectionLimits(o.([]interface{})), nonDefaultSizeInspectionLimits(n.([]interface{})))
+}
+
+// nonDefaultSizeInspectionLimits returns the configured request body size inspection limits,
+// keyed by associated resource type, omitting any that are set to the default of 16 KB.
+func nonDefaultSizeInspectionLimits(l []interface{}) map[string]string {
+	limits := make(map[string]string)
+
+	if config := expandAssociationConfig(l); config != nil {
+		for resourceType, v := range config.RequestBody {
+			if limit := aws.StringValue(v.DefaultSizeInspectionLimit); limit != "" && limit != wafv2.SizeInspectionLimitKb16 {
+				limits[resourceType] = limit
+			}
+		}
+	}
+
+	return limits
+}
+
 // filterWebACLRules removes the AWS-added Shield Advanced auto mitigation rule here
 // so that the provider will not report diff and/or attempt to remove the rule as it is
 // owned and managed by AWS.
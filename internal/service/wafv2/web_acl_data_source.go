@@ -22,6 +22,10 @@ func DataSourceWebACL() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -77,8 +81,15 @@ func dataSourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "WAFv2 WebACL not found for name: %s", name)
 	}
 
+	output, err := FindWebACLByThreePartKey(ctx, conn, aws.StringValue(foundWebACL.Id), name, d.Get("scope").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WAFv2 WebACL (%s): %s", aws.StringValue(foundWebACL.Id), err)
+	}
+
 	d.SetId(aws.StringValue(foundWebACL.Id))
 	d.Set("arn", foundWebACL.ARN)
+	d.Set("capacity", output.WebACL.Capacity)
 	d.Set("description", foundWebACL.Description)
 
 	return diags
@@ -0,0 +1,208 @@
+package wisdom
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_wisdom_assistant", name="Assistant")
+// @Tags(identifierAttribute="arn")
+func ResourceAssistant() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssistantCreate,
+		ReadWithoutTimeout:   resourceAssistantRead,
+		UpdateWithoutTimeout: resourceAssistantUpdate,
+		DeleteWithoutTimeout: resourceAssistantDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"server_side_encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 4096),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      connectwisdomservice.AssistantTypeAgent,
+				ValidateFunc: validation.StringInSlice(connectwisdomservice.AssistantType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAssistantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WisdomConn()
+
+	name := d.Get("name").(string)
+	input := &connectwisdomservice.CreateAssistantInput{
+		Name: aws.String(name),
+		Tags: GetTagsIn(ctx),
+		Type: aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.ServerSideEncryptionConfiguration = expandServerSideEncryptionConfiguration(v.([]interface{}))
+	}
+
+	output, err := conn.CreateAssistantWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Wisdom Assistant (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Assistant.AssistantId))
+
+	if _, err := waitAssistantCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Wisdom Assistant (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAssistantRead(ctx, d, meta)...)
+}
+
+func resourceAssistantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WisdomConn()
+
+	assistant, err := FindAssistantByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Wisdom Assistant (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Wisdom Assistant (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", assistant.AssistantArn)
+	d.Set("description", assistant.Description)
+	d.Set("name", assistant.Name)
+	if err := d.Set("server_side_encryption_configuration", flattenServerSideEncryptionConfiguration(assistant.ServerSideEncryptionConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting server_side_encryption_configuration: %s", err)
+	}
+	d.Set("type", assistant.Type)
+
+	SetTagsOut(ctx, assistant.Tags)
+
+	return diags
+}
+
+func resourceAssistantUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceAssistantRead(ctx, d, meta)...)
+}
+
+func resourceAssistantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WisdomConn()
+
+	log.Printf("[DEBUG] Deleting Wisdom Assistant: %s", d.Id())
+	_, err := conn.DeleteAssistantWithContext(ctx, &connectwisdomservice.DeleteAssistantInput{
+		AssistantId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connectwisdomservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Wisdom Assistant (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAssistantDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Wisdom Assistant (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandServerSideEncryptionConfiguration(tfList []interface{}) *connectwisdomservice.ServerSideEncryptionConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &connectwisdomservice.ServerSideEncryptionConfiguration{}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenServerSideEncryptionConfiguration(apiObject *connectwisdomservice.ServerSideEncryptionConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"kms_key_id": aws.StringValue(apiObject.KmsKeyId),
+	}
+
+	return []interface{}{tfMap}
+}
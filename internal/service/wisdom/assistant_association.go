@@ -0,0 +1,222 @@
+package wisdom
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_wisdom_assistant_association", name="Assistant Association")
+// @Tags(identifierAttribute="arn")
+func ResourceAssistantAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssistantAssociationCreate,
+		ReadWithoutTimeout:   resourceAssistantAssociationRead,
+		UpdateWithoutTimeout: resourceAssistantAssociationUpdate,
+		DeleteWithoutTimeout: resourceAssistantAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assistant_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assistant_association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assistant_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"association": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"knowledge_base_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"association_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      connectwisdomservice.AssociationTypeKnowledgeBase,
+				ValidateFunc: validation.StringInSlice(connectwisdomservice.AssociationType_Values(), false),
+			},
+			"knowledge_base_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAssistantAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WisdomConn()
+
+	assistantID := d.Get("assistant_id").(string)
+	input := &connectwisdomservice.CreateAssistantAssociationInput{
+		AssistantId:     aws.String(assistantID),
+		Association:     expandAssistantAssociationInputData(d.Get("association").([]interface{})),
+		AssociationType: aws.String(d.Get("association_type").(string)),
+		Tags:            GetTagsIn(ctx),
+	}
+
+	output, err := conn.CreateAssistantAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Wisdom Assistant (%s) Association: %s", assistantID, err)
+	}
+
+	d.SetId(AssistantAssociationCreateResourceID(aws.StringValue(output.AssistantAssociation.AssistantAssociationId), assistantID))
+
+	return append(diags, resourceAssistantAssociationRead(ctx, d, meta)...)
+}
+
+func resourceAssistantAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WisdomConn()
+
+	assistantAssociationID, assistantID, err := AssistantAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	association, err := FindAssistantAssociationByTwoPartKey(ctx, conn, assistantAssociationID, assistantID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Wisdom Assistant Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Wisdom Assistant Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", association.AssistantAssociationArn)
+	d.Set("assistant_arn", association.AssistantArn)
+	d.Set("assistant_association_id", association.AssistantAssociationId)
+	d.Set("assistant_id", association.AssistantId)
+	d.Set("association_type", association.AssociationType)
+	if v := association.AssociationData; v != nil && v.KnowledgeBaseAssociation != nil {
+		if err := d.Set("association", []interface{}{map[string]interface{}{
+			"knowledge_base_id": aws.StringValue(v.KnowledgeBaseAssociation.KnowledgeBaseId),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting association: %s", err)
+		}
+		d.Set("knowledge_base_arn", v.KnowledgeBaseAssociation.KnowledgeBaseArn)
+	} else {
+		d.Set("association", nil)
+		d.Set("knowledge_base_arn", nil)
+	}
+
+	SetTagsOut(ctx, association.Tags)
+
+	return diags
+}
+
+func resourceAssistantAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceAssistantAssociationRead(ctx, d, meta)...)
+}
+
+func resourceAssistantAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WisdomConn()
+
+	assistantAssociationID, assistantID, err := AssistantAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Wisdom Assistant Association: %s", d.Id())
+	_, err = conn.DeleteAssistantAssociationWithContext(ctx, &connectwisdomservice.DeleteAssistantAssociationInput{
+		AssistantAssociationId: aws.String(assistantAssociationID),
+		AssistantId:            aws.String(assistantID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connectwisdomservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Wisdom Assistant Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const assistantAssociationResourceIDSeparator = ","
+
+func AssistantAssociationCreateResourceID(assistantAssociationID, assistantID string) string {
+	parts := []string{assistantAssociationID, assistantID}
+	id := strings.Join(parts, assistantAssociationResourceIDSeparator)
+
+	return id
+}
+
+func AssistantAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, assistantAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ASSISTANT-ASSOCIATION-ID%[2]sASSISTANT-ID", id, assistantAssociationResourceIDSeparator)
+}
+
+func expandAssistantAssociationInputData(tfList []interface{}) *connectwisdomservice.AssistantAssociationInputData {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &connectwisdomservice.AssistantAssociationInputData{}
+
+	if v, ok := tfMap["knowledge_base_id"].(string); ok && v != "" {
+		apiObject.KnowledgeBaseId = aws.String(v)
+	}
+
+	return apiObject
+}
@@ -0,0 +1,158 @@
+package wisdom_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwisdom "github.com/hashicorp/terraform-provider-aws/internal/service/wisdom"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWisdomAssistantAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connectwisdomservice.AssistantAssociationData
+	resourceName := "aws_wisdom_assistant_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectwisdomservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssistantAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssistantAssociationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssistantAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "assistant_arn", "aws_wisdom_assistant.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "assistant_id", "aws_wisdom_assistant.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "association.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "association.0.knowledge_base_id", "aws_wisdom_knowledge_base.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "association_type", "KNOWLEDGE_BASE"),
+					resource.TestCheckResourceAttrPair(resourceName, "knowledge_base_arn", "aws_wisdom_knowledge_base.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWisdomAssistantAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connectwisdomservice.AssistantAssociationData
+	resourceName := "aws_wisdom_assistant_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectwisdomservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssistantAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssistantAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssistantAssociationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfwisdom.ResourceAssistantAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAssistantAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WisdomConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wisdom_assistant_association" {
+				continue
+			}
+
+			assistantAssociationID, assistantID, err := tfwisdom.AssistantAssociationParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfwisdom.FindAssistantAssociationByTwoPartKey(ctx, conn, assistantAssociationID, assistantID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Wisdom Assistant Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAssistantAssociationExists(ctx context.Context, n string, v *connectwisdomservice.AssistantAssociationData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Wisdom Assistant Association ID is set")
+		}
+
+		assistantAssociationID, assistantID, err := tfwisdom.AssistantAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WisdomConn()
+
+		output, err := tfwisdom.FindAssistantAssociationByTwoPartKey(ctx, conn, assistantAssociationID, assistantID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAssistantAssociationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wisdom_assistant" "test" {
+  name = %[1]q
+}
+
+resource "aws_wisdom_knowledge_base" "test" {
+  name                = %[1]q
+  knowledge_base_type = "CUSTOM"
+}
+
+resource "aws_wisdom_assistant_association" "test" {
+  assistant_id = aws_wisdom_assistant.test.id
+
+  association {
+    knowledge_base_id = aws_wisdom_knowledge_base.test.id
+  }
+}
+`, rName)
+}
@@ -0,0 +1,101 @@
+package wisdom
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAssistantByID(ctx context.Context, conn *connectwisdomservice.ConnectWisdomService, id string) (*connectwisdomservice.AssistantData, error) {
+	input := &connectwisdomservice.GetAssistantInput{
+		AssistantId: aws.String(id),
+	}
+
+	output, err := conn.GetAssistantWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connectwisdomservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Assistant == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Assistant.Status); status == connectwisdomservice.AssistantStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Assistant, nil
+}
+
+func FindAssistantAssociationByTwoPartKey(ctx context.Context, conn *connectwisdomservice.ConnectWisdomService, assistantAssociationID, assistantID string) (*connectwisdomservice.AssistantAssociationData, error) {
+	input := &connectwisdomservice.GetAssistantAssociationInput{
+		AssistantAssociationId: aws.String(assistantAssociationID),
+		AssistantId:            aws.String(assistantID),
+	}
+
+	output, err := conn.GetAssistantAssociationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connectwisdomservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AssistantAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AssistantAssociation, nil
+}
+
+func FindKnowledgeBaseByID(ctx context.Context, conn *connectwisdomservice.ConnectWisdomService, id string) (*connectwisdomservice.KnowledgeBaseData, error) {
+	input := &connectwisdomservice.GetKnowledgeBaseInput{
+		KnowledgeBaseId: aws.String(id),
+	}
+
+	output, err := conn.GetKnowledgeBaseWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connectwisdomservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.KnowledgeBase == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.KnowledgeBase.Status); status == connectwisdomservice.KnowledgeBaseStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.KnowledgeBase, nil
+}
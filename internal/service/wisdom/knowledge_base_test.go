@@ -0,0 +1,184 @@
+package wisdom_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwisdom "github.com/hashicorp/terraform-provider-aws/internal/service/wisdom"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWisdomKnowledgeBase_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connectwisdomservice.KnowledgeBaseData
+	resourceName := "aws_wisdom_knowledge_base.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectwisdomservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKnowledgeBaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKnowledgeBaseConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "wisdom", regexp.MustCompile(`knowledge-base/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_type", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rendering_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWisdomKnowledgeBase_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connectwisdomservice.KnowledgeBaseData
+	resourceName := "aws_wisdom_knowledge_base.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectwisdomservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKnowledgeBaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKnowledgeBaseConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfwisdom.ResourceKnowledgeBase(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWisdomKnowledgeBase_renderingConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connectwisdomservice.KnowledgeBaseData
+	resourceName := "aws_wisdom_knowledge_base.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectwisdomservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKnowledgeBaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKnowledgeBaseConfig_renderingConfiguration(rName, "https://example.com/{Id}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rendering_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rendering_configuration.0.template_uri", "https://example.com/{Id}"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKnowledgeBaseConfig_renderingConfiguration(rName, "https://example.org/{Id}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rendering_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rendering_configuration.0.template_uri", "https://example.org/{Id}"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKnowledgeBaseDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WisdomConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wisdom_knowledge_base" {
+				continue
+			}
+
+			_, err := tfwisdom.FindKnowledgeBaseByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Wisdom Knowledge Base %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKnowledgeBaseExists(ctx context.Context, n string, v *connectwisdomservice.KnowledgeBaseData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Wisdom Knowledge Base ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WisdomConn()
+
+		output, err := tfwisdom.FindKnowledgeBaseByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccKnowledgeBaseConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wisdom_knowledge_base" "test" {
+  name                = %[1]q
+  knowledge_base_type = "CUSTOM"
+}
+`, rName)
+}
+
+func testAccKnowledgeBaseConfig_renderingConfiguration(rName, templateURI string) string {
+	return fmt.Sprintf(`
+resource "aws_wisdom_knowledge_base" "test" {
+  name                = %[1]q
+  knowledge_base_type = "CUSTOM"
+
+  rendering_configuration {
+    template_uri = %[2]q
+  }
+}
+`, rName, templateURI)
+}
@@ -0,0 +1,77 @@
+package wisdom
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+func waitAssistantCreated(ctx context.Context, conn *connectwisdomservice.ConnectWisdomService, id string, timeout time.Duration) (*connectwisdomservice.AssistantData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{connectwisdomservice.AssistantStatusCreateInProgress},
+		Target:  []string{connectwisdomservice.AssistantStatusActive},
+		Refresh: statusAssistant(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connectwisdomservice.AssistantData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssistantDeleted(ctx context.Context, conn *connectwisdomservice.ConnectWisdomService, id string, timeout time.Duration) (*connectwisdomservice.AssistantData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{connectwisdomservice.AssistantStatusActive, connectwisdomservice.AssistantStatusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusAssistant(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connectwisdomservice.AssistantData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitKnowledgeBaseCreated(ctx context.Context, conn *connectwisdomservice.ConnectWisdomService, id string, timeout time.Duration) (*connectwisdomservice.KnowledgeBaseData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{connectwisdomservice.KnowledgeBaseStatusCreateInProgress},
+		Target:  []string{connectwisdomservice.KnowledgeBaseStatusActive},
+		Refresh: statusKnowledgeBase(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connectwisdomservice.KnowledgeBaseData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitKnowledgeBaseDeleted(ctx context.Context, conn *connectwisdomservice.ConnectWisdomService, id string, timeout time.Duration) (*connectwisdomservice.KnowledgeBaseData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{connectwisdomservice.KnowledgeBaseStatusActive, connectwisdomservice.KnowledgeBaseStatusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusKnowledgeBase(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connectwisdomservice.KnowledgeBaseData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -114,6 +114,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
@@ -139,6 +140,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/tnb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"sort"
@@ -29,7 +30,14 @@ const (
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	Tags            KeyValueTags
+	IgnoreResources []string
+	// SuppressComputedDiff resolves default tag values not known until apply
+	// from the resource's existing tags_all rather than marking tags_all as computed.
+	SuppressComputedDiff bool
+	// UnknownKeys are the keys of default tags whose values are not known until apply.
+	// Their values are not included in Tags.
+	UnknownKeys KeyValueTags
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -53,6 +61,32 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// ForResourceType returns the DefaultConfig to apply to resources of the given type,
+// or nil if the type matches any of the configured IgnoreResources patterns.
+func (dc *DefaultConfig) ForResourceType(typeName string) *DefaultConfig {
+	if dc == nil {
+		return nil
+	}
+
+	for _, pattern := range dc.IgnoreResources {
+		if ok, _ := filepath.Match(pattern, typeName); ok {
+			return nil
+		}
+	}
+
+	return dc
+}
+
+// UnknownTags returns the keys of default tags whose values are not known until apply,
+// excluding any overridden by the specified resource tags.
+func (dc *DefaultConfig) UnknownTags(tags KeyValueTags) KeyValueTags {
+	if dc == nil {
+		return nil
+	}
+
+	return dc.UnknownKeys.Removed(tags)
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
@@ -446,6 +480,29 @@ func (tags KeyValueTags) HasZeroValue() bool {
 	return false
 }
 
+// ResolveUnknownValues returns a copy of the tags with the values of the specified keys,
+// whose values are not known until apply, set from the matching keys in other.
+// It returns false if any of the keys is missing from other.
+func (tags KeyValueTags) ResolveUnknownValues(unknown, other KeyValueTags) (KeyValueTags, bool) {
+	result := make(KeyValueTags, len(tags)+len(unknown))
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	for k := range unknown {
+		v, ok := other[k]
+
+		if !ok {
+			return nil, false
+		}
+
+		result[k] = v
+	}
+
+	return result, true
+}
+
 // Equal returns whether or two sets of key-value tags are equal.
 func (tags KeyValueTags) Equal(other KeyValueTags) bool {
 	if tags == nil && other == nil {
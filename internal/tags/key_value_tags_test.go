@@ -54,6 +54,136 @@ func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResourceType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		typeName      string
+		want          KeyValueTags
+	}{
+		{
+			name:          "nil config",
+			defaultConfig: nil,
+			typeName:      "aws_vpc",
+			want:          nil,
+		},
+		{
+			name: "no ignore resources",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			typeName: "aws_vpc",
+			want: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+		},
+		{
+			name: "exact match",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				IgnoreResources: []string{"aws_vpc"},
+			},
+			typeName: "aws_vpc",
+			want:     nil,
+		},
+		{
+			name: "pattern match",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				IgnoreResources: []string{"aws_subnet", "aws_autoscaling_*"},
+			},
+			typeName: "aws_autoscaling_group",
+			want:     nil,
+		},
+		{
+			name: "no match",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				IgnoreResources: []string{"aws_vpc_*"},
+			},
+			typeName: "aws_vpc",
+			want: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResourceType(testCase.typeName).GetTags()
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want.Map())
+		})
+	}
+}
+
+func TestKeyValueTagsDefaultConfigUnknownTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		tags          KeyValueTags
+		want          []string
+	}{
+		{
+			name: "nil",
+			tags: New(ctx, map[string]string{}),
+		},
+		{
+			name: "no_unknown_keys",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			tags: New(ctx, map[string]string{}),
+		},
+		{
+			name: "unknown_keys",
+			defaultConfig: &DefaultConfig{
+				UnknownKeys: New(ctx, []string{"key1", "key2"}),
+			},
+			tags: New(ctx, map[string]string{}),
+			want: []string{"key1", "key2"},
+		},
+		{
+			name: "unknown_key_overridden",
+			defaultConfig: &DefaultConfig{
+				UnknownKeys: New(ctx, []string{"key1", "key2"}),
+			},
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			want: []string{"key2"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.UnknownTags(testCase.tags)
+			testKeyValueTagsVerifyKeys(t, got.Keys(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	t.Parallel()
 
@@ -1965,6 +2095,100 @@ func TestKeyValueTagsContainsAll(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsResolveUnknownValues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name    string
+		tags    KeyValueTags
+		unknown KeyValueTags
+		other   KeyValueTags
+		want    map[string]string
+		wantOK  bool
+	}{
+		{
+			name:    "empty",
+			tags:    New(ctx, map[string]string{}),
+			unknown: New(ctx, []string{}),
+			other:   New(ctx, map[string]string{}),
+			want:    map[string]string{},
+			wantOK:  true,
+		},
+		{
+			name: "no_unknown_values",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			unknown: New(ctx, []string{}),
+			other: New(ctx, map[string]string{
+				"key1": "value2",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+			wantOK: true,
+		},
+		{
+			name: "unknown_value_resolved",
+			tags: New(ctx, map[string]string{
+				"key2": "value2",
+			}),
+			unknown: New(ctx, []string{"key1"}),
+			other: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			wantOK: true,
+		},
+		{
+			name: "unknown_value_unresolved",
+			tags: New(ctx, map[string]string{
+				"key2": "value2",
+			}),
+			unknown: New(ctx, []string{"key1"}),
+			other: New(ctx, map[string]string{
+				"key2": "value2",
+			}),
+			wantOK: false,
+		},
+		{
+			name: "empty_value_kept",
+			tags: New(ctx, map[string]string{
+				"key1": "",
+			}),
+			unknown: New(ctx, []string{}),
+			other: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{
+				"key1": "",
+			},
+			wantOK: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := testCase.tags.ResolveUnknownValues(testCase.unknown, testCase.other)
+
+			if ok != testCase.wantOK {
+				t.Fatalf("got ok %t, expected %t", ok, testCase.wantOK)
+			}
+
+			if ok {
+				testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsEqual(t *testing.T) {
 	t.Parallel()
 
@@ -24,9 +24,15 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
+	// Use any resource type-specific default tags configuration.
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		defaultTagsConfig = inContext.DefaultConfig
+	}
+
 	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
 
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
 	// otherwise we mark the attribute as "Computed" only when there is a known diff (excluding an empty map)
@@ -41,6 +47,29 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 		return nil
 	}
 
+	// Default tag values not known until apply leave tags_all computed, unless
+	// suppress_computed_diff resolves all of them from the existing tags_all.
+	if unknownTags := defaultTagsConfig.UnknownTags(resourceTags).IgnoreConfig(ignoreTagsConfig); len(unknownTags) > 0 {
+		var ok bool
+
+		if defaultTagsConfig.SuppressComputedDiff {
+			o, _ := diff.GetChange("tags_all")
+			allTags, ok = allTags.ResolveUnknownValues(unknownTags, tftags.New(ctx, o))
+		}
+
+		if !ok {
+			if err := diff.SetNewComputed("tags_all"); err != nil {
+				return fmt.Errorf("setting tags_all to computed: %w", err)
+			}
+			return nil
+		}
+
+		if err := diff.SetNew("tags_all", allTags.Map()); err != nil {
+			return fmt.Errorf("setting new tags_all diff: %w", err)
+		}
+		return nil
+	}
+
 	if diff.HasChange("tags") {
 		_, n := diff.GetChange("tags")
 		newTags := tftags.New(ctx, n.(map[string]interface{}))
@@ -10,7 +10,6 @@ const (
 	APIGatewayV2                 = "apigatewayv2"
 	AccessAnalyzer               = "accessanalyzer"
 	Account                      = "account"
-	AlexaForBusiness             = "alexaforbusiness"
 	Amplify                      = "amplify"
 	AmplifyBackend               = "amplifybackend"
 	AmplifyUIBuilder             = "amplifyuibuilder"
@@ -112,6 +111,7 @@ const (
 	ElasticInference             = "elasticinference"
 	ElasticTranscoder            = "elastictranscoder"
 	Elasticsearch                = "elasticsearch"
+	EntityResolution             = "entityresolution"
 	Events                       = "events"
 	Evidently                    = "evidently"
 	FIS                          = "fis"
@@ -134,7 +134,6 @@ const (
 	GuardDuty                    = "guardduty"
 	Health                       = "health"
 	HealthLake                   = "healthlake"
-	Honeycode                    = "honeycode"
 	IAM                          = "iam"
 	IVS                          = "ivs"
 	IVSChat                      = "ivschat"
@@ -187,7 +186,6 @@ const (
 	MTurk                        = "mturk"
 	MWAA                         = "mwaa"
 	MachineLearning              = "machinelearning"
-	Macie                        = "macie"
 	Macie2                       = "macie2"
 	ManagedBlockchain            = "managedblockchain"
 	MarketplaceCatalog           = "marketplacecatalog"
@@ -208,13 +206,13 @@ const (
 	MigrationHubConfig           = "migrationhubconfig"
 	MigrationHubRefactorSpaces   = "migrationhubrefactorspaces"
 	MigrationHubStrategy         = "migrationhubstrategy"
-	Mobile                       = "mobile"
 	Neptune                      = "neptune"
 	NetworkFirewall              = "networkfirewall"
 	NetworkManager               = "networkmanager"
 	Nimble                       = "nimble"
 	ObservabilityAccessManager   = "oam"
 	OpenSearch                   = "opensearch"
+	OpenSearchIngestion          = "osis"
 	OpenSearchServerless         = "opensearchserverless"
 	OpsWorks                     = "opsworks"
 	OpsWorksCM                   = "opsworkscm"
@@ -297,6 +295,7 @@ const (
 	StorageGateway               = "storagegateway"
 	Support                      = "support"
 	Synthetics                   = "synthetics"
+	TNB                          = "tnb"
 	Textract                     = "textract"
 	TimestreamQuery              = "timestreamquery"
 	TimestreamWrite              = "timestreamwrite"